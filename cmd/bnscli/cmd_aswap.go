@@ -0,0 +1,122 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/x/aswap"
+)
+
+func cmdCreateSwap(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for creating an atomic swap. Funds are locked until the
+destination releases them by revealing the preimage of the given hash or until
+the timeout, after which they can be returned to the source.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		srcFl     = flAddress(fl, "src", "", "A source account address that the funds are locked from.")
+		dstFl     = flAddress(fl, "dst", "", "A destination account address that the funds can be released to.")
+		amountFl  = flCoin(fl, "amount", "", "An amount that is to be locked in the swap.")
+		hashFl    = flHex(fl, "hash", "", "Hex encoded hash of the preimage that releases the swap.")
+		hashAlgFl = fl.String("hash-algorithm", "sha256", "Algorithm used to compute the preimage hash. One of sha256, hash160 or keccak256.")
+		timeoutFl = flTime(fl, "timeout", nil, "Time after which the swap can be returned. Use UTC time and "+flagTimeFormat+" format.")
+		memoFl    = fl.String("memo", "", "A short message attached to the swap.")
+	)
+	fl.Parse(args)
+
+	alg, ok := hashAlgorithms[strings.ToLower(*hashAlgFl)]
+	if !ok {
+		flagDie("unknown hash algorithm %q", *hashAlgFl)
+	}
+	if len(*hashFl) == 0 {
+		flagDie("hash is required")
+	}
+	if timeoutFl.Time().IsZero() {
+		flagDie("timeout is required")
+	}
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_AswapCreateMsg{
+			AswapCreateMsg: &aswap.CreateMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				Source:        *srcFl,
+				Destination:   *dstFl,
+				PreimageHash:  *hashFl,
+				Amount:        []*coin.Coin{amountFl},
+				Timeout:       timeoutFl.UnixTime(),
+				Memo:          *memoFl,
+				HashAlgorithm: alg,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+// hashAlgorithms maps a command line name to the preimage hash algorithm.
+var hashAlgorithms = map[string]aswap.HashAlgorithm{
+	"sha256":    aswap.HashAlgorithmSha256,
+	"hash160":   aswap.HashAlgorithmHash160,
+	"keccak256": aswap.HashAlgorithmKeccak256,
+}
+
+func cmdReleaseSwap(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for releasing funds of an atomic swap to its destination.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		swapFl     = flSeq(fl, "swap", "", "An ID of a swap that is to be released.")
+		preimageFl = flHex(fl, "preimage", "", "Hex encoded preimage of the swap hash.")
+	)
+	fl.Parse(args)
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_AswapReleaseMsg{
+			AswapReleaseMsg: &aswap.ReleaseMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				SwapID:   *swapFl,
+				Preimage: *preimageFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdReturnSwap(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for returning funds of an expired atomic swap to its source.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		swapFl = flSeq(fl, "swap", "", "An ID of a swap that is to be returned.")
+	)
+	fl.Parse(args)
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_AswapReturnMsg{
+			AswapReturnMsg: &aswap.ReturnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				SwapID:   *swapFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
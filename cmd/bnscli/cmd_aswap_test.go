@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/aswap"
+)
+
+func TestCmdCreateSwapHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-src", "b1ca7e78f74423ae01da3b51e676934d9105f282",
+		"-dst", "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0",
+		"-amount", "3 IOV",
+		"-hash", "b472a266d0bd89c13706a4132ccfb16f7c3b9fcb",
+		"-hash-algorithm", "hash160",
+		"-timeout", "2030-01-01 12:00",
+		"-memo", "a swap",
+	}
+	if err := cmdCreateSwap(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new swap transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot read created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*aswap.CreateMsg)
+
+	assert.Equal(t, fromHex(t, "b472a266d0bd89c13706a4132ccfb16f7c3b9fcb"), msg.PreimageHash)
+	assert.Equal(t, aswap.HashAlgorithmHash160, msg.HashAlgorithm)
+	assert.Equal(t, []*coin.Coin{coin.NewCoinp(3, 0, "IOV")}, msg.Amount)
+	assert.Equal(t, "a swap", msg.Memo)
+	assert.Nil(t, msg.Validate())
+}
+
+func TestCmdReleaseSwapHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	preimage := "0000000000000000000000000000000000000000000000000000000000000001"
+	args := []string{
+		"-swap", "3",
+		"-preimage", preimage,
+	}
+	if err := cmdReleaseSwap(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a release swap transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot read created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*aswap.ReleaseMsg)
+
+	assert.Equal(t, sequenceID(3), []byte(msg.SwapID))
+	assert.Equal(t, fromHex(t, preimage), msg.Preimage)
+}
+
+func TestCmdReturnSwapHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	if err := cmdReturnSwap(nil, &output, []string{"-swap", "3"}); err != nil {
+		t.Fatalf("cannot create a return swap transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot read created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*aswap.ReturnMsg)
+
+	assert.Equal(t, sequenceID(3), []byte(msg.SwapID))
+}
@@ -59,6 +59,12 @@ original transactions (ie signatures) are being dropped.
 					CashSendMsg: msg,
 				},
 			})
+		case *cash.MultiSendMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashMultiSendMsg{
+					CashMultiSendMsg: msg,
+				},
+			})
 		case *escrow.CreateMsg:
 			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
 				Sum: &bnsd.ExecuteBatchMsg_Union_EscrowCreateMsg{
@@ -107,6 +113,24 @@ original transactions (ie signatures) are being dropped.
 					CurrencyCreateMsg: msg,
 				},
 			})
+		case *currency.MintMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CurrencyMintMsg{
+					CurrencyMintMsg: msg,
+				},
+			})
+		case *currency.BurnMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CurrencyBurnMsg{
+					CurrencyBurnMsg: msg,
+				},
+			})
+		case *currency.UpdateMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CurrencyUpdateMsg{
+					CurrencyUpdateMsg: msg,
+				},
+			})
 		case *username.RegisterTokenMsg:
 			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
 				Sum: &bnsd.ExecuteBatchMsg_Union_UsernameRegisterTokenMsg{
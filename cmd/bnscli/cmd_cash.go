@@ -1,12 +1,15 @@
 package main
 
 import (
+	"encoding/csv"
 	"flag"
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/x/cash"
@@ -18,6 +21,9 @@ func cmdSendTokens(input io.Reader, output io.Writer, args []string) error {
 		fmt.Fprintln(flag.CommandLine.Output(), `
 Create a transaction for transfering funds from the source account to the
 destination account.
+
+When a CSV file is provided, create a single transaction for transfering funds
+from the source account to all destination accounts listed in that file.
 		`)
 		fl.PrintDefaults()
 	}
@@ -26,9 +32,33 @@ destination account.
 		dstFl    = flAddress(fl, "dst", "", "A destination account address that the founds are send to.")
 		amountFl = flCoin(fl, "amount", "1 IOV", "An amount that is to be transferred between the source to the destination accounts.")
 		memoFl   = fl.String("memo", "", "A short message attached to the transfer operation.")
+		csvFl    = fl.String("csv", "", "A path to a CSV file with a list of pairs (address, amount). If provided, -dst and -amount are ignored.")
 	)
 	fl.Parse(args)
 
+	if *csvFl != "" {
+		outputs, err := readOutputs(*csvFl)
+		if err != nil {
+			return fmt.Errorf("cannot read %q outputs file: %s", *csvFl, err)
+		}
+		inputs, err := sourceInputs(*srcFl, outputs)
+		if err != nil {
+			return fmt.Errorf("cannot compute inputs: %s", err)
+		}
+		tx := &bnsd.Tx{
+			Sum: &bnsd.Tx_CashMultiSendMsg{
+				CashMultiSendMsg: &cash.MultiSendMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					Inputs:   inputs,
+					Outputs:  outputs,
+					Memo:     *memoFl,
+				},
+			},
+		}
+		_, err = writeTx(output, tx)
+		return err
+	}
+
 	tx := &bnsd.Tx{
 		Sum: &bnsd.Tx_CashSendMsg{
 			CashSendMsg: &cash.SendMsg{
@@ -45,6 +75,65 @@ destination account.
 	return err
 }
 
+func readOutputs(csvpath string) ([]cash.Output, error) {
+	fd, err := os.Open(csvpath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open file: %s", err)
+	}
+	defer fd.Close()
+
+	var outputs []cash.Output
+
+	rd := csv.NewReader(fd)
+	rd.Comment = '#'
+	for lineNo := 1; ; lineNo++ {
+		row, err := rd.Read()
+		if err != nil {
+			if err == io.EOF {
+				return outputs, nil
+			}
+			return outputs, err
+		}
+
+		if len(row) != 2 {
+			return outputs, fmt.Errorf("invalid line %d: expected 2 columns, got %d", lineNo, len(row))
+		}
+		address, err := weave.ParseAddress(row[0])
+		if err != nil {
+			return outputs, fmt.Errorf("invalid line %d: invalid address %q: %s", lineNo, row[0], err)
+		}
+		amount, err := coin.ParseHumanFormat(row[1])
+		if err != nil {
+			return outputs, fmt.Errorf("invalid line %d: invalid amount %q: %s", lineNo, row[1], err)
+		}
+		outputs = append(outputs, cash.Output{
+			Address: address,
+			Amount:  amount,
+		})
+	}
+}
+
+// sourceInputs returns inputs that take from the source account the total
+// amount of all outputs, one input per currency.
+func sourceInputs(src weave.Address, outputs []cash.Output) ([]cash.Input, error) {
+	var total coin.Coins
+	for _, o := range outputs {
+		var err error
+		total, err = total.Add(o.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+	inputs := make([]cash.Input, 0, len(total))
+	for _, c := range total {
+		inputs = append(inputs, cash.Input{
+			Address: src,
+			Amount:  *c,
+		})
+	}
+	return inputs, nil
+}
+
 func cmdWithFee(input io.Reader, output io.Writer, args []string) error {
 	fl := flag.NewFlagSet("", flag.ExitOnError)
 	fl.Usage = func() {
@@ -118,3 +207,41 @@ func cashGconf(nodeUrl string) (*cash.Configuration, error) {
 	}
 	return &conf, nil
 }
+
+func cmdBalance(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Query a node for the balance of an account. Each amount is displayed using the
+number of decimals declared by its currency.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
+		addrFl = flAddress(fl, "addr", "", "An account address that the balance is displayed for.")
+	)
+	fl.Parse(args)
+
+	if len(*addrFl) == 0 {
+		flagDie("address is required")
+	}
+
+	bnsClient := client.NewClient(client.NewHTTPConnection(*tmAddrFl))
+	currencies, err := bnsClient.Currencies()
+	if err != nil {
+		return fmt.Errorf("cannot fetch currencies: %s", err)
+	}
+	resp, err := bnsClient.GetWallet(*addrFl)
+	if err != nil {
+		return fmt.Errorf("cannot fetch wallet: %s", err)
+	}
+	if resp == nil {
+		return nil
+	}
+	for _, c := range resp.Wallet.Coins {
+		fmt.Fprintln(output, currencies.Format(*c))
+	}
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
@@ -20,8 +22,9 @@ Create a transaction for releasing funds from given escrow.
 		fl.PrintDefaults()
 	}
 	var (
-		escrowFl = flSeq(fl, "escrow", "", "An ID of an escrow that is to be released.")
-		amountFl = flCoin(fl, "amount", "", "Optional amount that is to be transferred from the escrow. The whole escrow hold amount is used if no value is provided.")
+		escrowFl     = flSeq(fl, "escrow", "", "An ID of an escrow that is to be released.")
+		amountFl     = flCoin(fl, "amount", "", "Optional amount that is to be transferred from the escrow. The whole escrow hold amount is used if no value is provided.")
+		milestonesFl = fl.String("milestones", "", "Optional comma separated list of indexes of milestones that are to be released. Cannot be used together with amount.")
 	)
 	fl.Parse(args)
 
@@ -29,12 +32,24 @@ Create a transaction for releasing funds from given escrow.
 	if !coin.IsEmpty(amountFl) {
 		amount = append(amount, amountFl)
 	}
+	var milestones []uint32
+	for _, m := range strings.Split(*milestonesFl, ",") {
+		if m = strings.TrimSpace(m); m == "" {
+			continue
+		}
+		n, err := strconv.ParseUint(m, 10, 32)
+		if err != nil {
+			flagDie("invalid milestone %q: %s", m, err)
+		}
+		milestones = append(milestones, uint32(n))
+	}
 	tx := &bnsd.Tx{
 		Sum: &bnsd.Tx_EscrowReleaseMsg{
 			EscrowReleaseMsg: &escrow.ReleaseMsg{
-				Metadata: &weave.Metadata{Schema: 1},
-				EscrowId: *escrowFl,
-				Amount:   amount,
+				Metadata:   &weave.Metadata{Schema: 1},
+				EscrowId:   *escrowFl,
+				Amount:     amount,
+				Milestones: milestones,
 			},
 		},
 	}
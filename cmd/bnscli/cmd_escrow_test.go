@@ -33,3 +33,29 @@ func TestCmdReleaseEscrowHappyPath(t *testing.T) {
 	assert.Equal(t, sequenceID(5), []byte(msg.EscrowId))
 	assert.Equal(t, []*coin.Coin{coin.NewCoinp(49, 0, "DOGE")}, msg.Amount)
 }
+
+func TestCmdReleaseEscrowMilestones(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-escrow", "5",
+		"-milestones", "0, 2",
+	}
+	if err := cmdReleaseEscrow(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new release escrow transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot read created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*escrow.ReleaseMsg)
+
+	assert.Equal(t, sequenceID(5), []byte(msg.EscrowId))
+	assert.Equal(t, 0, len(msg.Amount))
+	assert.Equal(t, []uint32{0, 2}, msg.Milestones)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/cmd/bnsd/x/username"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/currency"
@@ -345,6 +346,41 @@ Vote on a governance proposal.
 	return err
 }
 
+func cmdVeto(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Veto an accepted governance proposal that is pending execution.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		id        = flSeq(fl, "proposal-id", "", "The ID of the proposal to veto.")
+		electorFl = flHex(fl, "elector", "", "Optional address of an elector. If not provided the main signer will be used.")
+	)
+	fl.Parse(args)
+	if len(*id) == 0 {
+		flagDie("the proposal id  must not be empty")
+	}
+	if len(*electorFl) != 0 {
+		if err := weave.Address(*electorFl).Validate(); err != nil {
+			flagDie("invalid elector address: %q", err)
+		}
+	}
+
+	govTx := &bnsd.Tx{
+		Sum: &bnsd.Tx_GovVetoMsg{
+			GovVetoMsg: &gov.VetoMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: []byte(*id),
+				Elector:    weave.Address(*electorFl),
+			},
+		},
+	}
+	_, err := writeTx(output, govTx)
+	return err
+}
+
 func cmdTextResolution(input io.Reader, output io.Writer, args []string) error {
 	fl := flag.NewFlagSet("", flag.ExitOnError)
 	fl.Usage = func() {
@@ -458,6 +494,9 @@ Creates a new version for an existing election rule. The new version is used for
 		numeratorFl   = fl.Int("threshold-numerator", 0, "The top number of the fraction.")
 		denominatorFl = fl.Uint("threshold-denominator", 0, "The bottom number of the fraction")
 		quorumFl      = flFraction(fl, "quorum", "", "New quorum fraction in format <numerator>/<denominator>. Zero quorum deletes the value.")
+		depositFl     = flCoin(fl, "deposit", "", "Deposit required to create a proposal. Zero deposit deletes the value.")
+		collectorFl   = flAddress(fl, "deposit-collector", "", "Address receiving deposits of rejected proposals. If not set, deposits are burned.")
+		delayFl       = fl.Int("execution-delay", 0, "Duration in seconds between the acceptance and the execution of a proposal. During this time the proposal can be vetoed.")
 	)
 	fl.Parse(args)
 	if len(*id) == 0 {
@@ -478,14 +517,99 @@ Creates a new version for an existing election rule. The new version is used for
 		quorum = frac
 	}
 
+	var deposit *coin.Coin
+	if !depositFl.IsZero() {
+		deposit = depositFl
+	}
+
 	govTx := &bnsd.Tx{
 		Sum: &bnsd.Tx_GovUpdateElectionRuleMsg{
 			GovUpdateElectionRuleMsg: &gov.UpdateElectionRuleMsg{
-				Metadata:       &weave.Metadata{Schema: 1},
-				ElectionRuleID: []byte(*id),
-				VotingPeriod:   weave.AsUnixDuration(time.Duration(*durationFl) * time.Second),
-				Threshold:      fraction,
-				Quorum:         quorum,
+				Metadata:         &weave.Metadata{Schema: 1},
+				ElectionRuleID:   []byte(*id),
+				VotingPeriod:     weave.AsUnixDuration(time.Duration(*durationFl) * time.Second),
+				Threshold:        fraction,
+				Quorum:           quorum,
+				ProposalDeposit:  deposit,
+				DepositCollector: *collectorFl,
+				ExecutionDelay:   weave.AsUnixDuration(time.Duration(*delayFl) * time.Second),
+			},
+		},
+	}
+	_, err := writeTx(output, govTx)
+	return err
+}
+
+func cmdDelegate(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Delegate the voting power of an elector to another elector of the same electorate.
+The delegated weight is counted for the option voted by the delegatee, unless
+the delegator votes directly.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		id          = flSeq(fl, "electorate-id", "", "The ID of the electorate.")
+		delegatorFl = flHex(fl, "delegator", "", "Optional address of a delegator. If not provided the main signer will be used.")
+		delegateeFl = flAddress(fl, "delegatee", "", "Address of the elector receiving the voting power.")
+	)
+	fl.Parse(args)
+	if len(*id) == 0 {
+		flagDie("the electorate id must not be empty")
+	}
+	if len(*delegatorFl) != 0 {
+		if err := weave.Address(*delegatorFl).Validate(); err != nil {
+			flagDie("invalid delegator address: %q", err)
+		}
+	}
+	if len(*delegateeFl) == 0 {
+		flagDie("the delegatee must not be empty")
+	}
+
+	govTx := &bnsd.Tx{
+		Sum: &bnsd.Tx_GovDelegateMsg{
+			GovDelegateMsg: &gov.DelegateMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: []byte(*id),
+				Delegator:    weave.Address(*delegatorFl),
+				Delegatee:    *delegateeFl,
+			},
+		},
+	}
+	_, err := writeTx(output, govTx)
+	return err
+}
+
+func cmdRevokeDelegation(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Revoke an existing voting power delegation of an elector.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		id          = flSeq(fl, "electorate-id", "", "The ID of the electorate.")
+		delegatorFl = flHex(fl, "delegator", "", "Optional address of a delegator. If not provided the main signer will be used.")
+	)
+	fl.Parse(args)
+	if len(*id) == 0 {
+		flagDie("the electorate id must not be empty")
+	}
+	if len(*delegatorFl) != 0 {
+		if err := weave.Address(*delegatorFl).Validate(); err != nil {
+			flagDie("invalid delegator address: %q", err)
+		}
+	}
+
+	govTx := &bnsd.Tx{
+		Sum: &bnsd.Tx_GovRevokeDelegationMsg{
+			GovRevokeDelegationMsg: &gov.RevokeDelegationMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: []byte(*id),
+				Delegator:    weave.Address(*delegatorFl),
 			},
 		},
 	}
@@ -1,14 +1,18 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 
+	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/crypto"
+	"github.com/tyler-smith/go-bip39"
 	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 func cmdKeygen(input io.Reader, output io.Writer, args []string) error {
@@ -19,25 +23,87 @@ Generate a new private key.
 
 When successful a new file with binary content containing private key is
 created. This command fails if the private key file already exists.
+
+Supported key types are ed25519 (default) and secp256k1.
+
+Use -import flag to store a BIP39 mnemonic read from the standard input
+instead of generating a new key. Keys are derived from the mnemonic using
+SLIP-10 when used. Use mnemonic command to generate a new mnemonic.
+
+Use -name flag to store the key in the keystore instead of a file. Keystore
+keys are encrypted with a passphrase that is read from the terminal or from
+the BNSCLI_PASSPHRASE environment variable. A mnemonic imported into the
+keystore is used to derive a single key using -path derivation path.
 `)
 		fl.PrintDefaults()
 	}
 	var (
 		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
+		keyTypeFl = fl.String("type", "ed25519", "Type of the generated key. Either ed25519 or secp256k1.")
+		importFl  = fl.Bool("import", false, "Read a BIP39 mnemonic from the standard input and store it instead of generating a new key.")
+		nameFl    = fl.String("name", "", "Name of the key in the keystore. When provided, the key is encrypted and stored in the keystore instead of the -key file.")
+		hdPathFl  = fl.String("path", "",
+			"Derivation path of the key when a mnemonic is imported into the keystore. Defaults to "+defaultHDPath+".")
 	)
 	fl.Parse(args)
 
-	if _, err := os.Stat(*keyPathFl); !os.IsNotExist(err) {
-		// Do not allow to overwrite already existing private key. User
-		// must manually delete it first to ensure we do not delete
-		// such crucial data by an accident (bad command usage).
-		return fmt.Errorf("private key file %q already exists, delete this file and try again", *keyPathFl)
+	if *nameFl != "" {
+		if !client.ValidKeyName(*nameFl) {
+			return fmt.Errorf("invalid key name %q", *nameFl)
+		}
+		if client.NewKeystore(keystoreDir()).Has(*nameFl) {
+			return fmt.Errorf("key %q already exists, delete it and try again", *nameFl)
+		}
+	} else {
+		if *hdPathFl != "" {
+			return errors.New("derivation path can be used only together with the key name")
+		}
+		if _, err := os.Stat(*keyPathFl); !os.IsNotExist(err) {
+			// Do not allow to overwrite already existing private key. User
+			// must manually delete it first to ensure we do not delete
+			// such crucial data by an accident (bad command usage).
+			return fmt.Errorf("private key file %q already exists, delete this file and try again", *keyPathFl)
+		}
 	}
 
-	_, priv, err := ed25519.GenerateKey(nil)
-	if err != nil {
-		return fmt.Errorf("cannot generate ed25519 key: %s", err)
+	var priv []byte
+	switch {
+	case *importFl:
+		raw, err := ioutil.ReadAll(input)
+		if err != nil {
+			return fmt.Errorf("cannot read mnemonic: %s", err)
+		}
+		mnemonic, ok := mnemonicFromFile(raw)
+		if !ok {
+			return errors.New("invalid mnemonic")
+		}
+		priv = []byte(mnemonic)
+	case *keyTypeFl == "ed25519":
+		_, key, err := ed25519.GenerateKey(nil)
+		if err != nil {
+			return fmt.Errorf("cannot generate ed25519 key: %s", err)
+		}
+		priv = key
+	case *keyTypeFl == "secp256k1":
+		priv = crypto.GenPrivKeySecp256k1().GetSecp256K1()
+	default:
+		return fmt.Errorf("unsupported key type %q", *keyTypeFl)
+	}
+
+	if *nameFl != "" {
+		key, err := parsePrivateKey(priv, *hdPathFl)
+		if err != nil {
+			return fmt.Errorf("cannot decode private key: %s", err)
+		}
+		passphrase, err := readPassphrase(fmt.Sprintf("Passphrase for %q key: ", *nameFl), true)
+		if err != nil {
+			return fmt.Errorf("cannot read passphrase: %s", err)
+		}
+		if err := client.NewKeystore(keystoreDir()).Save(*nameFl, key, passphrase, false); err != nil {
+			return fmt.Errorf("cannot save key: %s", err)
+		}
+		return nil
 	}
 
 	fd, err := os.OpenFile(*keyPathFl, os.O_CREATE|os.O_WRONLY, 0400)
@@ -60,29 +126,161 @@ func cmdKeyaddr(input io.Reader, output io.Writer, args []string) error {
 	fl.Usage = func() {
 		fmt.Fprint(flag.CommandLine.Output(), `
 Print out a hex-address associated with your private key.
+
+If the private key file contains a mnemonic, the key is derived using given
+derivation path.
 `)
 		fl.PrintDefaults()
 	}
 	var (
 		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
-			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
+			"Name of the keystore key or path to the private key file. You can use BNSCLI_PRIV_KEY environment variable to set it.")
+		hdPathFl = fl.String("path", "",
+			"Derivation path of the key when the key file contains a mnemonic. Defaults to "+defaultHDPath+".")
 	)
 	fl.Parse(args)
 
-	raw, err := ioutil.ReadFile(*keyPathFl)
+	key, err := loadPrivateKey(*keyPathFl, *hdPathFl)
+	if err != nil {
+		return fmt.Errorf("cannot load private key: %s", err)
+	}
+	_, err = fmt.Fprintln(output, key.PublicKey().Address())
+	return err
+}
+
+func cmdKeylist(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Print out the name and the hex-address of all keys stored in the keystore.
+
+Listing keys does not require a passphrase.
+`)
+		fl.PrintDefaults()
+	}
+	fl.Parse(args)
+
+	infos, err := client.NewKeystore(keystoreDir()).List()
 	if err != nil {
-		return fmt.Errorf("cannot read private key file: %s", err)
+		return fmt.Errorf("cannot list keys: %s", err)
+	}
+	for _, info := range infos {
+		if _, err := fmt.Fprintf(output, "%s\t%s\n", info.Name, info.Address); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	if len(raw) != ed25519.PrivateKeySize {
-		return fmt.Errorf("invalid private key length: %d", len(raw))
+func cmdKeydel(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Delete a key from the keystore.
+
+This operation cannot be undone. Make sure you have a backup of the key or
+that it is no longer needed.
+`)
+		fl.PrintDefaults()
 	}
+	var (
+		nameFl = fl.String("name", "", "Name of the key that should be deleted.")
+	)
+	fl.Parse(args)
 
-	key := &crypto.PrivateKey{
-		Priv: &crypto.PrivateKey_Ed25519{
-			Ed25519: raw,
-		},
+	ks := client.NewKeystore(keystoreDir())
+	if !ks.Has(*nameFl) {
+		return fmt.Errorf("key %q does not exist", *nameFl)
 	}
-	_, err = fmt.Fprintln(output, key.PublicKey().Address())
+	if err := ks.Delete(*nameFl); err != nil {
+		return fmt.Errorf("cannot delete key: %s", err)
+	}
+	return nil
+}
+
+// keystoreDir returns the directory of the keystore used to store named
+// keys.
+func keystoreDir() string {
+	return env("BNSCLI_KEYSTORE", os.Getenv("HOME")+"/.bnscli/keystore")
+}
+
+// loadPrivateKey returns the private key referenced by the value of a -key
+// flag. The value is either a name of a keystore key or a path to a private
+// key file. Keystore keys are decrypted using a passphrase.
+func loadPrivateKey(key, hdpath string) (*crypto.PrivateKey, error) {
+	ks := client.NewKeystore(keystoreDir())
+	if !ks.Has(key) {
+		return decodePrivateKey(key, hdpath)
+	}
+	if hdpath != "" {
+		return nil, errors.New("derivation path can be used only with a mnemonic")
+	}
+	passphrase, err := readPassphrase(fmt.Sprintf("Passphrase for %q key: ", key), false)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read passphrase: %s", err)
+	}
+	return ks.Load(key, passphrase)
+}
+
+// readPassphrase returns the passphrase provided with the BNSCLI_PASSPHRASE
+// environment variable. If not set, the passphrase is read from the terminal.
+// Standard input is not used, because it is often a part of a pipeline.
+func readPassphrase(prompt string, confirm bool) ([]byte, error) {
+	if p, ok := os.LookupEnv("BNSCLI_PASSPHRASE"); ok {
+		return []byte(p), nil
+	}
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open terminal, use BNSCLI_PASSPHRASE environment variable instead: %s", err)
+	}
+	defer tty.Close()
+
+	fmt.Fprint(tty, prompt)
+	passphrase, err := terminal.ReadPassword(int(tty.Fd()))
+	fmt.Fprintln(tty)
+	if err != nil {
+		return nil, err
+	}
+	if !confirm {
+		return passphrase, nil
+	}
+
+	fmt.Fprint(tty, "Repeat passphrase: ")
+	repeated, err := terminal.ReadPassword(int(tty.Fd()))
+	fmt.Fprintln(tty)
+	if err != nil {
+		return nil, err
+	}
+	if string(passphrase) != string(repeated) {
+		return nil, errors.New("passphrases do not match")
+	}
+	return passphrase, nil
+}
+
+func cmdMnemonic(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Generate a new BIP39 mnemonic and write it to the standard output.
+
+Use keygen -import to store the mnemonic as your private key. Keys are then
+derived from the mnemonic using SLIP-10.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		sizeFl = fl.Int("size", 256, "Entropy size in bits. Must be a multiple of 32 between 128 and 256.")
+	)
+	fl.Parse(args)
+
+	entropy, err := bip39.NewEntropy(*sizeFl)
+	if err != nil {
+		return fmt.Errorf("cannot generate entropy: %s", err)
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return fmt.Errorf("cannot create mnemonic: %s", err)
+	}
+	_, err = fmt.Fprintln(output, mnemonic)
 	return err
 }
@@ -4,9 +4,11 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/x/multisig"
 )
 
@@ -20,6 +22,10 @@ provided.
 
 Created message does not contain any participants details. Attaching
 participants must be done by another command.
+
+A spending policy can be configured to allow sending funds from the contract
+with a weight lower than the activation threshold, as long as the amount sent
+within a spending period does not exceed the limit.
 		`)
 		fl.PrintDefaults()
 	}
@@ -27,6 +33,10 @@ participants must be done by another command.
 		updateFl              = flSeq(fl, "update", "", "If a multisig contract ID is provided, a multisig contract update instead of creation message is created.")
 		activationThresholdFl = fl.Uint("activation", 0, "Activation threshold value. Must be greater than 0.")
 		adminThresholdFl      = fl.Uint("admin", 0, "Admin threshold value. Must be greater than 0.")
+		adminTimelockFl       = fl.Int("admin-timelock", 0, "Duration in seconds after which an update of the contract takes effect. Zero means that updates are applied immediately.")
+		spendThresholdFl      = fl.Uint("spending-threshold", 0, "Spending policy threshold value. If greater than 0, a spending policy is configured. Must be lower than the activation threshold.")
+		spendPeriodFl         = fl.Int("spending-period", 86400, "Duration in seconds of a single spending period.")
+		spendLimitFl          = flCoin(fl, "spending-limit", "", "Maximum amount that can be sent within a spending period using the spending policy threshold.")
 	)
 	fl.Parse(args)
 
@@ -36,6 +46,22 @@ participants must be done by another command.
 	if *adminThresholdFl == 0 {
 		flagDie("admin threshold cannot be zero")
 	}
+	if *adminTimelockFl < 0 {
+		flagDie("admin timelock cannot be negative")
+	}
+
+	var policy *multisig.SpendingPolicy
+	if *spendThresholdFl != 0 {
+		if spendLimitFl.IsZero() {
+			flagDie("spending limit is required")
+		}
+		policy = &multisig.SpendingPolicy{
+			Threshold: multisig.Weight(*spendThresholdFl),
+			Period:    weave.AsUnixDuration(time.Duration(*spendPeriodFl) * time.Second),
+			Limit:     []*coin.Coin{spendLimitFl},
+		}
+	}
+	adminTimelock := weave.AsUnixDuration(time.Duration(*adminTimelockFl) * time.Second)
 
 	var tx bnsd.Tx
 
@@ -47,6 +73,8 @@ participants must be done by another command.
 					ContractID:          *updateFl,
 					ActivationThreshold: multisig.Weight(*activationThresholdFl),
 					AdminThreshold:      multisig.Weight(*adminThresholdFl),
+					SpendingPolicy:      policy,
+					AdminTimelock:       adminTimelock,
 				},
 			},
 		}
@@ -57,6 +85,8 @@ participants must be done by another command.
 					Metadata:            &weave.Metadata{Schema: 1},
 					ActivationThreshold: multisig.Weight(*activationThresholdFl),
 					AdminThreshold:      multisig.Weight(*adminThresholdFl),
+					SpendingPolicy:      policy,
+					AdminTimelock:       adminTimelock,
 				},
 			},
 		}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/x/session"
+)
+
+func cmdRegisterSessionKey(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for registering a session key for the main signer.
+
+A transaction signed with the session key is authorized by the main signer
+account, but only for the allowed message paths and only up to the spend cap.
+Funds sent from the account and fees paid by the account are counted toward
+the spend cap.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		keyFl    = flAddress(fl, "key", "", "Address of the session key.")
+		pathsFl  = fl.String("paths", "", "Comma separated list of message paths that the session key can authorize. For example 'username/change_token_targets'.")
+		capFl    = flCoin(fl, "spend-cap", "", "Maximum amount that can be spent from the account using the session key. If not provided, the session key cannot spend funds.")
+		expireFl = flTime(fl, "expire", nil, "Optional time after which the session key can no longer be used. Use UTC time and "+flagTimeFormat+" format.")
+	)
+	fl.Parse(args)
+
+	msg := session.RegisterKeyMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Key:      *keyFl,
+	}
+	for _, p := range strings.Split(*pathsFl, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			msg.AllowedPaths = append(msg.AllowedPaths, p)
+		}
+	}
+	if !capFl.IsZero() {
+		msg.SpendCap = []*coin.Coin{capFl}
+	}
+	if !expireFl.Time().IsZero() {
+		msg.ExpiresAt = expireFl.UnixTime()
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_SessionRegisterKeyMsg{
+			SessionRegisterKeyMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdRevokeSessionKey(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for revoking a session key. Only the account that
+registered the session key can revoke it.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		idFl = flSeq(fl, "id", "", "The ID of the session key.")
+	)
+	fl.Parse(args)
+	if len(*idFl) == 0 {
+		flagDie("the session key id must not be empty")
+	}
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_SessionRevokeKeyMsg{
+			SessionRevokeKeyMsg: &session.RevokeKeyMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				SessionKeyID: []byte(*idFl),
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
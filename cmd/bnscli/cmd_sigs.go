@@ -10,8 +10,11 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/x/multisig"
 	"github.com/iov-one/weave/x/sigs"
 )
 
@@ -27,6 +30,14 @@ Sign given transaction. This is decoding a transaction data from standard
 input, adds a signature and writes back to standard output signed transaction
 content.
 
+The -key flag accepts either a name of a keystore key or a path to the
+private key file. Keystore keys are encrypted and require a passphrase that
+is read from the terminal or from the BNSCLI_PASSPHRASE environment variable.
+
+Use -detached flag to write only the signature instead of the signed
+transaction. Signatures created independently by each participant of a
+multisig contract can be combined using the merge-signatures command.
+
 `)
 		fl.PrintDefaults()
 	}
@@ -34,14 +45,21 @@ content.
 		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
 			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
 		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
-			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
+			"Name of the keystore key or path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
+		hdPathFl = fl.String("path", "",
+			"Derivation path of the key when the key file contains a mnemonic. Defaults to "+defaultHDPath+".")
+		detachedFl = fl.Bool("detached", false, "Write only the signature instead of the signed transaction.")
+		laneFl     = fl.Uint("lane", 0, fmt.Sprintf("Nonce lane used to sign the transaction. Each lane maintains an independent sequence. Must not be greater than %d.", sigs.MaxLane))
 	)
 	fl.Parse(args)
 
 	if *keyPathFl == "" {
 		return errors.New("private key is required")
 	}
-	key, err := decodePrivateKey(*keyPathFl)
+	if *laneFl > sigs.MaxLane {
+		flagDie("lane must not be greater than %d", sigs.MaxLane)
+	}
+	key, err := loadPrivateKey(*keyPathFl, *hdPathFl)
 	if err != nil {
 		return fmt.Errorf("cannot load private key: %s", err)
 	}
@@ -57,14 +75,22 @@ content.
 	}
 
 	bnsClient := client.NewClient(client.NewHTTPConnection(*tmAddrFl))
-	aNonce := client.NewNonce(bnsClient, key.PublicKey().Address())
+	aNonce := client.NewLaneNonce(bnsClient, key.PublicKey().Address(), uint32(*laneFl))
 	if seq, err := aNonce.Next(); err != nil {
 		return fmt.Errorf("cannot get the next sequence number: %s", err)
 	} else {
-		sig, err := sigs.SignTx(key, tx, genesis.ChainID, seq)
+		sig, err := sigs.SignLaneTx(key, tx, genesis.ChainID, aNonce.Lane(), seq)
 		if err != nil {
 			return fmt.Errorf("cannot sign transaction: %s", err)
 		}
+		if *detachedFl {
+			raw, err := sig.Marshal()
+			if err != nil {
+				return fmt.Errorf("cannot serialize signature: %s", err)
+			}
+			_, err = output.Write(raw)
+			return err
+		}
 		tx.Signatures = append(tx.Signatures, sig)
 	}
 
@@ -72,18 +98,255 @@ content.
 	return err
 }
 
-func decodePrivateKey(filepath string) (*crypto.PrivateKey, error) {
+func cmdWithExpiration(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Read a transaction from the input and set its expiration. An expired
+transaction is rejected and cannot be replayed, for example on a hard-forked
+chain that is using the same chain ID.
+
+Expiration must be set before the transaction is signed.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		heightFl = fl.Int64("height", 0, "Last block height at which the transaction is valid.")
+		timeFl   = flTime(fl, "time", nil, "Time at which the transaction expires. Use UTC time and "+flagTimeFormat+" format.")
+	)
+	fl.Parse(args)
+
+	tx, _, err := readTx(input)
+	if err != nil {
+		return fmt.Errorf("cannot read input transaction: %s", err)
+	}
+
+	exp := &sigs.TxExpiration{Height: *heightFl}
+	if !timeFl.Time().IsZero() {
+		exp.Time = timeFl.UnixTime()
+	}
+	if err := exp.Validate(); err != nil {
+		flagDie("invalid expiration: %s", err)
+	}
+	tx.Expiration = exp
+
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdMergeSignatures(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Read a transaction from the input and attach detached signatures read from
+files given as arguments. Detached signatures are created using sign -detached
+command, so that each signer can sign the same transaction independently.
+
+Each signature is verified before it is attached. If the transaction is using
+multisig contracts, the total weight of all signatures must reach the
+activation threshold of every contract. A participant that is another multisig
+contract counts only if that contract is listed before in the transaction and
+was activated. Contracts are fetched from the node.
+
+  $ bnscli merge-signatures alice.sig bob.sig < tx.bin | bnscli submit
+
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
+	)
+	fl.Parse(args)
+
+	if fl.NArg() == 0 {
+		flagDie("at least one signature file is required")
+	}
+
+	tx, _, err := readTx(input)
+	if err != nil {
+		return fmt.Errorf("cannot read transaction: %s", err)
+	}
+
+	detached := make([]*sigs.StdSignature, 0, fl.NArg())
+	for _, path := range fl.Args() {
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("cannot read %q signature file: %s", path, err)
+		}
+		var sig sigs.StdSignature
+		if err := sig.Unmarshal(raw); err != nil {
+			return fmt.Errorf("cannot deserialize %q signature: %s", path, err)
+		}
+		detached = append(detached, &sig)
+	}
+
+	genesis, err := fetchGenesis(*tmAddrFl)
+	if err != nil {
+		return fmt.Errorf("cannot fetch genesis: %s", err)
+	}
+
+	store := tendermintStore(*tmAddrFl)
+	contracts := make([]*multisig.Contract, 0, len(tx.Multisig))
+	if len(tx.Multisig) != 0 {
+		bucket := multisig.NewContractBucket()
+		for _, id := range tx.Multisig {
+			var c multisig.Contract
+			if err := bucket.One(store, id, &c); err != nil {
+				return fmt.Errorf("cannot fetch %x multisig contract: %s", id, err)
+			}
+			contracts = append(contracts, &c)
+		}
+	}
+
+	if err := mergeSignatures(tx, genesis.ChainID, store, contracts, detached); err != nil {
+		return err
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+// mergeSignatures verifies detached signatures and attaches them to the
+// transaction. A signature created on behalf of an account with a rotated key
+// is verified against the controller key of that account, loaded from the
+// store. If multisig contracts are provided, all signatures together
+// must have enough weight to activate each contract. Contracts must be given
+// in the same order as their IDs are listed in the transaction, so that
+// nested contracts are resolved the same way the multisig decorator does.
+func mergeSignatures(
+	tx *bnsd.Tx,
+	chainID string,
+	db weave.ReadOnlyKVStore,
+	contracts []*multisig.Contract,
+	detached []*sigs.StdSignature,
+) error {
+	signed := make(map[string]struct{})
+	for _, sig := range tx.Signatures {
+		signed[signerAddress(sig).String()] = struct{}{}
+	}
+
+	for i, sig := range detached {
+		if err := sig.Validate(); err != nil {
+			return fmt.Errorf("invalid signature #%d: %s", i, err)
+		}
+		signer := signerAddress(sig)
+		if _, ok := signed[signer.String()]; ok {
+			return fmt.Errorf("signature #%d: %s already signed the transaction", i, signer)
+		}
+		key := sig.Pubkey
+		if len(sig.Account) != 0 {
+			controller, err := accountController(db, sig.Account)
+			if err != nil {
+				return fmt.Errorf("signature #%d: %s", i, err)
+			}
+			if !controller.Address().Equals(sig.Pubkey.Address()) {
+				return fmt.Errorf("signature #%d: %s is not the %s account controller", i, sig.Pubkey.Address(), sig.Account)
+			}
+			key = controller
+		}
+		signBytes, err := tx.GetSignBytes()
+		if err != nil {
+			return fmt.Errorf("cannot build sign bytes: %s", err)
+		}
+		signBytes, err = sigs.BuildAccountSignBytes(signBytes, chainID, sig.Account, sig.Lane, sig.Sequence)
+		if err != nil {
+			return fmt.Errorf("cannot build sign bytes: %s", err)
+		}
+		if !key.Verify(signBytes, sig.Signature) {
+			return fmt.Errorf("signature #%d: invalid %s signature", i, signer)
+		}
+		signed[signer.String()] = struct{}{}
+		tx.Signatures = append(tx.Signatures, sig)
+	}
+
+	if len(contracts) != len(tx.Multisig) {
+		return fmt.Errorf("%d multisig contracts given for %d contract IDs", len(contracts), len(tx.Multisig))
+	}
+	for i, c := range contracts {
+		// A contract that was activated by another one is a signer of
+		// all following contracts.
+		condition := multisig.MultiSigCondition(tx.Multisig[i]).Address().String()
+		if _, ok := signed[condition]; ok {
+			continue
+		}
+		var weight multisig.Weight
+		for _, p := range c.Participants {
+			if _, ok := signed[p.Signature.String()]; ok {
+				weight += p.Weight
+			}
+		}
+		if weight < c.ActivationThreshold {
+			return fmt.Errorf("multisig contract #%d: weight %d is less than the activation threshold %d", i, weight, c.ActivationThreshold)
+		}
+		signed[condition] = struct{}{}
+	}
+	return nil
+}
+
+// signerAddress returns the address that given signature authorizes. This is
+// the account address when signing on behalf of an account.
+func signerAddress(sig *sigs.StdSignature) weave.Address {
+	if len(sig.Account) != 0 {
+		return sig.Account
+	}
+	return sig.Pubkey.Address()
+}
+
+// accountController returns the controller key of an account with a rotated
+// key.
+func accountController(db weave.ReadOnlyKVStore, account weave.Address) (*crypto.PublicKey, error) {
+	obj, err := sigs.NewBucket().Get(db, account)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch %s account: %s", account, err)
+	}
+	user := sigs.AsUser(obj)
+	if user == nil {
+		return nil, fmt.Errorf("unknown %s account", account)
+	}
+	if user.Controller == nil {
+		return nil, fmt.Errorf("%s account key is not rotated", account)
+	}
+	return user.Controller, nil
+}
+
+// decodePrivateKey loads a private key from given file. The file can contain
+// either a raw private key or a BIP39 mnemonic. When a mnemonic is used, an
+// ed25519 key is derived using given SLIP-10 path or the default path if none
+// is provided.
+func decodePrivateKey(filepath, hdpath string) (*crypto.PrivateKey, error) {
 	data, err := ioutil.ReadFile(filepath)
 	if err != nil {
 		return nil, fmt.Errorf("cannot read %q file: %s", filepath, err)
 	}
-	if len(data) != 64 {
-		return nil, errors.New("invalid key length")
+	return parsePrivateKey(data, hdpath)
+}
+
+// parsePrivateKey decodes a private key from the private key file content.
+func parsePrivateKey(data []byte, hdpath string) (*crypto.PrivateKey, error) {
+	if mnemonic, ok := mnemonicFromFile(data); ok {
+		if hdpath == "" {
+			hdpath = defaultHDPath
+		}
+		return deriveEd25519Key(mnemonic, hdpath)
+	}
+	if hdpath != "" {
+		return nil, errors.New("derivation path can be used only with a mnemonic")
 	}
-	key := &crypto.PrivateKey{
-		Priv: &crypto.PrivateKey_Ed25519{Ed25519: data},
+	// Key type is recognized by the length of the key.
+	switch len(data) {
+	case 64:
+		key := &crypto.PrivateKey{
+			Priv: &crypto.PrivateKey_Ed25519{Ed25519: data},
+		}
+		return key, nil
+	case 32:
+		key := &crypto.PrivateKey{
+			Priv: &crypto.PrivateKey_Secp256K1{Secp256K1: data},
+		}
+		return key, nil
+	default:
+		return nil, errors.New("invalid key length")
 	}
-	return key, nil
 }
 
 func fetchGenesis(serverURL string) (*genesis, error) {
@@ -9,7 +9,13 @@ import (
 
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/sigs"
 )
 
 func TestCmdSignTransactionHappyPath(t *testing.T) {
@@ -44,6 +50,248 @@ func TestCmdSignTransactionHappyPath(t *testing.T) {
 	}
 }
 
+func TestCmdMergeSignaturesHappyPath(t *testing.T) {
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashSendMsg{
+			CashSendMsg: &cash.SendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+		},
+	}
+	var input bytes.Buffer
+	if _, err := writeTx(&input, tx); err != nil {
+		t.Fatalf("cannot marshal transaction: %s", err)
+	}
+
+	var signature bytes.Buffer
+	args := []string{
+		"-tm", tmURL,
+		"-key", mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex))),
+		"-detached",
+	}
+	if err := cmdSignTransaction(bytes.NewReader(input.Bytes()), &signature, args); err != nil {
+		t.Fatalf("transaction signing failed: %s", err)
+	}
+
+	var output bytes.Buffer
+	args = []string{
+		"-tm", tmURL,
+		mustCreateFile(t, &signature),
+	}
+	if err := cmdMergeSignatures(&input, &output, args); err != nil {
+		t.Fatalf("signatures merge failed: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot read created transaction: %s", err)
+	}
+	if n := len(tx.Signatures); n != 1 {
+		t.Fatalf("want one signature, got %d", n)
+	}
+	if got := tx.Signatures[0].Pubkey.Address().String(); got != addr {
+		t.Fatalf("want %s signer, got %s", addr, got)
+	}
+}
+
+func TestMergeSignatures(t *testing.T) {
+	const chainID = "test-chain"
+
+	alice := crypto.GenPrivKeyEd25519()
+	bob := crypto.GenPrivKeyEd25519()
+	carol := crypto.GenPrivKeyEd25519()
+
+	newTx := func() *bnsd.Tx {
+		return &bnsd.Tx{
+			Sum: &bnsd.Tx_CashSendMsg{
+				CashSendMsg: &cash.SendMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					Memo:     "merge signatures test",
+				},
+			},
+		}
+	}
+	sign := func(key *crypto.PrivateKey) *sigs.StdSignature {
+		sig, err := sigs.SignTx(key, newTx(), chainID, 0)
+		if err != nil {
+			t.Fatalf("cannot sign: %s", err)
+		}
+		return sig
+	}
+	contract := &multisig.Contract{
+		Participants: []*multisig.Participant{
+			{Signature: alice.PublicKey().Address(), Weight: 1},
+			{Signature: bob.PublicKey().Address(), Weight: 2},
+		},
+		ActivationThreshold: 2,
+	}
+	contractID := weavetest.SequenceID(1)
+
+	// Outer contract can be activated only together with the inner one.
+	inner := &multisig.Contract{
+		Participants: []*multisig.Participant{
+			{Signature: alice.PublicKey().Address(), Weight: 1},
+			{Signature: bob.PublicKey().Address(), Weight: 1},
+		},
+		ActivationThreshold: 2,
+	}
+	innerID := weavetest.SequenceID(2)
+	outer := &multisig.Contract{
+		Participants: []*multisig.Participant{
+			{Signature: multisig.MultiSigCondition(innerID).Address(), Weight: 2},
+			{Signature: carol.PublicKey().Address(), Weight: 1},
+		},
+		ActivationThreshold: 2,
+	}
+	outerID := weavetest.SequenceID(3)
+
+	// Dave lost the account key and the account is controlled by Erin.
+	dave := crypto.GenPrivKeyEd25519()
+	erin := crypto.GenPrivKeyEd25519()
+	account := dave.PublicKey().Address()
+	db := store.MemStore()
+	migration.MustInitPkg(db, "sigs")
+	if err := sigs.NewBucket().Save(db, sigs.NewUser(dave.PublicKey())); err != nil {
+		t.Fatalf("cannot save account: %s", err)
+	}
+	if err := sigs.RotateKey(db, account, erin.PublicKey()); err != nil {
+		t.Fatalf("cannot rotate account key: %s", err)
+	}
+	signAccount := func(key *crypto.PrivateKey, account weave.Address) *sigs.StdSignature {
+		sig, err := sigs.SignAccountTx(key, newTx(), chainID, account, 0, 0)
+		if err != nil {
+			t.Fatalf("cannot sign: %s", err)
+		}
+		return sig
+	}
+	accountContract := &multisig.Contract{
+		Participants: []*multisig.Participant{
+			{Signature: account, Weight: 2},
+			{Signature: erin.PublicKey().Address(), Weight: 1},
+		},
+		ActivationThreshold: 2,
+	}
+
+	otherMsg := newTx()
+	otherMsg.GetCashSendMsg().Memo = "a different message"
+	invalidSig, err := sigs.SignTx(alice, otherMsg, chainID, 0)
+	if err != nil {
+		t.Fatalf("cannot sign: %s", err)
+	}
+
+	cases := map[string]struct {
+		Signed    []*sigs.StdSignature
+		Multisig  [][]byte
+		Contracts []*multisig.Contract
+		Detached  []*sigs.StdSignature
+		WantErr   bool
+		WantSigs  int
+	}{
+		"no multisig": {
+			Detached: []*sigs.StdSignature{sign(alice), sign(carol)},
+			WantSigs: 2,
+		},
+		"activation threshold reached": {
+			Multisig:  [][]byte{contractID},
+			Contracts: []*multisig.Contract{contract},
+			Detached:  []*sigs.StdSignature{sign(bob)},
+			WantSigs:  1,
+		},
+		"activation threshold reached with already attached signature": {
+			Signed:    []*sigs.StdSignature{sign(alice)},
+			Multisig:  [][]byte{contractID},
+			Contracts: []*multisig.Contract{contract},
+			Detached:  []*sigs.StdSignature{sign(bob)},
+			WantSigs:  2,
+		},
+		"activation threshold not reached": {
+			Multisig:  [][]byte{contractID},
+			Contracts: []*multisig.Contract{contract},
+			Detached:  []*sigs.StdSignature{sign(alice)},
+			WantErr:   true,
+		},
+		"signature of a fee payer that is not a participant": {
+			Multisig:  [][]byte{contractID},
+			Contracts: []*multisig.Contract{contract},
+			Detached:  []*sigs.StdSignature{sign(bob), sign(carol)},
+			WantSigs:  2,
+		},
+		"nested contract activated": {
+			Multisig:  [][]byte{innerID, outerID},
+			Contracts: []*multisig.Contract{inner, outer},
+			Detached:  []*sigs.StdSignature{sign(alice), sign(bob)},
+			WantSigs:  2,
+		},
+		"nested contract not activated": {
+			Multisig:  [][]byte{innerID, outerID},
+			Contracts: []*multisig.Contract{inner, outer},
+			Detached:  []*sigs.StdSignature{sign(alice), sign(carol)},
+			WantErr:   true,
+		},
+		"nested contract listed after the outer one": {
+			Multisig:  [][]byte{outerID, innerID},
+			Contracts: []*multisig.Contract{outer, inner},
+			Detached:  []*sigs.StdSignature{sign(alice), sign(bob)},
+			WantErr:   true,
+		},
+		"missing multisig contract": {
+			Multisig:  [][]byte{innerID, outerID},
+			Contracts: []*multisig.Contract{inner},
+			Detached:  []*sigs.StdSignature{sign(alice), sign(bob)},
+			WantErr:   true,
+		},
+		"signature on behalf of an account": {
+			Detached: []*sigs.StdSignature{signAccount(erin, account)},
+			WantSigs: 1,
+		},
+		"account signature activates a contract": {
+			Multisig:  [][]byte{contractID},
+			Contracts: []*multisig.Contract{accountContract},
+			Detached:  []*sigs.StdSignature{signAccount(erin, account)},
+			WantSigs:  1,
+		},
+		"account signature of not the controller": {
+			Detached: []*sigs.StdSignature{signAccount(carol, account)},
+			WantErr:  true,
+		},
+		"account signature of the original account key": {
+			Detached: []*sigs.StdSignature{signAccount(dave, account)},
+			WantErr:  true,
+		},
+		"signature on behalf of an unknown account": {
+			Detached: []*sigs.StdSignature{signAccount(erin, carol.PublicKey().Address())},
+			WantErr:  true,
+		},
+		"duplicated signer": {
+			Signed:   []*sigs.StdSignature{sign(alice)},
+			Detached: []*sigs.StdSignature{sign(alice)},
+			WantErr:  true,
+		},
+		"signature of a different transaction": {
+			Detached: []*sigs.StdSignature{invalidSig},
+			WantErr:  true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			tx := newTx()
+			tx.Signatures = tc.Signed
+			tx.Multisig = tc.Multisig
+			err := mergeSignatures(tx, chainID, db, tc.Contracts, tc.Detached)
+			if hasErr := err != nil; hasErr != tc.WantErr {
+				t.Fatalf("want error %v, got %v", tc.WantErr, err)
+			}
+			if tc.WantErr {
+				return
+			}
+			if n := len(tx.Signatures); n != tc.WantSigs {
+				t.Fatalf("want %d signatures, got %d", tc.WantSigs, n)
+			}
+		})
+	}
+}
+
 var logRequestFl = flag.Bool("logrequest", false, "Log all requests send to tendermint mock server. This is useful when writing new test. Use curl to send the same request to a real tendermint node and record the response.")
 
 func mustCreateFile(t testing.TB, r io.Reader) string {
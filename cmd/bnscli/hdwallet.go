@@ -0,0 +1,101 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/iov-one/weave/crypto"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/ed25519"
+)
+
+// defaultHDPath is the SLIP-10 derivation path of the first account. 234 is
+// the IOV coin type as registered in SLIP-44.
+const defaultHDPath = "m/44'/234'/0'"
+
+// hardenedOffset is added to the index of hardened path segments.
+const hardenedOffset = 1 << 31
+
+// mnemonicFromFile returns a normalized mnemonic if given key file content
+// is a valid BIP39 mnemonic.
+func mnemonicFromFile(raw []byte) (string, bool) {
+	mnemonic := strings.Join(strings.Fields(string(raw)), " ")
+	if mnemonic == "" || !bip39.IsMnemonicValid(mnemonic) {
+		return "", false
+	}
+	return mnemonic, true
+}
+
+// deriveEd25519Key returns an ed25519 private key derived from given BIP39
+// mnemonic using SLIP-10 derivation path.
+func deriveEd25519Key(mnemonic, path string) (*crypto.PrivateKey, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %s", err)
+	}
+	indexes, err := parseHDPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path: %s", err)
+	}
+	key, chainCode := slip10Master(seed)
+	for _, index := range indexes {
+		key, chainCode = slip10Child(key, chainCode, index)
+	}
+	return &crypto.PrivateKey{
+		Priv: &crypto.PrivateKey_Ed25519{
+			Ed25519: ed25519.NewKeyFromSeed(key),
+		},
+	}, nil
+}
+
+// parseHDPath parses a derivation path as defined by BIP32, for example
+// m/44'/234'/0'. ed25519 supports only hardened derivation, so each segment
+// must be marked as hardened with a ' or h suffix.
+func parseHDPath(path string) ([]uint32, error) {
+	segments := strings.Split(path, "/")
+	if segments[0] != "m" {
+		return nil, errors.New("path must start with m")
+	}
+	indexes := make([]uint32, 0, len(segments)-1)
+	for _, s := range segments[1:] {
+		hardened := strings.HasSuffix(s, "'") || strings.HasSuffix(s, "h")
+		if !hardened {
+			return nil, fmt.Errorf("segment %q is not hardened", s)
+		}
+		n, err := strconv.ParseUint(s[:len(s)-1], 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid segment %q", s)
+		}
+		indexes = append(indexes, uint32(n)+hardenedOffset)
+	}
+	return indexes, nil
+}
+
+// slip10Master returns the ed25519 master key and chain code for a seed.
+func slip10Master(seed []byte) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, []byte("ed25519 seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:]
+}
+
+// slip10Child returns the ed25519 child key and chain code for given hardened
+// index.
+func slip10Child(key, chainCode []byte, index uint32) ([]byte, []byte) {
+	data := make([]byte, 0, 1+32+4)
+	data = append(data, 0)
+	data = append(data, key...)
+	var idx [4]byte
+	binary.BigEndian.PutUint32(idx[:], index)
+	data = append(data, idx[:]...)
+
+	mac := hmac.New(sha512.New, chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:]
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestSlip10Derivation(t *testing.T) {
+	// Test vector 1 for ed25519 from the SLIP-10 specification.
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+
+	cases := map[string]struct {
+		path      string
+		wantKey   string
+		wantChain string
+	}{
+		"master": {
+			path:      "m",
+			wantKey:   "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+			wantChain: "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb",
+		},
+		"first child": {
+			path:      "m/0'",
+			wantKey:   "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+			wantChain: "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69",
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			indexes, err := parseHDPath(tc.path)
+			if err != nil {
+				t.Fatalf("cannot parse path: %s", err)
+			}
+			key, chain := slip10Master(seed)
+			for _, i := range indexes {
+				key, chain = slip10Child(key, chain, i)
+			}
+			if got := hex.EncodeToString(key); got != tc.wantKey {
+				t.Errorf("want key %s, got %s", tc.wantKey, got)
+			}
+			if got := hex.EncodeToString(chain); got != tc.wantChain {
+				t.Errorf("want chain code %s, got %s", tc.wantChain, got)
+			}
+		})
+	}
+}
+
+func TestParseHDPath(t *testing.T) {
+	cases := map[string]struct {
+		path    string
+		want    []uint32
+		wantErr bool
+	}{
+		"default path": {
+			path: defaultHDPath,
+			want: []uint32{44 + hardenedOffset, 234 + hardenedOffset, hardenedOffset},
+		},
+		"h suffix": {
+			path: "m/44h/1h",
+			want: []uint32{44 + hardenedOffset, 1 + hardenedOffset},
+		},
+		"master only": {
+			path: "m",
+			want: []uint32{},
+		},
+		"not hardened": {
+			path:    "m/44'/234'/0",
+			wantErr: true,
+		},
+		"missing master": {
+			path:    "44'/234'",
+			wantErr: true,
+		},
+		"invalid index": {
+			path:    "m/x'",
+			wantErr: true,
+		},
+		"index too big": {
+			path:    "m/2147483648'",
+			wantErr: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			got, err := parseHDPath(tc.path)
+			if hasErr := err != nil; hasErr != tc.wantErr {
+				t.Fatalf("want error %v, got %v", tc.wantErr, err)
+			}
+			if tc.wantErr {
+				return
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("want %v, got %v", tc.want, got)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Fatalf("want %v, got %v", tc.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestDeriveEd25519Key(t *testing.T) {
+	const mnemonic = "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+	first, err := deriveEd25519Key(mnemonic, "m/44'/234'/0'")
+	if err != nil {
+		t.Fatalf("cannot derive key: %s", err)
+	}
+	second, err := deriveEd25519Key(mnemonic, "m/44'/234'/1'")
+	if err != nil {
+		t.Fatalf("cannot derive key: %s", err)
+	}
+	again, err := deriveEd25519Key(mnemonic, "m/44'/234'/0'")
+	if err != nil {
+		t.Fatalf("cannot derive key: %s", err)
+	}
+
+	if n := len(first.GetEd25519()); n != ed25519.PrivateKeySize {
+		t.Fatalf("invalid key size: %d", n)
+	}
+	if first.PublicKey().Address().Equals(second.PublicKey().Address()) {
+		t.Fatal("different paths derived the same key")
+	}
+	if !first.PublicKey().Address().Equals(again.PublicKey().Address()) {
+		t.Fatal("derivation is not deterministic")
+	}
+
+	if _, err := deriveEd25519Key("legal winner thank year", defaultHDPath); err == nil {
+		t.Fatal("invalid mnemonic accepted")
+	}
+}
@@ -38,15 +38,27 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"as-batch":                  cmdAsBatch,
 	"as-proposal":               cmdAsProposal,
 	"as-sequence":               cmdAsSequence,
+	"balance":                   cmdBalance,
+	"create-swap":               cmdCreateSwap,
 	"del-proposal":              cmdDelProposal,
+	"delegate":                  cmdDelegate,
 	"from-sequence":             cmdFromSequence,
 	"keyaddr":                   cmdKeyaddr,
+	"keydel":                    cmdKeydel,
 	"keygen":                    cmdKeygen,
+	"keylist":                   cmdKeylist,
+	"merge-signatures":          cmdMergeSignatures,
+	"mnemonic":                  cmdMnemonic,
 	"multisig":                  cmdMultisig,
+	"register-session-key":      cmdRegisterSessionKey,
 	"register-username":         cmdRegisterUsername,
 	"release-escrow":            cmdReleaseEscrow,
+	"release-swap":              cmdReleaseSwap,
 	"reset-revenue":             cmdResetRevenue,
 	"resolve-username":          cmdResolveUsername,
+	"return-swap":               cmdReturnSwap,
+	"revoke-delegation":         cmdRevokeDelegation,
+	"revoke-session-key":        cmdRevokeSessionKey,
 	"send-tokens":               cmdSendTokens,
 	"set-validators":            cmdSetValidators,
 	"sign":                      cmdSignTransaction,
@@ -55,11 +67,13 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"update-electorate":         cmdUpdateElectorate,
 	"update-election-rule":      cmdUpdateElectionRule,
 	"version":                   cmdVersion,
+	"veto":                      cmdVeto,
 	"view":                      cmdTransactionView,
 	"vote":                      cmdVote,
 	"with-fee":                  cmdWithFee,
 	"with-multisig":             cmdWithMultisig,
 	"with-elector":              cmdWithElector,
+	"with-expiration":           cmdWithExpiration,
 	"with-multisig-participant": cmdWithMultisigParticipant,
 	"with-blockchain-address":   cmdWithBlockchainAddress,
 }
@@ -27,9 +27,12 @@ import (
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/freeze"
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/recovery"
+	"github.com/iov-one/weave/x/session"
 	"github.com/iov-one/weave/x/sigs"
 	"github.com/iov-one/weave/x/utils"
 	"github.com/iov-one/weave/x/validators"
@@ -38,7 +41,7 @@ import (
 // Authenticator returns the typical authentication,
 // just using public key signatures
 func Authenticator() x.Authenticator {
-	return x.ChainAuth(sigs.Authenticate{}, multisig.Authenticate{})
+	return x.ChainAuth(sigs.Authenticate{}, multisig.Authenticate{}, session.Authenticate{})
 }
 
 // Chain returns a chain of decorators, to handle authentication,
@@ -46,16 +49,18 @@ func Authenticator() x.Authenticator {
 func Chain(authFn x.Authenticator, minFee coin.Coin) app.Decorators {
 	// ctrl can be initialized with any implementation, but must be used
 	// consistently everywhere.
-	var ctrl cash.Controller = cash.NewController(cash.NewBucket())
+	var ctrl cash.Controller = cash.NewController(cash.NewBucket(), freeze.NewTransferHook())
 
 	return app.ChainDecorators(
 		utils.NewLogging(),
 		utils.NewRecovery(),
 		utils.NewKeyTagger(),
+		cash.NewHistoryTagger(),
 		// on CheckTx, bad tx don't affect state
 		utils.NewSavepoint().OnCheck(),
 		sigs.NewDecorator(),
 		multisig.NewDecorator(authFn),
+		session.NewDecorator(authFn, ctrl),
 		// cash.NewDynamicFeeDecorator embeds utils.NewSavepoint().OnDeliver()
 		cash.NewDynamicFeeDecorator(authFn, ctrl),
 		msgfee.NewAntispamFeeDecorator(minFee),
@@ -67,7 +72,7 @@ func Chain(authFn x.Authenticator, minFee coin.Coin) app.Decorators {
 
 // ctrl can be initialized with any implementation, but must be used
 // consistently everywhere.
-var ctrl = cash.NewController(cash.NewBucket())
+var ctrl = cash.NewController(cash.NewBucket(), freeze.NewTransferHook())
 
 // Router returns a default router, only dispatching to the
 // cash.SendMsg
@@ -77,17 +82,20 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 
 	migration.RegisterRoutes(r, authFn)
 	cash.RegisterRoutes(r, authFn, ctrl)
-	escrow.RegisterRoutes(r, authFn, ctrl)
-	multisig.RegisterRoutes(r, authFn)
+	escrow.RegisterRoutes(r, authFn, ctrl, scheduler)
+	multisig.RegisterRoutes(r, authFn, scheduler)
 	//TODO: Possibly revisit passing the bucket later to have more control over types?
 	// or implement a check
-	currency.RegisterRoutes(r, authFn, issuer)
+	currency.RegisterRoutes(r, authFn, issuer, ctrl)
 	validators.RegisterRoutes(r, authFn)
 	distribution.RegisterRoutes(r, authFn, ctrl)
 	sigs.RegisterRoutes(r, authFn)
 	aswap.RegisterRoutes(r, authFn, ctrl)
-	gov.RegisterRoutes(r, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl), scheduler)
+	gov.RegisterRoutes(r, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl, scheduler), scheduler, ctrl)
 	username.RegisterRoutes(r, authFn)
+	session.RegisterRoutes(r, authFn)
+	recovery.RegisterRoutes(r, authFn, scheduler)
+	freeze.RegisterRoutes(r, authFn)
 	return r
 }
 
@@ -111,6 +119,9 @@ func QueryRouter(minFee coin.Coin) weave.QueryRouter {
 		gov.RegisterQuery,
 		username.RegisterQuery,
 		cron.RegisterQuery,
+		session.RegisterQuery,
+		recovery.RegisterQuery,
+		freeze.RegisterQuery,
 	)
 	return r
 }
@@ -131,17 +142,25 @@ func CronStack() weave.Handler {
 	rt := app.NewRouter()
 
 	authFn := cron.Authenticator{}
+	scheduler := cron.NewScheduler(CronTaskMarshaler)
 
 	// Cron is using custom router as not the same handlers are registered.
-	gov.RegisterCronRoutes(rt, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl))
+	gov.RegisterCronRoutes(rt, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl, scheduler), scheduler, ctrl)
 	distribution.RegisterRoutes(rt, authFn, ctrl)
-	escrow.RegisterRoutes(rt, authFn, ctrl)
+	escrow.RegisterRoutes(rt, authFn, ctrl, scheduler)
 	aswap.RegisterRoutes(rt, authFn, ctrl)
+	multisig.RegisterCronRoutes(rt, authFn)
+	recovery.RegisterCronRoutes(rt)
+	cash.RegisterCronRoutes(rt, scheduler)
 
 	decorators := app.ChainDecorators(
 		utils.NewLogging(),
 		utils.NewRecovery(),
 		utils.NewKeyTagger(),
+		// Tags are returned in the BeginBlock response. They are not
+		// indexed with transactions and therefore are not a part of
+		// the wallet history search.
+		cash.NewHistoryTagger(),
 		utils.NewActionTagger(),
 		// No fee decorators.
 	)
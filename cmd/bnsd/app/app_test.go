@@ -45,8 +45,10 @@ func TestApp(t *testing.T) {
 	addr2 := pk2.PublicKey().Address()
 	dres := sendToken(t, myApp, appFixture.ChainID, 2, []Signer{{pk, 0}}, addr, addr2, 2000, "ETH", "Have a great trip!")
 
-	// ensure 4 keys for all accounts that are modified by a transaction
-	assert.Equal(t, 5, len(dres.Tags))
+	// ensure 4 keys for all accounts that are modified by a transaction,
+	// 2 holder keys of the wallets receiving a currency for the first
+	// time and 3 wallet history tags
+	assert.Equal(t, 10, len(dres.Tags))
 	feeDistAddr := weave.NewCondition("dist", "revenue", []byte{0, 0, 0, 0, 0, 0, 0, 1}).Address()
 	wantKeys := []string{
 		"action",
@@ -63,13 +65,20 @@ func TestApp(t *testing.T) {
 		assert.Equal(t, true, found)
 	}
 
-	// first tag is the action tagger, following are key tagger
+	// first tag is the action tagger, following are wallet history
+	// tagger and key tagger
+	wantHistory := []string{addr.String(), addr2.String(), feeDistAddr.String()}
+	sort.Strings(wantHistory)
+	for i, want := range wantHistory {
+		assert.Equal(t, cash.WalletHistoryTag, string(dres.Tags[i+1].Key))
+		assert.Equal(t, want, string(dres.Tags[i+1].Value))
+	}
 	assert.Equal(t, []string{"cash/send", "s", "s", "s", "s"}, []string{
 		string(dres.Tags[0].Value),
-		string(dres.Tags[1].Value),
-		string(dres.Tags[2].Value),
-		string(dres.Tags[3].Value),
 		string(dres.Tags[4].Value),
+		string(dres.Tags[5].Value),
+		string(dres.Tags[6].Value),
+		string(dres.Tags[7].Value),
 	})
 
 	// Query for fees stored
@@ -246,7 +255,9 @@ func sendBatch(t *testing.T, baseApp abci.Application, chainID string, height in
 	// make sure the key tags are only present once (not once per item)
 	// action tag should be present for each message (important if different types)
 	feeDistAddr := weave.NewCondition("dist", "revenue", []byte{0, 0, 0, 0, 0, 0, 0, 1}).Address()
-	if len(dres.Tags) != 14 {
+	// one of the tags is the holder key of the recipient holding ETH
+	// for the first time
+	if len(dres.Tags) != 18 {
 		t.Fatalf("%v", len(dres.Tags))
 	}
 	// we need to sort the db keys for consistent ordering
@@ -255,9 +266,16 @@ func sendBatch(t *testing.T, baseApp abci.Application, chainID string, height in
 		toHex("cash:") + from.String(),
 		toHex("cash:") + to.String(),
 		toHex("sigs:") + from.String(),
-		toHex("cash:") + feeDistAddr.String(), // fee destination
+		toHex("cash:") + feeDistAddr.String(),  // fee destination
+		toHex("cashholder:ETH:") + to.String(), // recipient holds ETH
 	}
 	sort.Strings(wantKeys)
+	// wallet history tags are between the action tagger and the key tagger
+	wantKeys = append([]string{
+		cash.WalletHistoryTag,
+		cash.WalletHistoryTag,
+		cash.WalletHistoryTag,
+	}, wantKeys...)
 	// all the action tagger for batch are before the key tagger
 	wantKeys = append([]string{
 		"action",
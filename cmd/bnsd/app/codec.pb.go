@@ -15,8 +15,11 @@ import (
 	currency "github.com/iov-one/weave/x/currency"
 	distribution "github.com/iov-one/weave/x/distribution"
 	escrow "github.com/iov-one/weave/x/escrow"
+	freeze "github.com/iov-one/weave/x/freeze"
 	gov "github.com/iov-one/weave/x/gov"
 	multisig "github.com/iov-one/weave/x/multisig"
+	recovery "github.com/iov-one/weave/x/recovery"
+	session "github.com/iov-one/weave/x/session"
 	sigs "github.com/iov-one/weave/x/sigs"
 	validators "github.com/iov-one/weave/x/validators"
 	io "io"
@@ -37,12 +40,13 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 // Tx contains the message.
 //
 // When extending Tx, follow the rules:
-// - range 1-50 is reserved for middlewares,
-// - range 51-inf is reserved for different message types,
-// - keep the same numbers for the same message types in both bnsd and other
-//   applications. For example, FeeInfo field is used by both and indexed at
-//   first position. Skip unused fields (leave index unused or comment out for
-//   clarity).
+//   - range 1-50 is reserved for middlewares,
+//   - range 51-inf is reserved for different message types,
+//   - keep the same numbers for the same message types in both bnsd and other
+//     applications. For example, FeeInfo field is used by both and indexed at
+//     first position. Skip unused fields (leave index unused or comment out for
+//     clarity).
+//
 // When there is a gap in message sequence numbers - that most likely means some
 // old fields got deprecated. This is done to maintain binary compatibility.
 type Tx struct {
@@ -50,6 +54,10 @@ type Tx struct {
 	Signatures []*sigs.StdSignature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
 	// ID of a multisig contract.
 	Multisig [][]byte `protobuf:"bytes,4,rep,name=multisig,proto3" json:"multisig,omitempty"`
+	// Aggregated BLS12-381 signature of many signers.
+	AggregatedSignature *sigs.AggregatedSignature `protobuf:"bytes,5,opt,name=aggregated_signature,json=aggregatedSignature,proto3" json:"aggregated_signature,omitempty"`
+	// Optional expiration of the transaction.
+	Expiration *sigs.TxExpiration `protobuf:"bytes,6,opt,name=expiration,proto3" json:"expiration,omitempty"`
 	// msg is a sum type over all allowed messages on this chain.
 	//
 	// Types that are valid to be assigned to Sum:
@@ -78,6 +86,22 @@ type Tx struct {
 	//	*Tx_GovVoteMsg
 	//	*Tx_GovUpdateElectorateMsg
 	//	*Tx_GovUpdateElectionRuleMsg
+	//	*Tx_GovDelegateMsg
+	//	*Tx_GovRevokeDelegationMsg
+	//	*Tx_GovVetoMsg
+	//	*Tx_SessionRegisterKeyMsg
+	//	*Tx_SessionRevokeKeyMsg
+	//	*Tx_RecoveryConfigureMsg
+	//	*Tx_RecoveryInitiateMsg
+	//	*Tx_RecoveryApproveMsg
+	//	*Tx_RecoveryCancelMsg
+	//	*Tx_CurrencyMintMsg
+	//	*Tx_CurrencyBurnMsg
+	//	*Tx_CurrencyUpdateMsg
+	//	*Tx_CashMultiSendMsg
+	//	*Tx_FreezeFreezeMsg
+	//	*Tx_FreezeUnfreezeMsg
+	//	*Tx_CashMigrateWalletsMsg
 	Sum isTx_Sum `protobuf_oneof:"sum"`
 }
 
@@ -195,6 +219,54 @@ type Tx_GovUpdateElectorateMsg struct {
 type Tx_GovUpdateElectionRuleMsg struct {
 	GovUpdateElectionRuleMsg *gov.UpdateElectionRuleMsg `protobuf:"bytes,78,opt,name=gov_update_election_rule_msg,json=govUpdateElectionRuleMsg,proto3,oneof"`
 }
+type Tx_GovDelegateMsg struct {
+	GovDelegateMsg *gov.DelegateMsg `protobuf:"bytes,80,opt,name=gov_delegate_msg,json=govDelegateMsg,proto3,oneof"`
+}
+type Tx_GovRevokeDelegationMsg struct {
+	GovRevokeDelegationMsg *gov.RevokeDelegationMsg `protobuf:"bytes,81,opt,name=gov_revoke_delegation_msg,json=govRevokeDelegationMsg,proto3,oneof"`
+}
+type Tx_GovVetoMsg struct {
+	GovVetoMsg *gov.VetoMsg `protobuf:"bytes,82,opt,name=gov_veto_msg,json=govVetoMsg,proto3,oneof"`
+}
+type Tx_SessionRegisterKeyMsg struct {
+	SessionRegisterKeyMsg *session.RegisterKeyMsg `protobuf:"bytes,85,opt,name=session_register_key_msg,json=sessionRegisterKeyMsg,proto3,oneof"`
+}
+type Tx_SessionRevokeKeyMsg struct {
+	SessionRevokeKeyMsg *session.RevokeKeyMsg `protobuf:"bytes,86,opt,name=session_revoke_key_msg,json=sessionRevokeKeyMsg,proto3,oneof"`
+}
+type Tx_RecoveryConfigureMsg struct {
+	RecoveryConfigureMsg *recovery.ConfigureMsg `protobuf:"bytes,87,opt,name=recovery_configure_msg,json=recoveryConfigureMsg,proto3,oneof"`
+}
+type Tx_RecoveryInitiateMsg struct {
+	RecoveryInitiateMsg *recovery.InitiateMsg `protobuf:"bytes,88,opt,name=recovery_initiate_msg,json=recoveryInitiateMsg,proto3,oneof"`
+}
+type Tx_RecoveryApproveMsg struct {
+	RecoveryApproveMsg *recovery.ApproveMsg `protobuf:"bytes,89,opt,name=recovery_approve_msg,json=recoveryApproveMsg,proto3,oneof"`
+}
+type Tx_RecoveryCancelMsg struct {
+	RecoveryCancelMsg *recovery.CancelMsg `protobuf:"bytes,90,opt,name=recovery_cancel_msg,json=recoveryCancelMsg,proto3,oneof"`
+}
+type Tx_CurrencyMintMsg struct {
+	CurrencyMintMsg *currency.MintMsg `protobuf:"bytes,92,opt,name=currency_mint_msg,json=currencyMintMsg,proto3,oneof"`
+}
+type Tx_CurrencyBurnMsg struct {
+	CurrencyBurnMsg *currency.BurnMsg `protobuf:"bytes,93,opt,name=currency_burn_msg,json=currencyBurnMsg,proto3,oneof"`
+}
+type Tx_CurrencyUpdateMsg struct {
+	CurrencyUpdateMsg *currency.UpdateMsg `protobuf:"bytes,94,opt,name=currency_update_msg,json=currencyUpdateMsg,proto3,oneof"`
+}
+type Tx_CashMultiSendMsg struct {
+	CashMultiSendMsg *cash.MultiSendMsg `protobuf:"bytes,96,opt,name=cash_multi_send_msg,json=cashMultiSendMsg,proto3,oneof"`
+}
+type Tx_FreezeFreezeMsg struct {
+	FreezeFreezeMsg *freeze.FreezeMsg `protobuf:"bytes,97,opt,name=freeze_freeze_msg,json=freezeFreezeMsg,proto3,oneof"`
+}
+type Tx_FreezeUnfreezeMsg struct {
+	FreezeUnfreezeMsg *freeze.UnfreezeMsg `protobuf:"bytes,98,opt,name=freeze_unfreeze_msg,json=freezeUnfreezeMsg,proto3,oneof"`
+}
+type Tx_CashMigrateWalletsMsg struct {
+	CashMigrateWalletsMsg *cash.MigrateWalletsMsg `protobuf:"bytes,99,opt,name=cash_migrate_wallets_msg,json=cashMigrateWalletsMsg,proto3,oneof"`
+}
 
 func (*Tx_CashSendMsg) isTx_Sum()                   {}
 func (*Tx_EscrowCreateMsg) isTx_Sum()               {}
@@ -221,6 +293,22 @@ func (*Tx_GovDeleteProposalMsg) isTx_Sum()          {}
 func (*Tx_GovVoteMsg) isTx_Sum()                    {}
 func (*Tx_GovUpdateElectorateMsg) isTx_Sum()        {}
 func (*Tx_GovUpdateElectionRuleMsg) isTx_Sum()      {}
+func (*Tx_GovDelegateMsg) isTx_Sum()                {}
+func (*Tx_GovRevokeDelegationMsg) isTx_Sum()        {}
+func (*Tx_GovVetoMsg) isTx_Sum()                    {}
+func (*Tx_SessionRegisterKeyMsg) isTx_Sum()         {}
+func (*Tx_SessionRevokeKeyMsg) isTx_Sum()           {}
+func (*Tx_RecoveryConfigureMsg) isTx_Sum()          {}
+func (*Tx_RecoveryInitiateMsg) isTx_Sum()           {}
+func (*Tx_RecoveryApproveMsg) isTx_Sum()            {}
+func (*Tx_RecoveryCancelMsg) isTx_Sum()             {}
+func (*Tx_CurrencyMintMsg) isTx_Sum()               {}
+func (*Tx_CurrencyBurnMsg) isTx_Sum()               {}
+func (*Tx_CurrencyUpdateMsg) isTx_Sum()             {}
+func (*Tx_CashMultiSendMsg) isTx_Sum()              {}
+func (*Tx_FreezeFreezeMsg) isTx_Sum()               {}
+func (*Tx_FreezeUnfreezeMsg) isTx_Sum()             {}
+func (*Tx_CashMigrateWalletsMsg) isTx_Sum()         {}
 
 func (m *Tx) GetSum() isTx_Sum {
 	if m != nil {
@@ -250,6 +338,20 @@ func (m *Tx) GetMultisig() [][]byte {
 	return nil
 }
 
+func (m *Tx) GetAggregatedSignature() *sigs.AggregatedSignature {
+	if m != nil {
+		return m.AggregatedSignature
+	}
+	return nil
+}
+
+func (m *Tx) GetExpiration() *sigs.TxExpiration {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 func (m *Tx) GetCashSendMsg() *cash.SendMsg {
 	if x, ok := m.GetSum().(*Tx_CashSendMsg); ok {
 		return x.CashSendMsg
@@ -425,6 +527,118 @@ func (m *Tx) GetGovUpdateElectionRuleMsg() *gov.UpdateElectionRuleMsg {
 	return nil
 }
 
+func (m *Tx) GetGovDelegateMsg() *gov.DelegateMsg {
+	if x, ok := m.GetSum().(*Tx_GovDelegateMsg); ok {
+		return x.GovDelegateMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGovRevokeDelegationMsg() *gov.RevokeDelegationMsg {
+	if x, ok := m.GetSum().(*Tx_GovRevokeDelegationMsg); ok {
+		return x.GovRevokeDelegationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGovVetoMsg() *gov.VetoMsg {
+	if x, ok := m.GetSum().(*Tx_GovVetoMsg); ok {
+		return x.GovVetoMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetSessionRegisterKeyMsg() *session.RegisterKeyMsg {
+	if x, ok := m.GetSum().(*Tx_SessionRegisterKeyMsg); ok {
+		return x.SessionRegisterKeyMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetSessionRevokeKeyMsg() *session.RevokeKeyMsg {
+	if x, ok := m.GetSum().(*Tx_SessionRevokeKeyMsg); ok {
+		return x.SessionRevokeKeyMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetRecoveryConfigureMsg() *recovery.ConfigureMsg {
+	if x, ok := m.GetSum().(*Tx_RecoveryConfigureMsg); ok {
+		return x.RecoveryConfigureMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetRecoveryInitiateMsg() *recovery.InitiateMsg {
+	if x, ok := m.GetSum().(*Tx_RecoveryInitiateMsg); ok {
+		return x.RecoveryInitiateMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetRecoveryApproveMsg() *recovery.ApproveMsg {
+	if x, ok := m.GetSum().(*Tx_RecoveryApproveMsg); ok {
+		return x.RecoveryApproveMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetRecoveryCancelMsg() *recovery.CancelMsg {
+	if x, ok := m.GetSum().(*Tx_RecoveryCancelMsg); ok {
+		return x.RecoveryCancelMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCurrencyMintMsg() *currency.MintMsg {
+	if x, ok := m.GetSum().(*Tx_CurrencyMintMsg); ok {
+		return x.CurrencyMintMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCurrencyBurnMsg() *currency.BurnMsg {
+	if x, ok := m.GetSum().(*Tx_CurrencyBurnMsg); ok {
+		return x.CurrencyBurnMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCurrencyUpdateMsg() *currency.UpdateMsg {
+	if x, ok := m.GetSum().(*Tx_CurrencyUpdateMsg); ok {
+		return x.CurrencyUpdateMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashMultiSendMsg() *cash.MultiSendMsg {
+	if x, ok := m.GetSum().(*Tx_CashMultiSendMsg); ok {
+		return x.CashMultiSendMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetFreezeFreezeMsg() *freeze.FreezeMsg {
+	if x, ok := m.GetSum().(*Tx_FreezeFreezeMsg); ok {
+		return x.FreezeFreezeMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetFreezeUnfreezeMsg() *freeze.UnfreezeMsg {
+	if x, ok := m.GetSum().(*Tx_FreezeUnfreezeMsg); ok {
+		return x.FreezeUnfreezeMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashMigrateWalletsMsg() *cash.MigrateWalletsMsg {
+	if x, ok := m.GetSum().(*Tx_CashMigrateWalletsMsg); ok {
+		return x.CashMigrateWalletsMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Tx_OneofMarshaler, _Tx_OneofUnmarshaler, _Tx_OneofSizer, []interface{}{
@@ -453,6 +667,22 @@ func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, fun
 		(*Tx_GovVoteMsg)(nil),
 		(*Tx_GovUpdateElectorateMsg)(nil),
 		(*Tx_GovUpdateElectionRuleMsg)(nil),
+		(*Tx_GovDelegateMsg)(nil),
+		(*Tx_GovRevokeDelegationMsg)(nil),
+		(*Tx_GovVetoMsg)(nil),
+		(*Tx_SessionRegisterKeyMsg)(nil),
+		(*Tx_SessionRevokeKeyMsg)(nil),
+		(*Tx_RecoveryConfigureMsg)(nil),
+		(*Tx_RecoveryInitiateMsg)(nil),
+		(*Tx_RecoveryApproveMsg)(nil),
+		(*Tx_RecoveryCancelMsg)(nil),
+		(*Tx_CurrencyMintMsg)(nil),
+		(*Tx_CurrencyBurnMsg)(nil),
+		(*Tx_CurrencyUpdateMsg)(nil),
+		(*Tx_CashMultiSendMsg)(nil),
+		(*Tx_FreezeFreezeMsg)(nil),
+		(*Tx_FreezeUnfreezeMsg)(nil),
+		(*Tx_CashMigrateWalletsMsg)(nil),
 	}
 }
 
@@ -585,6 +815,86 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.GovUpdateElectionRuleMsg); err != nil {
 			return err
 		}
+	case *Tx_GovDelegateMsg:
+		_ = b.EncodeVarint(80<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovDelegateMsg); err != nil {
+			return err
+		}
+	case *Tx_GovRevokeDelegationMsg:
+		_ = b.EncodeVarint(81<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovRevokeDelegationMsg); err != nil {
+			return err
+		}
+	case *Tx_GovVetoMsg:
+		_ = b.EncodeVarint(82<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovVetoMsg); err != nil {
+			return err
+		}
+	case *Tx_SessionRegisterKeyMsg:
+		_ = b.EncodeVarint(85<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SessionRegisterKeyMsg); err != nil {
+			return err
+		}
+	case *Tx_SessionRevokeKeyMsg:
+		_ = b.EncodeVarint(86<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SessionRevokeKeyMsg); err != nil {
+			return err
+		}
+	case *Tx_RecoveryConfigureMsg:
+		_ = b.EncodeVarint(87<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RecoveryConfigureMsg); err != nil {
+			return err
+		}
+	case *Tx_RecoveryInitiateMsg:
+		_ = b.EncodeVarint(88<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RecoveryInitiateMsg); err != nil {
+			return err
+		}
+	case *Tx_RecoveryApproveMsg:
+		_ = b.EncodeVarint(89<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RecoveryApproveMsg); err != nil {
+			return err
+		}
+	case *Tx_RecoveryCancelMsg:
+		_ = b.EncodeVarint(90<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RecoveryCancelMsg); err != nil {
+			return err
+		}
+	case *Tx_CurrencyMintMsg:
+		_ = b.EncodeVarint(92<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyMintMsg); err != nil {
+			return err
+		}
+	case *Tx_CurrencyBurnMsg:
+		_ = b.EncodeVarint(93<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyBurnMsg); err != nil {
+			return err
+		}
+	case *Tx_CurrencyUpdateMsg:
+		_ = b.EncodeVarint(94<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyUpdateMsg); err != nil {
+			return err
+		}
+	case *Tx_CashMultiSendMsg:
+		_ = b.EncodeVarint(96<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMultiSendMsg); err != nil {
+			return err
+		}
+	case *Tx_FreezeFreezeMsg:
+		_ = b.EncodeVarint(97<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.FreezeFreezeMsg); err != nil {
+			return err
+		}
+	case *Tx_FreezeUnfreezeMsg:
+		_ = b.EncodeVarint(98<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.FreezeUnfreezeMsg); err != nil {
+			return err
+		}
+	case *Tx_CashMigrateWalletsMsg:
+		_ = b.EncodeVarint(99<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMigrateWalletsMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Tx.Sum has unexpected type %T", x)
@@ -795,6 +1105,134 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_GovUpdateElectionRuleMsg{msg}
 		return true, err
+	case 80: // sum.gov_delegate_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.DelegateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovDelegateMsg{msg}
+		return true, err
+	case 81: // sum.gov_revoke_delegation_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.RevokeDelegationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovRevokeDelegationMsg{msg}
+		return true, err
+	case 82: // sum.gov_veto_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.VetoMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovVetoMsg{msg}
+		return true, err
+	case 85: // sum.session_register_key_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(session.RegisterKeyMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_SessionRegisterKeyMsg{msg}
+		return true, err
+	case 86: // sum.session_revoke_key_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(session.RevokeKeyMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_SessionRevokeKeyMsg{msg}
+		return true, err
+	case 87: // sum.recovery_configure_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(recovery.ConfigureMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_RecoveryConfigureMsg{msg}
+		return true, err
+	case 88: // sum.recovery_initiate_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(recovery.InitiateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_RecoveryInitiateMsg{msg}
+		return true, err
+	case 89: // sum.recovery_approve_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(recovery.ApproveMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_RecoveryApproveMsg{msg}
+		return true, err
+	case 90: // sum.recovery_cancel_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(recovery.CancelMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_RecoveryCancelMsg{msg}
+		return true, err
+	case 92: // sum.currency_mint_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.MintMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CurrencyMintMsg{msg}
+		return true, err
+	case 93: // sum.currency_burn_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.BurnMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CurrencyBurnMsg{msg}
+		return true, err
+	case 94: // sum.currency_update_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.UpdateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CurrencyUpdateMsg{msg}
+		return true, err
+	case 96: // sum.cash_multi_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MultiSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashMultiSendMsg{msg}
+		return true, err
+	case 97: // sum.freeze_freeze_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(freeze.FreezeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_FreezeFreezeMsg{msg}
+		return true, err
+	case 98: // sum.freeze_unfreeze_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(freeze.UnfreezeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_FreezeUnfreezeMsg{msg}
+		return true, err
+	case 99: // sum.cash_migrate_wallets_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MigrateWalletsMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashMigrateWalletsMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -929,6 +1367,86 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_GovDelegateMsg:
+		s := proto.Size(x.GovDelegateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GovRevokeDelegationMsg:
+		s := proto.Size(x.GovRevokeDelegationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GovVetoMsg:
+		s := proto.Size(x.GovVetoMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_SessionRegisterKeyMsg:
+		s := proto.Size(x.SessionRegisterKeyMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_SessionRevokeKeyMsg:
+		s := proto.Size(x.SessionRevokeKeyMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_RecoveryConfigureMsg:
+		s := proto.Size(x.RecoveryConfigureMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_RecoveryInitiateMsg:
+		s := proto.Size(x.RecoveryInitiateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_RecoveryApproveMsg:
+		s := proto.Size(x.RecoveryApproveMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_RecoveryCancelMsg:
+		s := proto.Size(x.RecoveryCancelMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CurrencyMintMsg:
+		s := proto.Size(x.CurrencyMintMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CurrencyBurnMsg:
+		s := proto.Size(x.CurrencyBurnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CurrencyUpdateMsg:
+		s := proto.Size(x.CurrencyUpdateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashMultiSendMsg:
+		s := proto.Size(x.CashMultiSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_FreezeFreezeMsg:
+		s := proto.Size(x.FreezeFreezeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_FreezeUnfreezeMsg:
+		s := proto.Size(x.FreezeUnfreezeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashMigrateWalletsMsg:
+		s := proto.Size(x.CashMigrateWalletsMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -998,6 +1516,10 @@ type ExecuteBatchMsg_Union struct {
 	//	*ExecuteBatchMsg_Union_DistributionCreateMsg
 	//	*ExecuteBatchMsg_Union_DistributionMsg
 	//	*ExecuteBatchMsg_Union_DistributionResetMsg
+	//	*ExecuteBatchMsg_Union_CurrencyMintMsg
+	//	*ExecuteBatchMsg_Union_CurrencyBurnMsg
+	//	*ExecuteBatchMsg_Union_CurrencyUpdateMsg
+	//	*ExecuteBatchMsg_Union_CashMultiSendMsg
 	Sum isExecuteBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
@@ -1085,6 +1607,18 @@ type ExecuteBatchMsg_Union_DistributionMsg struct {
 type ExecuteBatchMsg_Union_DistributionResetMsg struct {
 	DistributionResetMsg *distribution.ResetMsg `protobuf:"bytes,68,opt,name=distribution_reset_msg,json=distributionResetMsg,proto3,oneof"`
 }
+type ExecuteBatchMsg_Union_CurrencyMintMsg struct {
+	CurrencyMintMsg *currency.MintMsg `protobuf:"bytes,92,opt,name=currency_mint_msg,json=currencyMintMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CurrencyBurnMsg struct {
+	CurrencyBurnMsg *currency.BurnMsg `protobuf:"bytes,93,opt,name=currency_burn_msg,json=currencyBurnMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CurrencyUpdateMsg struct {
+	CurrencyUpdateMsg *currency.UpdateMsg `protobuf:"bytes,94,opt,name=currency_update_msg,json=currencyUpdateMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashMultiSendMsg struct {
+	CashMultiSendMsg *cash.MultiSendMsg `protobuf:"bytes,96,opt,name=cash_multi_send_msg,json=cashMultiSendMsg,proto3,oneof"`
+}
 
 func (*ExecuteBatchMsg_Union_CashSendMsg) isExecuteBatchMsg_Union_Sum()                   {}
 func (*ExecuteBatchMsg_Union_EscrowCreateMsg) isExecuteBatchMsg_Union_Sum()               {}
@@ -1101,6 +1635,10 @@ func (*ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) isExecuteBatchMsg_Un
 func (*ExecuteBatchMsg_Union_DistributionCreateMsg) isExecuteBatchMsg_Union_Sum()         {}
 func (*ExecuteBatchMsg_Union_DistributionMsg) isExecuteBatchMsg_Union_Sum()               {}
 func (*ExecuteBatchMsg_Union_DistributionResetMsg) isExecuteBatchMsg_Union_Sum()          {}
+func (*ExecuteBatchMsg_Union_CurrencyMintMsg) isExecuteBatchMsg_Union_Sum()               {}
+func (*ExecuteBatchMsg_Union_CurrencyBurnMsg) isExecuteBatchMsg_Union_Sum()               {}
+func (*ExecuteBatchMsg_Union_CurrencyUpdateMsg) isExecuteBatchMsg_Union_Sum()             {}
+func (*ExecuteBatchMsg_Union_CashMultiSendMsg) isExecuteBatchMsg_Union_Sum()              {}
 
 func (m *ExecuteBatchMsg_Union) GetSum() isExecuteBatchMsg_Union_Sum {
 	if m != nil {
@@ -1214,7 +1752,35 @@ func (m *ExecuteBatchMsg_Union) GetDistributionResetMsg() *distribution.ResetMsg
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
+func (m *ExecuteBatchMsg_Union) GetCurrencyMintMsg() *currency.MintMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CurrencyMintMsg); ok {
+		return x.CurrencyMintMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCurrencyBurnMsg() *currency.BurnMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CurrencyBurnMsg); ok {
+		return x.CurrencyBurnMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCurrencyUpdateMsg() *currency.UpdateMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CurrencyUpdateMsg); ok {
+		return x.CurrencyUpdateMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashMultiSendMsg() *cash.MultiSendMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashMultiSendMsg); ok {
+		return x.CashMultiSendMsg
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
 func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ExecuteBatchMsg_Union_OneofMarshaler, _ExecuteBatchMsg_Union_OneofUnmarshaler, _ExecuteBatchMsg_Union_OneofSizer, []interface{}{
 		(*ExecuteBatchMsg_Union_CashSendMsg)(nil),
@@ -1232,6 +1798,10 @@ func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto
 		(*ExecuteBatchMsg_Union_DistributionCreateMsg)(nil),
 		(*ExecuteBatchMsg_Union_DistributionMsg)(nil),
 		(*ExecuteBatchMsg_Union_DistributionResetMsg)(nil),
+		(*ExecuteBatchMsg_Union_CurrencyMintMsg)(nil),
+		(*ExecuteBatchMsg_Union_CurrencyBurnMsg)(nil),
+		(*ExecuteBatchMsg_Union_CurrencyUpdateMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashMultiSendMsg)(nil),
 	}
 }
 
@@ -1314,6 +1884,26 @@ func _ExecuteBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.Buffer) e
 		if err := b.EncodeMessage(x.DistributionResetMsg); err != nil {
 			return err
 		}
+	case *ExecuteBatchMsg_Union_CurrencyMintMsg:
+		_ = b.EncodeVarint(92<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyMintMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CurrencyBurnMsg:
+		_ = b.EncodeVarint(93<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyBurnMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CurrencyUpdateMsg:
+		_ = b.EncodeVarint(94<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyUpdateMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashMultiSendMsg:
+		_ = b.EncodeVarint(96<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMultiSendMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ExecuteBatchMsg_Union.Sum has unexpected type %T", x)
@@ -1444,6 +2034,38 @@ func _ExecuteBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wire int, b
 		err := b.DecodeMessage(msg)
 		m.Sum = &ExecuteBatchMsg_Union_DistributionResetMsg{msg}
 		return true, err
+	case 92: // sum.currency_mint_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.MintMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CurrencyMintMsg{msg}
+		return true, err
+	case 93: // sum.currency_burn_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.BurnMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CurrencyBurnMsg{msg}
+		return true, err
+	case 94: // sum.currency_update_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.UpdateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CurrencyUpdateMsg{msg}
+		return true, err
+	case 96: // sum.cash_multi_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MultiSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashMultiSendMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1528,6 +2150,26 @@ func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ExecuteBatchMsg_Union_CurrencyMintMsg:
+		s := proto.Size(x.CurrencyMintMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CurrencyBurnMsg:
+		s := proto.Size(x.CurrencyBurnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CurrencyUpdateMsg:
+		s := proto.Size(x.CurrencyUpdateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashMultiSendMsg:
+		s := proto.Size(x.CashMultiSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2298,20 +2940,23 @@ type ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg struct {
 	GovCreateTextResolutionMsg *gov.CreateTextResolutionMsg `protobuf:"bytes,79,opt,name=gov_create_text_resolution_msg,json=govCreateTextResolutionMsg,proto3,oneof"`
 }
 
-func (*ExecuteProposalBatchMsg_Union_SendMsg) isExecuteProposalBatchMsg_Union_Sum()                  {}
-func (*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) isExecuteProposalBatchMsg_Union_Sum()         {}
-func (*ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
-func (*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) isExecuteProposalBatchMsg_Union_Sum()        {}
-func (*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
-func (*ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {}
-func (*ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_SendMsg) isExecuteProposalBatchMsg_Union_Sum()                {}
+func (*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) isExecuteProposalBatchMsg_Union_Sum()       {}
+func (*ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) isExecuteProposalBatchMsg_Union_Sum()      {}
+func (*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 func (*ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
-func (*ExecuteProposalBatchMsg_Union_DistributionCreateMsg) isExecuteProposalBatchMsg_Union_Sum()    {}
-func (*ExecuteProposalBatchMsg_Union_DistributionMsg) isExecuteProposalBatchMsg_Union_Sum()          {}
-func (*ExecuteProposalBatchMsg_Union_DistributionResetMsg) isExecuteProposalBatchMsg_Union_Sum()     {}
-func (*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
-func (*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_DistributionCreateMsg) isExecuteProposalBatchMsg_Union_Sum()  {}
+func (*ExecuteProposalBatchMsg_Union_DistributionMsg) isExecuteProposalBatchMsg_Union_Sum()        {}
+func (*ExecuteProposalBatchMsg_Union_DistributionResetMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
+func (*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 func (*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
 
@@ -2744,6 +3389,10 @@ type CronTask struct {
 	//	*CronTask_DistributionDistributeMsg
 	//	*CronTask_AswapReleaseMsg
 	//	*CronTask_GovTallyMsg
+	//	*CronTask_GovExecuteProposalMsg
+	//	*CronTask_MultisigExecuteUpdateMsg
+	//	*CronTask_RecoveryExecuteMsg
+	//	*CronTask_CashUnlockVestingMsg
 	Sum isCronTask_Sum `protobuf_oneof:"sum"`
 }
 
@@ -2801,12 +3450,28 @@ type CronTask_AswapReleaseMsg struct {
 type CronTask_GovTallyMsg struct {
 	GovTallyMsg *gov.TallyMsg `protobuf:"bytes,76,opt,name=gov_tally_msg,json=govTallyMsg,proto3,oneof"`
 }
+type CronTask_GovExecuteProposalMsg struct {
+	GovExecuteProposalMsg *gov.ExecuteProposalMsg `protobuf:"bytes,83,opt,name=gov_execute_proposal_msg,json=govExecuteProposalMsg,proto3,oneof"`
+}
+type CronTask_MultisigExecuteUpdateMsg struct {
+	MultisigExecuteUpdateMsg *multisig.ExecuteUpdateMsg `protobuf:"bytes,84,opt,name=multisig_execute_update_msg,json=multisigExecuteUpdateMsg,proto3,oneof"`
+}
+type CronTask_RecoveryExecuteMsg struct {
+	RecoveryExecuteMsg *recovery.ExecuteMsg `protobuf:"bytes,91,opt,name=recovery_execute_msg,json=recoveryExecuteMsg,proto3,oneof"`
+}
+type CronTask_CashUnlockVestingMsg struct {
+	CashUnlockVestingMsg *cash.UnlockVestingMsg `protobuf:"bytes,95,opt,name=cash_unlock_vesting_msg,json=cashUnlockVestingMsg,proto3,oneof"`
+}
 
 func (*CronTask_EscrowReleaseMsg) isCronTask_Sum()          {}
 func (*CronTask_EscrowReturnMsg) isCronTask_Sum()           {}
 func (*CronTask_DistributionDistributeMsg) isCronTask_Sum() {}
 func (*CronTask_AswapReleaseMsg) isCronTask_Sum()           {}
 func (*CronTask_GovTallyMsg) isCronTask_Sum()               {}
+func (*CronTask_GovExecuteProposalMsg) isCronTask_Sum()     {}
+func (*CronTask_MultisigExecuteUpdateMsg) isCronTask_Sum()  {}
+func (*CronTask_RecoveryExecuteMsg) isCronTask_Sum()        {}
+func (*CronTask_CashUnlockVestingMsg) isCronTask_Sum()      {}
 
 func (m *CronTask) GetSum() isCronTask_Sum {
 	if m != nil {
@@ -2857,6 +3522,34 @@ func (m *CronTask) GetGovTallyMsg() *gov.TallyMsg {
 	return nil
 }
 
+func (m *CronTask) GetGovExecuteProposalMsg() *gov.ExecuteProposalMsg {
+	if x, ok := m.GetSum().(*CronTask_GovExecuteProposalMsg); ok {
+		return x.GovExecuteProposalMsg
+	}
+	return nil
+}
+
+func (m *CronTask) GetMultisigExecuteUpdateMsg() *multisig.ExecuteUpdateMsg {
+	if x, ok := m.GetSum().(*CronTask_MultisigExecuteUpdateMsg); ok {
+		return x.MultisigExecuteUpdateMsg
+	}
+	return nil
+}
+
+func (m *CronTask) GetRecoveryExecuteMsg() *recovery.ExecuteMsg {
+	if x, ok := m.GetSum().(*CronTask_RecoveryExecuteMsg); ok {
+		return x.RecoveryExecuteMsg
+	}
+	return nil
+}
+
+func (m *CronTask) GetCashUnlockVestingMsg() *cash.UnlockVestingMsg {
+	if x, ok := m.GetSum().(*CronTask_CashUnlockVestingMsg); ok {
+		return x.CashUnlockVestingMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _CronTask_OneofMarshaler, _CronTask_OneofUnmarshaler, _CronTask_OneofSizer, []interface{}{
@@ -2865,6 +3558,10 @@ func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) erro
 		(*CronTask_DistributionDistributeMsg)(nil),
 		(*CronTask_AswapReleaseMsg)(nil),
 		(*CronTask_GovTallyMsg)(nil),
+		(*CronTask_GovExecuteProposalMsg)(nil),
+		(*CronTask_MultisigExecuteUpdateMsg)(nil),
+		(*CronTask_RecoveryExecuteMsg)(nil),
+		(*CronTask_CashUnlockVestingMsg)(nil),
 	}
 }
 
@@ -2897,6 +3594,26 @@ func _CronTask_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.GovTallyMsg); err != nil {
 			return err
 		}
+	case *CronTask_GovExecuteProposalMsg:
+		_ = b.EncodeVarint(83<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovExecuteProposalMsg); err != nil {
+			return err
+		}
+	case *CronTask_MultisigExecuteUpdateMsg:
+		_ = b.EncodeVarint(84<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigExecuteUpdateMsg); err != nil {
+			return err
+		}
+	case *CronTask_RecoveryExecuteMsg:
+		_ = b.EncodeVarint(91<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RecoveryExecuteMsg); err != nil {
+			return err
+		}
+	case *CronTask_CashUnlockVestingMsg:
+		_ = b.EncodeVarint(95<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashUnlockVestingMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("CronTask.Sum has unexpected type %T", x)
@@ -2947,6 +3664,38 @@ func _CronTask_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Sum = &CronTask_GovTallyMsg{msg}
 		return true, err
+	case 83: // sum.gov_execute_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.ExecuteProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_GovExecuteProposalMsg{msg}
+		return true, err
+	case 84: // sum.multisig_execute_update_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.ExecuteUpdateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_MultisigExecuteUpdateMsg{msg}
+		return true, err
+	case 91: // sum.recovery_execute_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(recovery.ExecuteMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_RecoveryExecuteMsg{msg}
+		return true, err
+	case 95: // sum.cash_unlock_vesting_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.UnlockVestingMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_CashUnlockVestingMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -2981,6 +3730,26 @@ func _CronTask_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *CronTask_GovExecuteProposalMsg:
+		s := proto.Size(x.GovExecuteProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CronTask_MultisigExecuteUpdateMsg:
+		s := proto.Size(x.MultisigExecuteUpdateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CronTask_RecoveryExecuteMsg:
+		s := proto.Size(x.RecoveryExecuteMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CronTask_CashUnlockVestingMsg:
+		s := proto.Size(x.CashUnlockVestingMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -3001,88 +3770,121 @@ func init() {
 func init() { proto.RegisterFile("cmd/bnsd/app/codec.proto", fileDescriptor_a8efb1d2ea3c411d) }
 
 var fileDescriptor_a8efb1d2ea3c411d = []byte{
-	// 1284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x99, 0xdd, 0x6e, 0x1b, 0x45,
-	0x1b, 0xc7, 0x93, 0x26, 0xed, 0x9b, 0x4e, 0xd2, 0x26, 0x9e, 0xb6, 0x89, 0xe3, 0xb6, 0x4e, 0x9b,
-	0x57, 0x42, 0x15, 0x12, 0xbb, 0xa8, 0xe1, 0x9b, 0x96, 0x08, 0x3b, 0x2e, 0x29, 0xd0, 0xa4, 0x38,
-	0x0e, 0x27, 0x14, 0xac, 0xf1, 0xee, 0x78, 0xbd, 0xca, 0x7a, 0xc7, 0xda, 0x99, 0x75, 0x36, 0xc7,
-	0xdc, 0x00, 0x97, 0xc0, 0x7d, 0x70, 0x03, 0x3d, 0xec, 0x21, 0x47, 0x15, 0x4a, 0x2e, 0x81, 0x33,
-	0x38, 0x41, 0xf3, 0xb5, 0x9e, 0x59, 0x27, 0x14, 0x28, 0xe2, 0x4b, 0x3e, 0xcb, 0x3c, 0xff, 0x67,
-	0x7e, 0xf3, 0xb9, 0xff, 0x67, 0xdc, 0x82, 0xb2, 0xd7, 0xf7, 0xdd, 0x4e, 0x4c, 0x7d, 0x17, 0x0d,
-	0x06, 0xae, 0x47, 0x7c, 0xec, 0x39, 0x83, 0x84, 0x30, 0x02, 0x67, 0x79, 0xb4, 0xb2, 0x96, 0xeb,
-	0x99, 0x9b, 0x52, 0x9c, 0xc4, 0xa8, 0x8f, 0xcd, 0xb4, 0xca, 0xd5, 0x80, 0x04, 0x44, 0xfc, 0xe9,
-	0xf2, 0xbf, 0x54, 0xf4, 0x5a, 0x3f, 0x0c, 0x12, 0xc4, 0x42, 0x12, 0x5b, 0xc9, 0x57, 0x32, 0x17,
-	0xd1, 0x43, 0x64, 0x0d, 0x54, 0x81, 0x99, 0xeb, 0x21, 0xda, 0xb3, 0x62, 0xcb, 0x99, 0xeb, 0xa5,
-	0x49, 0x82, 0x63, 0xef, 0xc8, 0x8a, 0x57, 0x32, 0xd7, 0x0f, 0x29, 0x4b, 0xc2, 0x4e, 0x3a, 0x06,
-	0xbf, 0x9a, 0xb9, 0x98, 0x7a, 0x09, 0x39, 0xb4, 0xa2, 0xa5, 0xcc, 0x0d, 0xc8, 0xb0, 0x08, 0xef,
-	0xa7, 0x11, 0x0b, 0x69, 0x18, 0x14, 0x27, 0x42, 0xc3, 0x80, 0x5a, 0xb1, 0x72, 0xe6, 0x0e, 0x51,
-	0x14, 0xfa, 0x88, 0x91, 0xc4, 0x52, 0xd6, 0x7f, 0x5e, 0x04, 0xe7, 0x5a, 0x19, 0xbc, 0x0d, 0x66,
-	0xbb, 0x18, 0xd3, 0xf2, 0xf4, 0xad, 0xe9, 0x3b, 0xf3, 0x77, 0x2f, 0x39, 0x7c, 0x29, 0xce, 0x03,
-	0x8c, 0x1f, 0xc6, 0x5d, 0xd2, 0x14, 0x12, 0xbc, 0x0b, 0x00, 0x0d, 0x83, 0x18, 0xb1, 0x34, 0xc1,
-	0xb4, 0x7c, 0xee, 0xd6, 0xcc, 0x9d, 0xf9, 0xbb, 0xd0, 0xe1, 0x43, 0x39, 0x7b, 0xcc, 0xdf, 0xd3,
-	0x52, 0xd3, 0xc8, 0x82, 0x15, 0x30, 0xa7, 0xe7, 0x58, 0x9e, 0xbd, 0x35, 0x73, 0x67, 0xa1, 0x99,
-	0xb7, 0xe1, 0x06, 0xb8, 0xc4, 0x47, 0x69, 0x53, 0x1c, 0xfb, 0xed, 0x3e, 0x0d, 0xca, 0x1b, 0xe6,
-	0xd8, 0x7b, 0x38, 0xf6, 0x1f, 0xd1, 0x60, 0x7b, 0xaa, 0x39, 0xcf, 0xdb, 0xaa, 0x09, 0x37, 0x41,
-	0x49, 0xee, 0x4e, 0xdb, 0x4b, 0x30, 0x62, 0x58, 0x74, 0x7c, 0x43, 0x74, 0x2c, 0x39, 0x52, 0x71,
-	0xea, 0x42, 0x91, 0x9d, 0x17, 0x65, 0x2c, 0x0f, 0xc1, 0x1a, 0x80, 0x0a, 0x90, 0xe0, 0x08, 0x23,
-	0x2a, 0x09, 0x6f, 0x0a, 0x02, 0xd4, 0x84, 0xa6, 0x94, 0x24, 0x62, 0x49, 0x06, 0x47, 0x31, 0x63,
-	0x12, 0x09, 0x66, 0x69, 0x12, 0x0b, 0xc4, 0x5b, 0xf6, 0x24, 0x9a, 0x42, 0xb1, 0x26, 0x91, 0x87,
-	0xe0, 0x3e, 0x58, 0x55, 0x80, 0x74, 0xe0, 0xf3, 0x55, 0x0c, 0x50, 0xc2, 0x42, 0x4c, 0x05, 0xe8,
-	0x6d, 0x01, 0x2a, 0x6b, 0xd0, 0xbe, 0xc8, 0x78, 0x2c, 0x13, 0x24, 0x6f, 0x59, 0x4a, 0x45, 0x05,
-	0x36, 0xc0, 0x15, 0xbd, 0xbb, 0xe6, 0xf6, 0xbc, 0x23, 0x80, 0x57, 0x1c, 0xad, 0x59, 0x1b, 0x54,
-	0xd2, 0xd1, 0xd1, 0x16, 0x99, 0x18, 0x35, 0x3f, 0x8e, 0x79, 0xb7, 0x88, 0x91, 0xe3, 0x17, 0x30,
-	0x79, 0x90, 0x2f, 0x72, 0x74, 0xe7, 0xda, 0x68, 0x30, 0x88, 0x8e, 0xda, 0x7e, 0xd8, 0xed, 0x0a,
-	0xd8, 0x7b, 0x6a, 0x91, 0xa3, 0x0c, 0xe7, 0x43, 0x9e, 0xb1, 0x15, 0x76, 0xbb, 0x6a, 0x91, 0x23,
-	0xc9, 0x54, 0xf8, 0xec, 0xf4, 0x37, 0x65, 0x2e, 0xf2, 0x7d, 0x35, 0x3b, 0xad, 0xd9, 0x8b, 0xd4,
-	0xd1, 0xd1, 0x22, 0xeb, 0xa0, 0x84, 0x33, 0xec, 0xa5, 0x0c, 0xb7, 0x3b, 0x88, 0x79, 0x3d, 0x01,
-	0xb9, 0x27, 0x20, 0xd7, 0x1c, 0xee, 0x14, 0x4e, 0x43, 0xca, 0x35, 0xae, 0xea, 0x73, 0xb4, 0x43,
-	0xf0, 0x0b, 0x70, 0x5d, 0xbb, 0x49, 0x3b, 0xc1, 0x41, 0x48, 0x19, 0x4e, 0xda, 0x8c, 0x1c, 0x60,
-	0x79, 0x25, 0xee, 0x0b, 0x5c, 0xc5, 0xd1, 0x39, 0x4e, 0x53, 0xe5, 0xb4, 0x78, 0x8a, 0x64, 0x96,
-	0xb5, 0x58, 0xd4, 0x2c, 0x38, 0x4b, 0x50, 0x4c, 0xbb, 0x16, 0xfc, 0x83, 0x22, 0xbc, 0xa5, 0x72,
-	0x4e, 0x83, 0x17, 0x35, 0x78, 0x00, 0x6e, 0xe7, 0x70, 0xaf, 0x87, 0xe2, 0x00, 0x2b, 0x34, 0x43,
-	0x49, 0x80, 0x99, 0xbc, 0x89, 0x9b, 0x62, 0x88, 0xb5, 0xd1, 0x10, 0x75, 0x91, 0x29, 0x20, 0x2d,
-	0x99, 0x27, 0xc7, 0xb9, 0xa9, 0x33, 0x4e, 0x4d, 0x80, 0x9f, 0x81, 0x15, 0xd3, 0xee, 0xcc, 0x63,
-	0xab, 0x89, 0x21, 0x56, 0x1c, 0x53, 0xb7, 0x8e, 0xee, 0x9a, 0xa9, 0x8c, 0x8e, 0x6f, 0x1b, 0x2c,
-	0x59, 0x48, 0xce, 0xaa, 0x0b, 0xd6, 0x75, 0x9b, 0xb5, 0xa5, 0x1b, 0xda, 0x10, 0x4c, 0x95, 0x93,
-	0x76, 0xc0, 0xb2, 0x45, 0x4a, 0x30, 0xc5, 0x4c, 0xf0, 0xb6, 0x04, 0x6f, 0xd9, 0xe6, 0x35, 0xb9,
-	0x2c, 0x51, 0x57, 0x4d, 0x41, 0xc7, 0xe1, 0x57, 0xe0, 0x46, 0x5e, 0x35, 0xda, 0xe9, 0x20, 0x48,
-	0x90, 0x8f, 0xdb, 0xd4, 0xeb, 0xe1, 0x3e, 0x12, 0xd4, 0x86, 0x9a, 0x65, 0x9e, 0xe4, 0xec, 0xcb,
-	0xa4, 0x3d, 0x91, 0x23, 0xd1, 0xab, 0xb9, 0x5a, 0x14, 0xe1, 0x3d, 0xb0, 0x24, 0x8a, 0x8f, 0xb9,
-	0x8b, 0x0f, 0x04, 0x73, 0xc9, 0x11, 0x82, 0xb5, 0x7d, 0x97, 0x45, 0x68, 0xb4, 0x6f, 0x9b, 0xa0,
-	0x24, 0x7b, 0x9b, 0xee, 0xf7, 0x91, 0xb2, 0x2e, 0xd9, 0xdd, 0x32, 0xbf, 0x45, 0x11, 0x33, 0xbc,
-	0x2f, 0x1f, 0xde, 0xb0, 0xbe, 0x6d, 0x6b, 0x78, 0xd3, 0xf9, 0x2e, 0xab, 0xee, 0xda, 0xf8, 0x76,
-	0xc1, 0x4a, 0x40, 0x86, 0x7a, 0xea, 0x83, 0x84, 0x0c, 0x08, 0x45, 0x91, 0x80, 0x3c, 0x54, 0xbb,
-	0x1d, 0x90, 0xa1, 0x5a, 0xc1, 0x63, 0x25, 0xab, 0xdd, 0x0e, 0xc8, 0x70, 0x2c, 0xae, 0x81, 0x3e,
-	0x8e, 0x70, 0x11, 0xf8, 0xb1, 0x01, 0xdc, 0x12, 0xfa, 0x38, 0x70, 0x2c, 0x0e, 0x5f, 0x07, 0x0b,
-	0x1c, 0x38, 0x24, 0x6a, 0x6b, 0x3f, 0x11, 0x94, 0x05, 0x41, 0xf9, 0x9c, 0xe8, 0x6d, 0x05, 0x01,
-	0x19, 0xaa, 0x16, 0xf7, 0x39, 0xde, 0x43, 0x39, 0x25, 0x8e, 0xb0, 0xc7, 0x48, 0xa2, 0x4f, 0xe6,
-	0x91, 0xf2, 0x39, 0xde, 0x5d, 0x5a, 0x63, 0x23, 0x4f, 0x50, 0x3e, 0x17, 0x90, 0xe1, 0x29, 0x0a,
-	0x7c, 0x02, 0x6e, 0x14, 0xb1, 0xe2, 0x7a, 0xa6, 0x91, 0x24, 0xef, 0xa8, 0xef, 0xbf, 0x40, 0xe6,
-	0x57, 0x31, 0x8d, 0x14, 0xbb, 0x6c, 0xb3, 0x47, 0x5a, 0xed, 0x3c, 0x98, 0xa1, 0x69, 0x7f, 0xfd,
-	0xc7, 0x8b, 0x60, 0xb1, 0xe0, 0x73, 0xf0, 0x3e, 0x98, 0xeb, 0x63, 0x4a, 0x51, 0x20, 0x9e, 0x03,
-	0x33, 0xe2, 0xb2, 0x9e, 0x66, 0x88, 0xce, 0x7e, 0x1c, 0x92, 0xb8, 0x36, 0xfb, 0xf4, 0xf9, 0xda,
-	0x54, 0x33, 0xef, 0x52, 0xf9, 0xf6, 0x22, 0x38, 0x2f, 0x94, 0x49, 0x81, 0x9f, 0x14, 0xf8, 0xbf,
-	0xb1, 0xc0, 0x4f, 0x6a, 0xf3, 0xa4, 0x36, 0x17, 0x6a, 0xb3, 0x76, 0xbd, 0xef, 0xe6, 0xc1, 0xa2,
-	0xf6, 0xfc, 0xdd, 0x01, 0xcf, 0xa0, 0x7f, 0xcc, 0xac, 0xfe, 0x0c, 0xaf, 0xd9, 0x07, 0xab, 0xda,
-	0xe3, 0x25, 0xea, 0x77, 0x5a, 0x85, 0xec, 0xdc, 0x10, 0x09, 0x67, 0x58, 0xc5, 0x7f, 0xf6, 0x1b,
-	0x7f, 0x02, 0x2a, 0xfa, 0x11, 0x9f, 0x97, 0xfe, 0xe2, 0x6b, 0xfe, 0xa6, 0x55, 0xbc, 0xf4, 0xb1,
-	0x1b, 0xaf, 0xfa, 0x15, 0x7c, 0xba, 0x34, 0x71, 0x90, 0x89, 0x83, 0xfc, 0xe5, 0xaf, 0xfb, 0x7f,
-	0xe3, 0x63, 0x12, 0x76, 0x40, 0xd5, 0x78, 0xd5, 0x33, 0x9c, 0x31, 0xbe, 0xcf, 0x24, 0x1a, 0x1d,
-	0xde, 0xae, 0xe0, 0xdf, 0x30, 0x1e, 0xf7, 0x2d, 0x9c, 0xb1, 0x66, 0x9e, 0x24, 0x47, 0xa8, 0xe4,
-	0x4f, 0xfc, 0x31, 0xb5, 0x36, 0x07, 0x2e, 0x10, 0x61, 0xd5, 0xeb, 0x5f, 0x03, 0xb0, 0x72, 0xc6,
-	0xd7, 0x0c, 0x1b, 0x63, 0x6f, 0xd7, 0xff, 0xff, 0xea, 0xe7, 0xff, 0xc2, 0x37, 0xec, 0xab, 0x60,
-	0xee, 0x45, 0x15, 0xe1, 0x7f, 0x74, 0x52, 0x0d, 0x5e, 0xae, 0x1a, 0x4c, 0x8c, 0x76, 0x62, 0xb4,
-	0x45, 0xa3, 0x9d, 0x18, 0xe1, 0x19, 0x46, 0xa8, 0xdf, 0xb0, 0x33, 0x60, 0xae, 0x9e, 0x90, 0xb8,
-	0x85, 0xe8, 0x01, 0xdc, 0x01, 0x97, 0x51, 0xca, 0x7a, 0x38, 0x66, 0xa1, 0x27, 0x3e, 0x2f, 0x61,
-	0x7e, 0x0b, 0xb5, 0x57, 0x7e, 0x7a, 0xbe, 0xb6, 0x1e, 0x84, 0xac, 0x97, 0x76, 0x1c, 0x8f, 0xf4,
-	0xdd, 0x90, 0x0c, 0x5f, 0x23, 0x31, 0x76, 0x0f, 0x31, 0x1a, 0x62, 0xa7, 0x4e, 0x62, 0x3f, 0x14,
-	0xd3, 0x2f, 0xf4, 0xfe, 0x67, 0xfc, 0x86, 0xfe, 0x12, 0x5c, 0xb7, 0x6e, 0x54, 0xde, 0xc0, 0xbf,
-	0xfd, 0x9a, 0xae, 0x9a, 0xaa, 0x25, 0xbe, 0xfc, 0xbf, 0x84, 0x6d, 0x80, 0x4b, 0xfc, 0xb0, 0x19,
-	0x8a, 0xa2, 0x23, 0xd1, 0xf9, 0x53, 0x55, 0x1f, 0xf8, 0xd9, 0xb6, 0x78, 0x54, 0xfd, 0x62, 0x08,
-	0xc8, 0x50, 0x37, 0xd5, 0xe9, 0xd5, 0xca, 0x4f, 0x8f, 0xab, 0xd3, 0xcf, 0x8e, 0xab, 0xd3, 0x3f,
-	0x1c, 0x57, 0xa7, 0xbf, 0x39, 0xa9, 0x4e, 0x3d, 0x3b, 0xa9, 0x4e, 0x7d, 0x7f, 0x52, 0x9d, 0xea,
-	0x5c, 0x10, 0xff, 0x2d, 0xb3, 0xf1, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xe8, 0x94, 0x96,
-	0xd2, 0x1a, 0x00, 0x00,
+	// 1813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x5b, 0x53, 0xdc, 0xbc,
+	0x19, 0x86, 0x0f, 0x42, 0xa9, 0x20, 0x01, 0xcc, 0x69, 0xd9, 0xe4, 0x03, 0x3e, 0x3a, 0xd3, 0xc9,
+	0x74, 0xa6, 0x76, 0x27, 0xf4, 0xdc, 0xef, 0x6b, 0x26, 0x0b, 0xa4, 0xa4, 0x09, 0x39, 0x2c, 0xbb,
+	0xa4, 0x6d, 0x0e, 0xae, 0xd6, 0xab, 0x35, 0x1e, 0x76, 0xad, 0x1d, 0xcb, 0x36, 0xa6, 0x77, 0x9d,
+	0x5e, 0xf4, 0xb6, 0x3f, 0xa1, 0xbf, 0xa0, 0x7f, 0xa0, 0x7f, 0x20, 0x97, 0xb9, 0xec, 0x55, 0xa6,
+	0x93, 0xfc, 0x8b, 0x5e, 0x74, 0x3a, 0x92, 0x5e, 0xc9, 0x92, 0x17, 0x7a, 0x4a, 0xa7, 0xa7, 0xd9,
+	0x2b, 0xf0, 0xf3, 0xbc, 0x7a, 0xa4, 0x57, 0xb2, 0x1e, 0xbd, 0x16, 0xa0, 0x5a, 0x30, 0xe8, 0x7a,
+	0x9d, 0x98, 0x75, 0x3d, 0x3c, 0x1c, 0x7a, 0x01, 0xed, 0x92, 0xc0, 0x1d, 0x26, 0x34, 0xa5, 0xce,
+	0x34, 0x47, 0xeb, 0x5b, 0x9a, 0x2f, 0xbc, 0x8c, 0x91, 0x24, 0xc6, 0x03, 0x62, 0x86, 0xd5, 0x57,
+	0x42, 0x1a, 0x52, 0xf1, 0xab, 0xc7, 0x7f, 0x03, 0x74, 0x75, 0x10, 0x85, 0x09, 0x4e, 0x23, 0x1a,
+	0x5b, 0xc1, 0xcb, 0x85, 0x87, 0xd9, 0x39, 0xb6, 0x3a, 0xaa, 0x3b, 0x85, 0x17, 0x60, 0x76, 0x6a,
+	0x61, 0x6b, 0x85, 0x17, 0x64, 0x49, 0x42, 0xe2, 0xe0, 0xc2, 0xc2, 0xeb, 0x85, 0xd7, 0x8d, 0x58,
+	0x9a, 0x44, 0x9d, 0x6c, 0x44, 0x7c, 0xa5, 0xf0, 0x08, 0x0b, 0x12, 0x7a, 0x5e, 0x45, 0x7b, 0x09,
+	0x21, 0xbf, 0xb0, 0x47, 0xbd, 0x54, 0x78, 0x21, 0xcd, 0xab, 0x5d, 0x0e, 0xb2, 0x7e, 0x1a, 0xb1,
+	0x28, 0xac, 0xe2, 0x09, 0x09, 0x68, 0x4e, 0x12, 0x7b, 0x28, 0xab, 0x85, 0xc7, 0x08, 0x63, 0xd5,
+	0x51, 0x38, 0x85, 0xc7, 0xa2, 0x90, 0x59, 0x58, 0xad, 0xf0, 0x72, 0xdc, 0x8f, 0xba, 0x38, 0xa5,
+	0x89, 0xc5, 0xec, 0xfc, 0x79, 0x03, 0x7d, 0xd2, 0x2a, 0x9c, 0xcf, 0xd0, 0x74, 0x8f, 0x10, 0x56,
+	0x9b, 0xdc, 0x9e, 0xbc, 0x3d, 0x77, 0xe7, 0xba, 0xcb, 0xe7, 0xc3, 0xbd, 0x4f, 0xc8, 0x83, 0xb8,
+	0x47, 0x9b, 0x82, 0x72, 0xee, 0x20, 0xc4, 0xa2, 0x30, 0xc6, 0x69, 0x96, 0x10, 0x56, 0xfb, 0x64,
+	0x7b, 0xea, 0xf6, 0xdc, 0x1d, 0xc7, 0xe5, 0x5d, 0xb9, 0xc7, 0x69, 0xf7, 0x58, 0x51, 0x4d, 0x23,
+	0xca, 0xa9, 0xa3, 0x59, 0x95, 0x52, 0x6d, 0x7a, 0x7b, 0xea, 0xf6, 0x7c, 0x53, 0x3f, 0x3b, 0x8f,
+	0xd0, 0x0a, 0x0e, 0xc3, 0x84, 0x84, 0x38, 0x25, 0x5d, 0x5f, 0x37, 0xaa, 0x5d, 0x13, 0x43, 0xd8,
+	0x90, 0xca, 0xf7, 0x74, 0x44, 0xd9, 0xc1, 0x32, 0x1e, 0x05, 0xf9, 0xe8, 0x48, 0x31, 0x8c, 0xe4,
+	0x92, 0xd7, 0x66, 0xb6, 0x27, 0xcb, 0xd1, 0xb5, 0x8a, 0x03, 0xcd, 0x34, 0x8d, 0x28, 0x67, 0x17,
+	0x5d, 0xe7, 0x79, 0xfa, 0x8c, 0xc4, 0x5d, 0x7f, 0xc0, 0xc2, 0xda, 0xae, 0x99, 0xfd, 0x31, 0x89,
+	0xbb, 0x47, 0x2c, 0x3c, 0x9c, 0x68, 0xce, 0xf1, 0x67, 0x78, 0x74, 0xee, 0xa2, 0x25, 0xb9, 0xc8,
+	0x7e, 0x90, 0x10, 0x9c, 0x12, 0xd1, 0xf0, 0x9b, 0xa2, 0xe1, 0x92, 0x2b, 0x19, 0x77, 0x4f, 0x30,
+	0xb2, 0xf1, 0x82, 0xc4, 0x34, 0xe4, 0x34, 0x90, 0x03, 0x02, 0x09, 0xe9, 0x13, 0xcc, 0xa4, 0xc2,
+	0xb7, 0x60, 0xc4, 0xa0, 0xd0, 0x94, 0x94, 0x94, 0x58, 0x94, 0x60, 0x89, 0x19, 0x83, 0x48, 0x48,
+	0x9a, 0x25, 0xb1, 0x90, 0xf8, 0xb6, 0x3d, 0x88, 0xa6, 0x60, 0xac, 0x41, 0x68, 0xc8, 0x69, 0xa3,
+	0x0d, 0x10, 0xc8, 0x86, 0x5d, 0x9e, 0xc5, 0x10, 0x27, 0x69, 0x44, 0x98, 0x10, 0xfa, 0x8e, 0x10,
+	0xaa, 0x29, 0xa1, 0xb6, 0x88, 0x78, 0x2a, 0x03, 0xa4, 0xde, 0x9a, 0xa4, 0xaa, 0x8c, 0x73, 0x80,
+	0x96, 0xd5, 0xfa, 0x9a, 0xd3, 0xf3, 0x5d, 0x21, 0xb8, 0xec, 0x2a, 0xce, 0x9a, 0xa0, 0x25, 0x85,
+	0x96, 0x53, 0x64, 0xca, 0xc0, 0xf8, 0xb8, 0xcc, 0xf7, 0xaa, 0x32, 0xb2, 0xff, 0x8a, 0x8c, 0x06,
+	0x79, 0x92, 0xe5, 0x5b, 0xef, 0xe3, 0xe1, 0xb0, 0x7f, 0xe1, 0x77, 0xa3, 0x5e, 0x4f, 0x88, 0x7d,
+	0x1f, 0x92, 0x2c, 0x23, 0xdc, 0x7b, 0x3c, 0x62, 0x3f, 0xea, 0xf5, 0x20, 0xc9, 0x92, 0x32, 0x19,
+	0x3e, 0x3a, 0x65, 0x0d, 0x66, 0x92, 0x3f, 0x80, 0xd1, 0x29, 0xce, 0x4e, 0x52, 0xa1, 0x65, 0x92,
+	0x7b, 0x68, 0x89, 0x14, 0x24, 0xc8, 0x52, 0xe2, 0x77, 0x70, 0x1a, 0x9c, 0x0a, 0x91, 0xcf, 0x85,
+	0xc8, 0xaa, 0xcb, 0x0d, 0xcf, 0x3d, 0x90, 0x74, 0x83, 0xb3, 0x6a, 0x1d, 0x6d, 0xc8, 0x79, 0x81,
+	0x6e, 0x2a, 0x53, 0xf4, 0x13, 0x12, 0x46, 0x2c, 0x25, 0x89, 0x9f, 0xd2, 0x33, 0x22, 0x5f, 0x89,
+	0x2f, 0x84, 0x5c, 0xdd, 0x55, 0x31, 0x6e, 0x13, 0x62, 0x5a, 0x3c, 0x44, 0x6a, 0xd6, 0x14, 0x59,
+	0xe5, 0x2c, 0xf1, 0x34, 0xc1, 0x31, 0xeb, 0x59, 0xe2, 0x3f, 0xac, 0x8a, 0xb7, 0x20, 0xe6, 0x32,
+	0xf1, 0x2a, 0xe7, 0x9c, 0xa1, 0xcf, 0xb4, 0x78, 0x70, 0x8a, 0xe3, 0x90, 0x80, 0x74, 0x8a, 0x93,
+	0x90, 0xa4, 0xf2, 0x4d, 0xbc, 0x2b, 0xba, 0xd8, 0x2a, 0xbb, 0xd8, 0x13, 0x91, 0x42, 0xa4, 0x25,
+	0xe3, 0x64, 0x3f, 0x9f, 0xaa, 0x88, 0x4b, 0x03, 0x9c, 0x67, 0x68, 0xdd, 0x74, 0x6d, 0x73, 0xd9,
+	0x1a, 0xa2, 0x8b, 0x75, 0xd7, 0xe4, 0xad, 0xa5, 0x5b, 0x35, 0x99, 0x72, 0xf9, 0x0e, 0xd1, 0xa2,
+	0x25, 0xc9, 0xb5, 0xf6, 0x84, 0xd6, 0x4d, 0x5b, 0x6b, 0x5f, 0x3d, 0x28, 0x43, 0x30, 0x59, 0xae,
+	0xf4, 0x18, 0xad, 0x59, 0x4a, 0x09, 0x61, 0x24, 0x15, 0x7a, 0xfb, 0x42, 0x6f, 0xcd, 0xd6, 0x6b,
+	0x72, 0x5a, 0x4a, 0xad, 0x98, 0x84, 0xc2, 0x9d, 0xd7, 0xe8, 0x96, 0x3e, 0xfc, 0xfc, 0x6c, 0x18,
+	0x26, 0xb8, 0x4b, 0x7c, 0x16, 0x9c, 0x92, 0x01, 0x16, 0xaa, 0x07, 0x30, 0x4a, 0x1d, 0xe4, 0xb6,
+	0x65, 0xd0, 0xb1, 0x88, 0x91, 0xd2, 0x1b, 0x9a, 0xad, 0x92, 0xce, 0xe7, 0x68, 0x51, 0x9c, 0xa1,
+	0xe6, 0x2c, 0xde, 0x17, 0x9a, 0x8b, 0xae, 0x20, 0xac, 0xe9, 0xbb, 0x21, 0xa0, 0x72, 0xde, 0xee,
+	0xa2, 0x25, 0xd9, 0xda, 0x74, 0xbf, 0x1f, 0x81, 0x75, 0xc9, 0xe6, 0x96, 0xf9, 0x2d, 0x08, 0xac,
+	0x84, 0xca, 0xee, 0x0d, 0xeb, 0x3b, 0xb4, 0xba, 0x37, 0x9d, 0xef, 0x06, 0x34, 0x07, 0xc4, 0x79,
+	0x82, 0xd6, 0x43, 0x9a, 0xab, 0xa1, 0x0f, 0x13, 0x3a, 0xa4, 0x0c, 0xf7, 0x85, 0xc8, 0x03, 0x98,
+	0xed, 0x90, 0xe6, 0x90, 0xc1, 0x53, 0xa0, 0x61, 0xb6, 0x43, 0x9a, 0x8f, 0xe0, 0x4a, 0xb0, 0x4b,
+	0xfa, 0xa4, 0x2a, 0xf8, 0x63, 0x43, 0x70, 0x5f, 0xf0, 0xa3, 0x82, 0x23, 0xb8, 0xf3, 0x0d, 0x34,
+	0xcf, 0x05, 0x73, 0x0a, 0x53, 0xfb, 0x50, 0xa8, 0xcc, 0x0b, 0x95, 0x13, 0xaa, 0xa6, 0x15, 0x85,
+	0x34, 0x3f, 0xa1, 0xda, 0xe7, 0x78, 0x0b, 0x70, 0x4a, 0xd2, 0x27, 0x41, 0x4a, 0x13, 0xb5, 0x32,
+	0x47, 0xe0, 0x73, 0xbc, 0xb9, 0xb4, 0xc6, 0x03, 0x1d, 0x00, 0x3e, 0x17, 0xd2, 0xfc, 0x12, 0xc6,
+	0x79, 0x89, 0x6e, 0x55, 0x65, 0xc5, 0xeb, 0x99, 0xf5, 0xa5, 0xf2, 0x63, 0xd8, 0xff, 0x15, 0x65,
+	0xfe, 0x2a, 0x66, 0x7d, 0xd0, 0xae, 0xd9, 0xda, 0x25, 0xc7, 0x97, 0x51, 0xcd, 0x5b, 0xa8, 0xc6,
+	0xfa, 0x14, 0x96, 0x51, 0x4d, 0x58, 0x58, 0xbe, 0x45, 0x30, 0x55, 0x21, 0xb6, 0x52, 0x4e, 0x48,
+	0x4e, 0xcf, 0x88, 0x12, 0x51, 0xdb, 0xf0, 0x99, 0x91, 0x72, 0x53, 0x44, 0xec, 0xeb, 0x80, 0x32,
+	0xe5, 0x4b, 0x18, 0x3d, 0xf7, 0x24, 0xa5, 0x42, 0xa9, 0x69, 0xce, 0x3d, 0x49, 0xa9, 0x31, 0xf7,
+	0xf2, 0xc9, 0x69, 0xa2, 0x1a, 0x14, 0x61, 0xa5, 0xff, 0x9e, 0x91, 0x0b, 0xd1, 0xba, 0x0d, 0xd6,
+	0x02, 0x01, 0xda, 0x7c, 0x1f, 0x92, 0x0b, 0xb0, 0x16, 0x60, 0x6c, 0xc2, 0x79, 0x84, 0xd6, 0x4a,
+	0x4d, 0x91, 0xa0, 0x52, 0x3c, 0x81, 0xe3, 0xa1, 0x54, 0xe4, 0xb4, 0xd6, 0x5b, 0xd6, 0x7a, 0x25,
+	0xcc, 0xed, 0x45, 0x95, 0x8f, 0x7e, 0x40, 0xe3, 0x5e, 0x14, 0x66, 0x89, 0x9c, 0xee, 0xe7, 0xf0,
+	0x7e, 0x2a, 0xda, 0xdd, 0x53, 0x34, 0xbc, 0x9f, 0x8a, 0x30, 0x71, 0xe7, 0x21, 0x5a, 0xd5, 0x7a,
+	0x51, 0x1c, 0xa5, 0x91, 0x5a, 0xbd, 0x9f, 0xc0, 0xe0, 0xb4, 0xdc, 0x03, 0x60, 0x61, 0x70, 0x0a,
+	0x37, 0x60, 0xe7, 0x10, 0xe9, 0x4e, 0xf8, 0x01, 0x9d, 0xd0, 0x5c, 0x6a, 0xfd, 0x54, 0x68, 0xad,
+	0x94, 0x5a, 0xf7, 0x24, 0x29, 0xa5, 0x1c, 0x05, 0x97, 0x28, 0x3f, 0x95, 0xcb, 0x34, 0x71, 0x1c,
+	0x10, 0xb9, 0x07, 0x7f, 0x06, 0xa7, 0x72, 0x99, 0xa3, 0xe0, 0xe0, 0x54, 0xd6, 0x09, 0x2a, 0x90,
+	0xdb, 0x93, 0x3e, 0xdc, 0x07, 0x51, 0x2c, 0x7d, 0xf8, 0x25, 0xd8, 0x93, 0x62, 0xdc, 0xa3, 0x28,
+	0x06, 0x0b, 0x5e, 0x50, 0x18, 0x40, 0x96, 0x40, 0x47, 0xf9, 0xd3, 0xab, 0xaa, 0x40, 0xa3, 0x2c,
+	0xcd, 0x14, 0x06, 0x90, 0x55, 0x5e, 0x18, 0xc5, 0xcf, 0xeb, 0x6a, 0x79, 0x61, 0x15, 0x3f, 0x0a,
+	0xd5, 0xa0, 0xb3, 0x87, 0x96, 0x45, 0x71, 0x2b, 0xca, 0xa2, 0xb2, 0xc4, 0xfd, 0x39, 0xd4, 0x99,
+	0x9c, 0x73, 0x8f, 0x38, 0x57, 0xd6, 0xb9, 0x8b, 0x1c, 0x34, 0x31, 0x9e, 0x8c, 0xfc, 0x76, 0xf1,
+	0xe1, 0x07, 0x97, 0xc0, 0x90, 0x8c, 0x84, 0xdc, 0xfb, 0xe2, 0x07, 0x24, 0x23, 0x31, 0x0d, 0xf1,
+	0x64, 0xa0, 0x65, 0x16, 0x1b, 0x12, 0x1d, 0x48, 0x06, 0x24, 0xda, 0x71, 0xcf, 0x10, 0x81, 0x2e,
+	0x0d, 0x90, 0xef, 0x32, 0x99, 0x8c, 0x38, 0x94, 0x88, 0x7f, 0x8e, 0xfb, 0x7d, 0x55, 0x23, 0x04,
+	0xb0, 0xcb, 0x64, 0x46, 0x32, 0xe0, 0xb9, 0xe4, 0x61, 0x97, 0x89, 0xb4, 0xaa, 0x44, 0xe3, 0x1a,
+	0x9a, 0x62, 0xd9, 0x60, 0xe7, 0x97, 0xf3, 0x68, 0xa1, 0x52, 0x68, 0x39, 0x5f, 0xa0, 0xd9, 0x01,
+	0x61, 0x0c, 0x87, 0xe2, 0x8b, 0x68, 0x4a, 0x9c, 0x96, 0x97, 0x55, 0x64, 0x6e, 0x3b, 0x8e, 0x68,
+	0xdc, 0x98, 0x7e, 0xf3, 0x6e, 0x6b, 0xa2, 0xa9, 0x9b, 0xd4, 0x7f, 0x37, 0x87, 0xae, 0xb5, 0xe3,
+	0xf1, 0x17, 0xc6, 0xf8, 0x0b, 0xe3, 0x3f, 0xfb, 0x85, 0x31, 0xfe, 0x38, 0x18, 0x7f, 0x1c, 0x54,
+	0x3f, 0x0e, 0xc6, 0xe7, 0x9b, 0x81, 0xa9, 0x33, 0xe0, 0xf7, 0x73, 0x68, 0x41, 0x95, 0xe0, 0x4f,
+	0x86, 0x7c, 0xbe, 0xd8, 0x3f, 0x67, 0xdd, 0xff, 0x0a, 0xe7, 0x6d, 0xa3, 0x0d, 0x55, 0x72, 0x4b,
+	0xa9, 0x7f, 0xd0, 0x38, 0x65, 0xe3, 0x03, 0x11, 0x70, 0x85, 0x71, 0xfe, 0xdf, 0x3a, 0xde, 0x4b,
+	0x54, 0x57, 0x77, 0x2a, 0xfa, 0x4b, 0xac, 0x7a, 0xb9, 0xf2, 0xa9, 0x75, 0x94, 0xab, 0x65, 0x37,
+	0x2e, 0x59, 0xd6, 0xc9, 0xe5, 0xd4, 0xd8, 0x4f, 0xc7, 0x7e, 0xfa, 0x6f, 0xbf, 0x6c, 0xf9, 0x9f,
+	0xfc, 0xb6, 0xef, 0xa0, 0x4d, 0xe3, 0x92, 0x25, 0x25, 0x45, 0xca, 0xe7, 0x99, 0xf6, 0xcb, 0xc5,
+	0x7b, 0x22, 0xf4, 0x6f, 0x19, 0x77, 0x2d, 0x2d, 0x52, 0xa4, 0x4d, 0x1d, 0x24, 0x7b, 0xa8, 0xeb,
+	0x1b, 0x97, 0x11, 0xb6, 0x31, 0x8b, 0x66, 0xa8, 0xb0, 0xea, 0x9d, 0x5f, 0x21, 0xb4, 0x7e, 0xc5,
+	0x6e, 0x76, 0x0e, 0x46, 0x2a, 0xf9, 0xaf, 0xfc, 0xd5, 0xed, 0x7f, 0x45, 0x45, 0xff, 0xdb, 0x2f,
+	0xab, 0x8a, 0xfe, 0x6b, 0x68, 0xf6, 0x6f, 0x9d, 0x08, 0x5f, 0x62, 0xe3, 0xd3, 0xe0, 0xe3, 0x4e,
+	0x83, 0xb1, 0xd1, 0x8e, 0x8d, 0xb6, 0x6a, 0xb4, 0x63, 0x23, 0xbc, 0xc2, 0x08, 0xa1, 0x86, 0xfd,
+	0xf5, 0x0c, 0x9a, 0xdd, 0x4b, 0x68, 0xdc, 0xc2, 0xec, 0xcc, 0x79, 0x8c, 0x6e, 0xe0, 0x2c, 0x3d,
+	0x25, 0x71, 0x1a, 0x05, 0x62, 0x7b, 0x09, 0xf3, 0x9b, 0x6f, 0x7c, 0xf5, 0x4f, 0xef, 0xb6, 0x76,
+	0xc2, 0x28, 0x3d, 0xcd, 0x3a, 0x6e, 0x40, 0x07, 0x5e, 0x44, 0xf3, 0xaf, 0xd3, 0x98, 0x78, 0xe7,
+	0x04, 0xe7, 0x84, 0x5f, 0xff, 0x75, 0x23, 0x31, 0xfc, 0x4a, 0xeb, 0xff, 0x8e, 0x1b, 0x85, 0x57,
+	0xe8, 0xa6, 0xf5, 0x46, 0xe9, 0x07, 0xf2, 0xf7, 0xbf, 0xa6, 0x1b, 0x26, 0x6b, 0x91, 0x1f, 0xff,
+	0x87, 0x89, 0x5d, 0x74, 0x9d, 0x2f, 0x76, 0x8a, 0xfb, 0x7d, 0x79, 0x5b, 0xfb, 0x08, 0xce, 0x07,
+	0xbe, 0xb6, 0x2d, 0x8e, 0xca, 0x86, 0x73, 0x21, 0xcd, 0xd5, 0x23, 0xbf, 0xd9, 0xe2, 0x8d, 0x46,
+	0xaa, 0x56, 0xde, 0xfe, 0x18, 0x36, 0x31, 0x6f, 0x5f, 0x39, 0xaf, 0x60, 0x13, 0x87, 0x34, 0x1f,
+	0x25, 0xb8, 0xc3, 0x69, 0x73, 0x57, 0xc2, 0x86, 0xc9, 0xb7, 0xe0, 0x95, 0x56, 0x31, 0x4a, 0xdb,
+	0xf4, 0xfa, 0x9a, 0x22, 0xab, 0x9c, 0x75, 0x63, 0xab, 0xc4, 0xb9, 0xea, 0x8b, 0xea, 0x8d, 0x2d,
+	0xb4, 0xac, 0xdc, 0xd8, 0x96, 0x28, 0xff, 0xcb, 0x89, 0xf8, 0xc2, 0xca, 0xe2, 0x3e, 0x0d, 0xce,
+	0xfc, 0x9c, 0xb0, 0x34, 0x8a, 0x43, 0x21, 0xe6, 0x83, 0x45, 0x70, 0xde, 0x6d, 0x0b, 0xfe, 0x44,
+	0xd2, 0x60, 0x11, 0x9c, 0xa8, 0xe2, 0xb0, 0x13, 0x1a, 0xb5, 0x37, 0xef, 0x37, 0x27, 0xdf, 0xbe,
+	0xdf, 0x9c, 0xfc, 0xe3, 0xfb, 0xcd, 0xc9, 0xdf, 0x7c, 0xd8, 0x9c, 0x78, 0xfb, 0x61, 0x73, 0xe2,
+	0x0f, 0x1f, 0x36, 0x27, 0x3a, 0x33, 0xe2, 0x7f, 0x1e, 0x76, 0xff, 0x32, 0x00, 0xae, 0xaf, 0xde,
+	0x85, 0x74, 0x22, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -3130,12 +3932,32 @@ func (m *Tx) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], b)
 		}
 	}
+	if m.AggregatedSignature != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AggregatedSignature.Size()))
+		n2, err := m.AggregatedSignature.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Expiration != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Expiration.Size()))
+		n3, err := m.Expiration.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
 	if m.Sum != nil {
-		nn2, err := m.Sum.MarshalTo(dAtA[i:])
+		nn4, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn2
+		i += nn4
 	}
 	return i, nil
 }
@@ -3148,11 +3970,11 @@ func (m *Tx_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n3, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		n5, err := m.CashSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n5
 	}
 	return i, nil
 }
@@ -3164,11 +3986,11 @@ func (m *Tx_EscrowCreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowCreateMsg.Size()))
-		n4, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
+		n6, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n6
 	}
 	return i, nil
 }
@@ -3180,11 +4002,11 @@ func (m *Tx_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n5, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n7, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n7
 	}
 	return i, nil
 }
@@ -3196,11 +4018,11 @@ func (m *Tx_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n6, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		n8, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n8
 	}
 	return i, nil
 }
@@ -3212,11 +4034,11 @@ func (m *Tx_EscrowUpdatePartiesMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdatePartiesMsg.Size()))
-		n7, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
+		n9, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n9
 	}
 	return i, nil
 }
@@ -3228,11 +4050,11 @@ func (m *Tx_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
-		n8, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		n10, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n10
 	}
 	return i, nil
 }
@@ -3244,11 +4066,11 @@ func (m *Tx_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n9, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n11, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n11
 	}
 	return i, nil
 }
@@ -3260,11 +4082,11 @@ func (m *Tx_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n10, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n12, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n12
 	}
 	return i, nil
 }
@@ -3276,11 +4098,11 @@ func (m *Tx_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n11, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		n13, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n13
 	}
 	return i, nil
 }
@@ -3292,11 +4114,11 @@ func (m *Tx_ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteBatchMsg.Size()))
-		n12, err := m.ExecuteBatchMsg.MarshalTo(dAtA[i:])
+		n14, err := m.ExecuteBatchMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n14
 	}
 	return i, nil
 }
@@ -3308,11 +4130,11 @@ func (m *Tx_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n13, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n15, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n15
 	}
 	return i, nil
 }
@@ -3324,11 +4146,11 @@ func (m *Tx_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n14, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n16, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n16
 	}
 	return i, nil
 }
@@ -3340,11 +4162,11 @@ func (m *Tx_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n15, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n17, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n17
 	}
 	return i, nil
 }
@@ -3356,11 +4178,11 @@ func (m *Tx_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n16, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n18, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n18
 	}
 	return i, nil
 }
@@ -3372,11 +4194,11 @@ func (m *Tx_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n17, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n19, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n19
 	}
 	return i, nil
 }
@@ -3388,11 +4210,11 @@ func (m *Tx_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n18, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n20, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n20
 	}
 	return i, nil
 }
@@ -3404,11 +4226,11 @@ func (m *Tx_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
-		n19, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		n21, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n21
 	}
 	return i, nil
 }
@@ -3420,11 +4242,11 @@ func (m *Tx_AswapCreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AswapCreateMsg.Size()))
-		n20, err := m.AswapCreateMsg.MarshalTo(dAtA[i:])
+		n22, err := m.AswapCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n22
 	}
 	return i, nil
 }
@@ -3436,11 +4258,11 @@ func (m *Tx_AswapReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReleaseMsg.Size()))
-		n21, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
+		n23, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n23
 	}
 	return i, nil
 }
@@ -3452,11 +4274,11 @@ func (m *Tx_AswapReturnMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReturnMsg.Size()))
-		n22, err := m.AswapReturnMsg.MarshalTo(dAtA[i:])
+		n24, err := m.AswapReturnMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n24
 	}
 	return i, nil
 }
@@ -3468,11 +4290,11 @@ func (m *Tx_GovCreateProposalMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateProposalMsg.Size()))
-		n23, err := m.GovCreateProposalMsg.MarshalTo(dAtA[i:])
+		n25, err := m.GovCreateProposalMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n25
 	}
 	return i, nil
 }
@@ -3484,11 +4306,11 @@ func (m *Tx_GovDeleteProposalMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovDeleteProposalMsg.Size()))
-		n24, err := m.GovDeleteProposalMsg.MarshalTo(dAtA[i:])
+		n26, err := m.GovDeleteProposalMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n26
 	}
 	return i, nil
 }
@@ -3500,11 +4322,11 @@ func (m *Tx_GovVoteMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovVoteMsg.Size()))
-		n25, err := m.GovVoteMsg.MarshalTo(dAtA[i:])
+		n27, err := m.GovVoteMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n27
 	}
 	return i, nil
 }
@@ -3516,11 +4338,11 @@ func (m *Tx_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n26, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		n28, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n28
 	}
 	return i, nil
 }
@@ -3532,310 +4354,271 @@ func (m *Tx_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n27, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		n29, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
-	}
-	return i, nil
-}
-func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, msg := range m.Messages {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i += n29
 	}
 	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		nn28, err := m.Sum.MarshalTo(dAtA[i:])
+func (m *Tx_GovDelegateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovDelegateMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovDelegateMsg.Size()))
+		n30, err := m.GovDelegateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn28
+		i += n30
 	}
 	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_GovRevokeDelegationMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.CashSendMsg != nil {
-		dAtA[i] = 0x9a
+	if m.GovRevokeDelegationMsg != nil {
+		dAtA[i] = 0x8a
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n29, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovRevokeDelegationMsg.Size()))
+		n31, err := m.GovRevokeDelegationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n31
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_GovVetoMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowCreateMsg != nil {
-		dAtA[i] = 0xa2
+	if m.GovVetoMsg != nil {
+		dAtA[i] = 0x92
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowCreateMsg.Size()))
-		n30, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovVetoMsg.Size()))
+		n32, err := m.GovVetoMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n32
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_SessionRegisterKeyMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReleaseMsg != nil {
+	if m.SessionRegisterKeyMsg != nil {
 		dAtA[i] = 0xaa
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n31, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.SessionRegisterKeyMsg.Size()))
+		n33, err := m.SessionRegisterKeyMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n33
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_SessionRevokeKeyMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReturnMsg != nil {
+	if m.SessionRevokeKeyMsg != nil {
 		dAtA[i] = 0xb2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n32, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.SessionRevokeKeyMsg.Size()))
+		n34, err := m.SessionRevokeKeyMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n34
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_RecoveryConfigureMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowUpdatePartiesMsg != nil {
+	if m.RecoveryConfigureMsg != nil {
 		dAtA[i] = 0xba
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdatePartiesMsg.Size()))
-		n33, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.RecoveryConfigureMsg.Size()))
+		n35, err := m.RecoveryConfigureMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n35
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_RecoveryInitiateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MultisigCreateMsg != nil {
+	if m.RecoveryInitiateMsg != nil {
 		dAtA[i] = 0xc2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
-		n34, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.RecoveryInitiateMsg.Size()))
+		n36, err := m.RecoveryInitiateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n36
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_RecoveryApproveMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MultisigUpdateMsg != nil {
+	if m.RecoveryApproveMsg != nil {
 		dAtA[i] = 0xca
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n35, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.RecoveryApproveMsg.Size()))
+		n37, err := m.RecoveryApproveMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n37
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_RecoveryCancelMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.ValidatorsApplyDiffMsg != nil {
+	if m.RecoveryCancelMsg != nil {
 		dAtA[i] = 0xd2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n36, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.RecoveryCancelMsg.Size()))
+		n38, err := m.RecoveryCancelMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n38
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CurrencyMintMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.CurrencyCreateMsg != nil {
-		dAtA[i] = 0xda
+	if m.CurrencyMintMsg != nil {
+		dAtA[i] = 0xe2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n37, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyMintMsg.Size()))
+		n39, err := m.CurrencyMintMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n39
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CurrencyBurnMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.UsernameRegisterTokenMsg != nil {
+	if m.CurrencyBurnMsg != nil {
 		dAtA[i] = 0xea
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n38, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyBurnMsg.Size()))
+		n40, err := m.CurrencyBurnMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n40
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CurrencyUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.UsernameTransferTokenMsg != nil {
+	if m.CurrencyUpdateMsg != nil {
 		dAtA[i] = 0xf2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n39, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyUpdateMsg.Size()))
+		n41, err := m.CurrencyUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n41
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashMultiSendMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.UsernameChangeTokenTargetsMsg != nil {
-		dAtA[i] = 0xfa
+	if m.CashMultiSendMsg != nil {
+		dAtA[i] = 0x82
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n40, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMultiSendMsg.Size()))
+		n42, err := m.CashMultiSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n42
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_FreezeFreezeMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DistributionCreateMsg != nil {
-		dAtA[i] = 0x92
+	if m.FreezeFreezeMsg != nil {
+		dAtA[i] = 0x8a
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n41, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.FreezeFreezeMsg.Size()))
+		n43, err := m.FreezeFreezeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n43
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_FreezeUnfreezeMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DistributionMsg != nil {
-		dAtA[i] = 0x9a
+	if m.FreezeUnfreezeMsg != nil {
+		dAtA[i] = 0x92
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n42, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.FreezeUnfreezeMsg.Size()))
+		n44, err := m.FreezeUnfreezeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n44
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashMigrateWalletsMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DistributionResetMsg != nil {
-		dAtA[i] = 0xa2
+	if m.CashMigrateWalletsMsg != nil {
+		dAtA[i] = 0x9a
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n43, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMigrateWalletsMsg.Size()))
+		n45, err := m.CashMigrateWalletsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n45
 	}
 	return i, nil
 }
-func (m *ProposalOptions) Marshal() (dAtA []byte, err error) {
+func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3845,22 +4628,52 @@ func (m *ProposalOptions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProposalOptions) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Option != nil {
-		nn44, err := m.Option.MarshalTo(dAtA[i:])
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		nn46, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn44
+		i += nn46
 	}
 	return i, nil
 }
 
-func (m *ProposalOptions_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.CashSendMsg != nil {
 		dAtA[i] = 0x9a
@@ -3868,15 +4681,31 @@ func (m *ProposalOptions_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n45, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		n47, err := m.CashSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n47
 	}
 	return i, nil
 }
-func (m *ProposalOptions_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowCreateMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowCreateMsg.Size()))
+		n48, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.EscrowReleaseMsg != nil {
 		dAtA[i] = 0xaa
@@ -3884,95 +4713,111 @@ func (m *ProposalOptions_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n46, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n49, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n49
 	}
 	return i, nil
 }
-func (m *ProposalOptions_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.UpdateEscrowPartiesMsg != nil {
+	if m.EscrowReturnMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
+		n50, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowUpdatePartiesMsg != nil {
 		dAtA[i] = 0xba
 		i++
 		dAtA[i] = 0x3
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
-		n47, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdatePartiesMsg.Size()))
+		n51, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n51
 	}
 	return i, nil
 }
-func (m *ProposalOptions_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MultisigUpdateMsg != nil {
-		dAtA[i] = 0xca
+	if m.MultisigCreateMsg != nil {
+		dAtA[i] = 0xc2
 		i++
 		dAtA[i] = 0x3
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n48, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
+		n52, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n52
 	}
 	return i, nil
 }
-func (m *ProposalOptions_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.ValidatorsApplyDiffMsg != nil {
-		dAtA[i] = 0xd2
+	if m.MultisigUpdateMsg != nil {
+		dAtA[i] = 0xca
 		i++
 		dAtA[i] = 0x3
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n49, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
+		n53, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n53
 	}
 	return i, nil
 }
-func (m *ProposalOptions_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.CurrencyCreateMsg != nil {
-		dAtA[i] = 0xda
+	if m.ValidatorsApplyDiffMsg != nil {
+		dAtA[i] = 0xd2
 		i++
 		dAtA[i] = 0x3
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n50, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
+		n54, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n54
 	}
 	return i, nil
 }
-func (m *ProposalOptions_ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.ExecuteProposalBatchMsg != nil {
-		dAtA[i] = 0xe2
+	if m.CurrencyCreateMsg != nil {
+		dAtA[i] = 0xda
 		i++
 		dAtA[i] = 0x3
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteProposalBatchMsg.Size()))
-		n51, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
+		n55, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n55
 	}
 	return i, nil
 }
-func (m *ProposalOptions_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.UsernameRegisterTokenMsg != nil {
 		dAtA[i] = 0xea
@@ -3980,15 +4825,15 @@ func (m *ProposalOptions_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n52, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n56, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n56
 	}
 	return i, nil
 }
-func (m *ProposalOptions_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.UsernameTransferTokenMsg != nil {
 		dAtA[i] = 0xf2
@@ -3996,15 +4841,15 @@ func (m *ProposalOptions_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n53, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n57, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n57
 	}
 	return i, nil
 }
-func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.UsernameChangeTokenTargetsMsg != nil {
 		dAtA[i] = 0xfa
@@ -4012,15 +4857,15 @@ func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n54, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n58, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n58
 	}
 	return i, nil
 }
-func (m *ProposalOptions_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.DistributionCreateMsg != nil {
 		dAtA[i] = 0x92
@@ -4028,15 +4873,15 @@ func (m *ProposalOptions_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n55, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n59, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n59
 	}
 	return i, nil
 }
-func (m *ProposalOptions_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.DistributionMsg != nil {
 		dAtA[i] = 0x9a
@@ -4044,15 +4889,15 @@ func (m *ProposalOptions_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n56, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n60, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n60
 	}
 	return i, nil
 }
-func (m *ProposalOptions_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.DistributionResetMsg != nil {
 		dAtA[i] = 0xa2
@@ -4060,79 +4905,79 @@ func (m *ProposalOptions_DistributionResetMsg) MarshalTo(dAtA []byte) (int, erro
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n57, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n61, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n61
 	}
 	return i, nil
 }
-func (m *ProposalOptions_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CurrencyMintMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MigrationUpgradeSchemaMsg != nil {
-		dAtA[i] = 0xaa
+	if m.CurrencyMintMsg != nil {
+		dAtA[i] = 0xe2
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
-		n58, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyMintMsg.Size()))
+		n62, err := m.CurrencyMintMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n62
 	}
 	return i, nil
 }
-func (m *ProposalOptions_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CurrencyBurnMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.GovUpdateElectorateMsg != nil {
+	if m.CurrencyBurnMsg != nil {
 		dAtA[i] = 0xea
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n59, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyBurnMsg.Size()))
+		n63, err := m.CurrencyBurnMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n63
 	}
 	return i, nil
 }
-func (m *ProposalOptions_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CurrencyUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.GovUpdateElectionRuleMsg != nil {
+	if m.CurrencyUpdateMsg != nil {
 		dAtA[i] = 0xf2
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n60, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyUpdateMsg.Size()))
+		n64, err := m.CurrencyUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n64
 	}
 	return i, nil
 }
-func (m *ProposalOptions_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CashMultiSendMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.GovCreateTextResolutionMsg != nil {
-		dAtA[i] = 0xfa
+	if m.CashMultiSendMsg != nil {
+		dAtA[i] = 0x82
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
-		n61, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMultiSendMsg.Size()))
+		n65, err := m.CashMultiSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n65
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg) Marshal() (dAtA []byte, err error) {
+func (m *ProposalOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4142,68 +4987,38 @@ func (m *ExecuteProposalBatchMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for _, msg := range m.Messages {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
-}
-
-func (m *ExecuteProposalBatchMsg_Union) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteProposalBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		nn62, err := m.Sum.MarshalTo(dAtA[i:])
+	if m.Option != nil {
+		nn66, err := m.Option.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn62
+		i += nn66
 	}
 	return i, nil
 }
 
-func (m *ExecuteProposalBatchMsg_Union_SendMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.SendMsg != nil {
+	if m.CashSendMsg != nil {
 		dAtA[i] = 0x9a
 		i++
 		dAtA[i] = 0x3
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.SendMsg.Size()))
-		n63, err := m.SendMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
+		n67, err := m.CashSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n67
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.EscrowReleaseMsg != nil {
 		dAtA[i] = 0xaa
@@ -4211,15 +5026,15 @@ func (m *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n64, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n68, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n68
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.UpdateEscrowPartiesMsg != nil {
 		dAtA[i] = 0xba
@@ -4227,15 +5042,15 @@ func (m *ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
-		n65, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		n69, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n69
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.MultisigUpdateMsg != nil {
 		dAtA[i] = 0xca
@@ -4243,15 +5058,15 @@ func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n66, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n70, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n70
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.ValidatorsApplyDiffMsg != nil {
 		dAtA[i] = 0xd2
@@ -4259,15 +5074,47 @@ func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n67, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n71, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n71
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CurrencyCreateMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
+		n72, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
+	}
+	return i, nil
+}
+func (m *ProposalOptions_ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ExecuteProposalBatchMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteProposalBatchMsg.Size()))
+		n73, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
+	}
+	return i, nil
+}
+func (m *ProposalOptions_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.UsernameRegisterTokenMsg != nil {
 		dAtA[i] = 0xea
@@ -4275,15 +5122,15 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n68, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n74, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n74
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.UsernameTransferTokenMsg != nil {
 		dAtA[i] = 0xf2
@@ -4291,15 +5138,15 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n69, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n75, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n75
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.UsernameChangeTokenTargetsMsg != nil {
 		dAtA[i] = 0xfa
@@ -4307,15 +5154,15 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n70, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n76, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n76
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.DistributionCreateMsg != nil {
 		dAtA[i] = 0x92
@@ -4323,15 +5170,15 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n71, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n77, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n77
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.DistributionMsg != nil {
 		dAtA[i] = 0x9a
@@ -4339,15 +5186,15 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n72, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n78, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n78
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.DistributionResetMsg != nil {
 		dAtA[i] = 0xa2
@@ -4355,15 +5202,31 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n73, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n79, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n79
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MigrationUpgradeSchemaMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
+		n80, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n80
+	}
+	return i, nil
+}
+func (m *ProposalOptions_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.GovUpdateElectorateMsg != nil {
 		dAtA[i] = 0xea
@@ -4371,15 +5234,15 @@ func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n74, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		n81, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n81
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.GovUpdateElectionRuleMsg != nil {
 		dAtA[i] = 0xf2
@@ -4387,15 +5250,15 @@ func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) MarshalTo(dAtA
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n75, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		n82, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n82
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.GovCreateTextResolutionMsg != nil {
 		dAtA[i] = 0xfa
@@ -4403,15 +5266,15 @@ func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) MarshalTo(dAt
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
-		n76, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		n83, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n83
 	}
 	return i, nil
 }
-func (m *CronTask) Marshal() (dAtA []byte, err error) {
+func (m *ExecuteProposalBatchMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4421,30 +5284,68 @@ func (m *CronTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Authenticators) > 0 {
-		for _, b := range m.Authenticators {
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
 			dAtA[i] = 0xa
 			i++
-			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
+	return i, nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
 	if m.Sum != nil {
-		nn77, err := m.Sum.MarshalTo(dAtA[i:])
+		nn84, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn77
+		i += nn84
 	}
 	return i, nil
 }
 
-func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteProposalBatchMsg_Union_SendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SendMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SendMsg.Size()))
+		n85, err := m.SendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n85
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.EscrowReleaseMsg != nil {
 		dAtA[i] = 0xaa
@@ -4452,94 +5353,399 @@ func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n78, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n86, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n86
 	}
 	return i, nil
 }
-func (m *CronTask_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReturnMsg != nil {
-		dAtA[i] = 0xb2
+	if m.UpdateEscrowPartiesMsg != nil {
+		dAtA[i] = 0xba
 		i++
 		dAtA[i] = 0x3
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n79, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
+		n87, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
+		i += n87
 	}
 	return i, nil
 }
-func (m *CronTask_DistributionDistributeMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DistributionDistributeMsg != nil {
-		dAtA[i] = 0x9a
+	if m.MultisigUpdateMsg != nil {
+		dAtA[i] = 0xca
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x3
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionDistributeMsg.Size()))
-		n80, err := m.DistributionDistributeMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
+		n88, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n80
+		i += n88
 	}
 	return i, nil
 }
-func (m *CronTask_AswapReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.AswapReleaseMsg != nil {
-		dAtA[i] = 0xba
+	if m.ValidatorsApplyDiffMsg != nil {
+		dAtA[i] = 0xd2
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x3
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReleaseMsg.Size()))
-		n81, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
+		n89, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n81
+		i += n89
 	}
 	return i, nil
 }
-func (m *CronTask_GovTallyMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.GovTallyMsg != nil {
-		dAtA[i] = 0xe2
+	if m.UsernameRegisterTokenMsg != nil {
+		dAtA[i] = 0xea
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x3
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.GovTallyMsg.Size()))
-		n82, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
+		n90, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n82
+		i += n90
 	}
 	return i, nil
 }
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UsernameTransferTokenMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
+		n91, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n91
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return i, nil
 }
-func (m *Tx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UsernameChangeTokenTargetsMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
+		n92, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n92
 	}
-	var l int
-	_ = l
-	if m.Fees != nil {
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionCreateMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
+		n93, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n93
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
+		n94, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n94
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionResetMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
+		n95, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n95
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectorateMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
+		n96, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n96
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectionRuleMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
+		n97, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n97
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovCreateTextResolutionMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
+		n98, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n98
+	}
+	return i, nil
+}
+func (m *CronTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for _, b := range m.Authenticators {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.Sum != nil {
+		nn99, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn99
+	}
+	return i, nil
+}
+
+func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReleaseMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
+		n100, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n100
+	}
+	return i, nil
+}
+func (m *CronTask_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReturnMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
+		n101, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n101
+	}
+	return i, nil
+}
+func (m *CronTask_DistributionDistributeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionDistributeMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionDistributeMsg.Size()))
+		n102, err := m.DistributionDistributeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n102
+	}
+	return i, nil
+}
+func (m *CronTask_AswapReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.AswapReleaseMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReleaseMsg.Size()))
+		n103, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n103
+	}
+	return i, nil
+}
+func (m *CronTask_GovTallyMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovTallyMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovTallyMsg.Size()))
+		n104, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n104
+	}
+	return i, nil
+}
+func (m *CronTask_GovExecuteProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovExecuteProposalMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovExecuteProposalMsg.Size()))
+		n105, err := m.GovExecuteProposalMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n105
+	}
+	return i, nil
+}
+func (m *CronTask_MultisigExecuteUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigExecuteUpdateMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigExecuteUpdateMsg.Size()))
+		n106, err := m.MultisigExecuteUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n106
+	}
+	return i, nil
+}
+func (m *CronTask_RecoveryExecuteMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.RecoveryExecuteMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RecoveryExecuteMsg.Size()))
+		n107, err := m.RecoveryExecuteMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n107
+	}
+	return i, nil
+}
+func (m *CronTask_CashUnlockVestingMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashUnlockVestingMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashUnlockVestingMsg.Size()))
+		n108, err := m.CashUnlockVestingMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n108
+	}
+	return i, nil
+}
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Tx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fees != nil {
 		l = m.Fees.Size()
 		n += 1 + l + sovCodec(uint64(l))
 	}
@@ -4555,6 +5761,14 @@ func (m *Tx) Size() (n int) {
 			n += 1 + l + sovCodec(uint64(l))
 		}
 	}
+	if m.AggregatedSignature != nil {
+		l = m.AggregatedSignature.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	if m.Sum != nil {
 		n += m.Sum.Size()
 	}
@@ -4861,22 +6075,214 @@ func (m *Tx_GovUpdateElectionRuleMsg) Size() (n int) {
 	}
 	return n
 }
-func (m *ExecuteBatchMsg) Size() (n int) {
+func (m *Tx_GovDelegateMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
-		}
+	if m.GovDelegateMsg != nil {
+		l = m.GovDelegateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-
-func (m *ExecuteBatchMsg_Union) Size() (n int) {
+func (m *Tx_GovRevokeDelegationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovRevokeDelegationMsg != nil {
+		l = m.GovRevokeDelegationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovVetoMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovVetoMsg != nil {
+		l = m.GovVetoMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_SessionRegisterKeyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionRegisterKeyMsg != nil {
+		l = m.SessionRegisterKeyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_SessionRevokeKeyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionRevokeKeyMsg != nil {
+		l = m.SessionRevokeKeyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_RecoveryConfigureMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecoveryConfigureMsg != nil {
+		l = m.RecoveryConfigureMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_RecoveryInitiateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecoveryInitiateMsg != nil {
+		l = m.RecoveryInitiateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_RecoveryApproveMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecoveryApproveMsg != nil {
+		l = m.RecoveryApproveMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_RecoveryCancelMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecoveryCancelMsg != nil {
+		l = m.RecoveryCancelMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CurrencyMintMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyMintMsg != nil {
+		l = m.CurrencyMintMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CurrencyBurnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyBurnMsg != nil {
+		l = m.CurrencyBurnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CurrencyUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyUpdateMsg != nil {
+		l = m.CurrencyUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CashMultiSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMultiSendMsg != nil {
+		l = m.CashMultiSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_FreezeFreezeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FreezeFreezeMsg != nil {
+		l = m.FreezeFreezeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_FreezeUnfreezeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FreezeUnfreezeMsg != nil {
+		l = m.FreezeUnfreezeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CashMigrateWalletsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMigrateWalletsMsg != nil {
+		l = m.CashMigrateWalletsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecuteBatchMsg_Union) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5068,6 +6474,54 @@ func (m *ExecuteBatchMsg_Union_DistributionResetMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *ExecuteBatchMsg_Union_CurrencyMintMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyMintMsg != nil {
+		l = m.CurrencyMintMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CurrencyBurnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyBurnMsg != nil {
+		l = m.CurrencyBurnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CurrencyUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyUpdateMsg != nil {
+		l = m.CurrencyUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashMultiSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMultiSendMsg != nil {
+		l = m.CashMultiSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *ProposalOptions) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5557,6 +7011,54 @@ func (m *CronTask_GovTallyMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *CronTask_GovExecuteProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovExecuteProposalMsg != nil {
+		l = m.GovExecuteProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_MultisigExecuteUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigExecuteUpdateMsg != nil {
+		l = m.MultisigExecuteUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_RecoveryExecuteMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecoveryExecuteMsg != nil {
+		l = m.RecoveryExecuteMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_CashUnlockVestingMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashUnlockVestingMsg != nil {
+		l = m.CashUnlockVestingMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 
 func sovCodec(x uint64) (n int) {
 	for {
@@ -5581,28 +7083,622 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowCodec
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fees == nil {
+				m.Fees = &cash.FeeInfo{}
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &sigs.StdSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multisig = append(m.Multisig, make([]byte, postIndex-iNdEx))
+			copy(m.Multisig[len(m.Multisig)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSignature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AggregatedSignature == nil {
+				m.AggregatedSignature = &sigs.AggregatedSignature{}
+			}
+			if err := m.AggregatedSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &sigs.TxExpiration{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.SendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashSendMsg{v}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowCreateMsg{v}
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ReleaseMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowReleaseMsg{v}
+			iNdEx = postIndex
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReturnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ReturnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowReturnMsg{v}
+			iNdEx = postIndex
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdatePartiesMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.UpdatePartiesMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowUpdatePartiesMsg{v}
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigCreateMsg{v}
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.UpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigUpdateMsg{v}
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &validators.ApplyDiffMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ValidatorsApplyDiffMsg{v}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &currency.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CurrencyCreateMsg{v}
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteBatchMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExecuteBatchMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ExecuteBatchMsg{v}
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.RegisterTokenMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_UsernameRegisterTokenMsg{v}
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &username.TransferTokenMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Sum = &Tx_UsernameTransferTokenMsg{v}
+			iNdEx = postIndex
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5629,16 +7725,50 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fees == nil {
-				m.Fees = &cash.FeeInfo{}
+			v := &username.ChangeTokenTargetsMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sum = &Tx_UsernameChangeTokenTargetsMsg{v}
+			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Tx_DistributionCreateMsg{v}
 			iNdEx = postIndex
-		case 2:
+		case 67:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5665,16 +7795,17 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, &sigs.StdSignature{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &distribution.DistributeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Tx_DistributionMsg{v}
 			iNdEx = postIndex
-		case 4:
+		case 68:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -5684,27 +7815,30 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Multisig = append(m.Multisig, make([]byte, postIndex-iNdEx))
-			copy(m.Multisig[len(m.Multisig)-1], dAtA[iNdEx:postIndex])
+			v := &distribution.ResetMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_DistributionResetMsg{v}
 			iNdEx = postIndex
-		case 51:
+		case 69:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5731,15 +7865,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
+			v := &migration.UpgradeSchemaMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CashSendMsg{v}
+			m.Sum = &Tx_MigrationUpgradeSchemaMsg{v}
 			iNdEx = postIndex
-		case 52:
+		case 70:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AswapCreateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5766,15 +7900,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.CreateMsg{}
+			v := &aswap.CreateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_EscrowCreateMsg{v}
+			m.Sum = &Tx_AswapCreateMsg{v}
 			iNdEx = postIndex
-		case 53:
+		case 71:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AswapReleaseMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5801,15 +7935,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReleaseMsg{}
+			v := &aswap.ReleaseMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_EscrowReleaseMsg{v}
+			m.Sum = &Tx_AswapReleaseMsg{v}
 			iNdEx = postIndex
-		case 54:
+		case 72:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReturnMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AswapReturnMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5836,15 +7970,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReturnMsg{}
+			v := &aswap.ReturnMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_EscrowReturnMsg{v}
+			m.Sum = &Tx_AswapReturnMsg{v}
 			iNdEx = postIndex
-		case 55:
+		case 73:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdatePartiesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateProposalMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5871,15 +8005,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.UpdatePartiesMsg{}
+			v := &gov.CreateProposalMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_EscrowUpdatePartiesMsg{v}
+			m.Sum = &Tx_GovCreateProposalMsg{v}
 			iNdEx = postIndex
-		case 56:
+		case 74:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovDeleteProposalMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5906,15 +8040,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.CreateMsg{}
+			v := &gov.DeleteProposalMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MultisigCreateMsg{v}
+			m.Sum = &Tx_GovDeleteProposalMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 75:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovVoteMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5941,15 +8075,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
+			v := &gov.VoteMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MultisigUpdateMsg{v}
+			m.Sum = &Tx_GovVoteMsg{v}
 			iNdEx = postIndex
-		case 58:
+		case 77:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5976,15 +8110,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &validators.ApplyDiffMsg{}
+			v := &gov.UpdateElectorateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_ValidatorsApplyDiffMsg{v}
+			m.Sum = &Tx_GovUpdateElectorateMsg{v}
 			iNdEx = postIndex
-		case 59:
+		case 78:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6011,15 +8145,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &currency.CreateMsg{}
+			v := &gov.UpdateElectionRuleMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CurrencyCreateMsg{v}
+			m.Sum = &Tx_GovUpdateElectionRuleMsg{v}
 			iNdEx = postIndex
-		case 60:
+		case 80:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteBatchMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovDelegateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6046,15 +8180,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ExecuteBatchMsg{}
+			v := &gov.DelegateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_ExecuteBatchMsg{v}
+			m.Sum = &Tx_GovDelegateMsg{v}
 			iNdEx = postIndex
-		case 61:
+		case 81:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovRevokeDelegationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6081,15 +8215,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.RegisterTokenMsg{}
+			v := &gov.RevokeDelegationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_UsernameRegisterTokenMsg{v}
+			m.Sum = &Tx_GovRevokeDelegationMsg{v}
 			iNdEx = postIndex
-		case 62:
+		case 82:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovVetoMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6116,15 +8250,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.TransferTokenMsg{}
+			v := &gov.VetoMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_UsernameTransferTokenMsg{v}
+			m.Sum = &Tx_GovVetoMsg{v}
 			iNdEx = postIndex
-		case 63:
+		case 85:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionRegisterKeyMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6151,15 +8285,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.ChangeTokenTargetsMsg{}
+			v := &session.RegisterKeyMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_UsernameChangeTokenTargetsMsg{v}
+			m.Sum = &Tx_SessionRegisterKeyMsg{v}
 			iNdEx = postIndex
-		case 66:
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionRevokeKeyMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6186,15 +8320,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.CreateMsg{}
+			v := &session.RevokeKeyMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_DistributionCreateMsg{v}
+			m.Sum = &Tx_SessionRevokeKeyMsg{v}
 			iNdEx = postIndex
-		case 67:
+		case 87:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryConfigureMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6221,15 +8355,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.DistributeMsg{}
+			v := &recovery.ConfigureMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_DistributionMsg{v}
+			m.Sum = &Tx_RecoveryConfigureMsg{v}
 			iNdEx = postIndex
-		case 68:
+		case 88:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryInitiateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6256,15 +8390,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.ResetMsg{}
+			v := &recovery.InitiateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_DistributionResetMsg{v}
+			m.Sum = &Tx_RecoveryInitiateMsg{v}
 			iNdEx = postIndex
-		case 69:
+		case 89:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryApproveMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6291,15 +8425,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &migration.UpgradeSchemaMsg{}
+			v := &recovery.ApproveMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MigrationUpgradeSchemaMsg{v}
+			m.Sum = &Tx_RecoveryApproveMsg{v}
 			iNdEx = postIndex
-		case 70:
+		case 90:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AswapCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryCancelMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6326,15 +8460,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &aswap.CreateMsg{}
+			v := &recovery.CancelMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AswapCreateMsg{v}
+			m.Sum = &Tx_RecoveryCancelMsg{v}
 			iNdEx = postIndex
-		case 71:
+		case 92:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AswapReleaseMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyMintMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6361,15 +8495,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &aswap.ReleaseMsg{}
+			v := &currency.MintMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AswapReleaseMsg{v}
+			m.Sum = &Tx_CurrencyMintMsg{v}
 			iNdEx = postIndex
-		case 72:
+		case 93:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AswapReturnMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyBurnMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6396,15 +8530,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &aswap.ReturnMsg{}
+			v := &currency.BurnMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AswapReturnMsg{v}
+			m.Sum = &Tx_CurrencyBurnMsg{v}
 			iNdEx = postIndex
-		case 73:
+		case 94:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateProposalMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyUpdateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6431,15 +8565,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.CreateProposalMsg{}
+			v := &currency.UpdateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovCreateProposalMsg{v}
+			m.Sum = &Tx_CurrencyUpdateMsg{v}
 			iNdEx = postIndex
-		case 74:
+		case 96:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovDeleteProposalMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMultiSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6466,15 +8600,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.DeleteProposalMsg{}
+			v := &cash.MultiSendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovDeleteProposalMsg{v}
+			m.Sum = &Tx_CashMultiSendMsg{v}
 			iNdEx = postIndex
-		case 75:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovVoteMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeFreezeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6501,15 +8635,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.VoteMsg{}
+			v := &freeze.FreezeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovVoteMsg{v}
+			m.Sum = &Tx_FreezeFreezeMsg{v}
 			iNdEx = postIndex
-		case 77:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeUnfreezeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6536,15 +8670,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectorateMsg{}
+			v := &freeze.UnfreezeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovUpdateElectorateMsg{v}
+			m.Sum = &Tx_FreezeUnfreezeMsg{v}
 			iNdEx = postIndex
-		case 78:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMigrateWalletsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6571,11 +8705,11 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectionRuleMsg{}
+			v := &cash.MigrateWalletsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovUpdateElectionRuleMsg{v}
+			m.Sum = &Tx_CashMigrateWalletsMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7242,6 +9376,146 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &ExecuteBatchMsg_Union_DistributionResetMsg{v}
 			iNdEx = postIndex
+		case 92:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyMintMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &currency.MintMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CurrencyMintMsg{v}
+			iNdEx = postIndex
+		case 93:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyBurnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &currency.BurnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CurrencyBurnMsg{v}
+			iNdEx = postIndex
+		case 94:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &currency.UpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CurrencyUpdateMsg{v}
+			iNdEx = postIndex
+		case 96:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMultiSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.MultiSendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CashMultiSendMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -8780,6 +11054,146 @@ func (m *CronTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &CronTask_GovTallyMsg{v}
 			iNdEx = postIndex
+		case 83:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovExecuteProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.ExecuteProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_GovExecuteProposalMsg{v}
+			iNdEx = postIndex
+		case 84:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigExecuteUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.ExecuteUpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_MultisigExecuteUpdateMsg{v}
+			iNdEx = postIndex
+		case 91:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryExecuteMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &recovery.ExecuteMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_RecoveryExecuteMsg{v}
+			iNdEx = postIndex
+		case 95:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashUnlockVestingMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.UnlockVestingMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_CashUnlockVestingMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -4,9 +4,12 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/x/aswap"
+	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/recovery"
 )
 
 // CronTaskMarshaler is a task marshaler implementation to be used by the bnsd
@@ -47,6 +50,22 @@ func (taskMarshaler) MarshalTask(auth []weave.Condition, msg weave.Msg) ([]byte,
 		t.Sum = &CronTask_GovTallyMsg{
 			GovTallyMsg: msg,
 		}
+	case *gov.ExecuteProposalMsg:
+		t.Sum = &CronTask_GovExecuteProposalMsg{
+			GovExecuteProposalMsg: msg,
+		}
+	case *multisig.ExecuteUpdateMsg:
+		t.Sum = &CronTask_MultisigExecuteUpdateMsg{
+			MultisigExecuteUpdateMsg: msg,
+		}
+	case *recovery.ExecuteMsg:
+		t.Sum = &CronTask_RecoveryExecuteMsg{
+			RecoveryExecuteMsg: msg,
+		}
+	case *cash.UnlockVestingMsg:
+		t.Sum = &CronTask_CashUnlockVestingMsg{
+			CashUnlockVestingMsg: msg,
+		}
 	}
 
 	raw, err := t.Marshal()
@@ -22,6 +22,8 @@ var (
 	source = makePrivKey("1234567890")
 	dst    = makePrivKey("F00BA411").PublicKey().Address()
 	guest  = makePrivKey("00CAFE00F00D").PublicKey().Address()
+
+	secpSource = crypto.PrivKeySecp256k1FromSeed([]byte("1234567890"))
 )
 
 // makePrivKey repeats the string as long as needed to get 64 digits, then
@@ -83,6 +85,23 @@ func Examples() []commands.Example {
 	}
 	tx.Signatures = []*sigs.StdSignature{sig}
 
+	secpPub := secpSource.PublicKey()
+	secpMsg := &cash.SendMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Amount:      &amt,
+		Destination: dst,
+		Source:      secpPub.Address(),
+		Memo:        "Test payment",
+	}
+	secpTx := Tx{
+		Sum: &Tx_CashSendMsg{secpMsg},
+	}
+	secpSig, err := sigs.SignTx(secpSource, &secpTx, "test-123", 17)
+	if err != nil {
+		panic(err)
+	}
+	secpTx.Signatures = []*sigs.StdSignature{secpSig}
+
 	registerTokenMsg := &username.RegisterTokenMsg{
 		Metadata: &weave.Metadata{Schema: 1},
 		Username: "alice*iov",
@@ -116,6 +135,9 @@ func Examples() []commands.Example {
 		{Filename: "send_msg", Obj: msg},
 		{Filename: "unsigned_tx", Obj: &unsigned},
 		{Filename: "signed_tx", Obj: &tx},
+		{Filename: "priv_key_secp256k1", Obj: secpSource},
+		{Filename: "pub_key_secp256k1", Obj: secpPub},
+		{Filename: "signed_tx_secp256k1", Obj: &secpTx},
 		{Filename: "username_register_token_msg", Obj: registerTokenMsg},
 		{Filename: "username_register_token_tx", Obj: registerTokenTx},
 		{Filename: "username_change_token_targets_msg", Obj: changeTokenTargetsMsg},
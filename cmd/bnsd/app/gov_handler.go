@@ -26,7 +26,7 @@ func decodeProposalOptions(raw []byte) (weave.Msg, error) {
 
 // proposalOptionsExecutor will set up an executor to allow governance-internal actions
 // such a setup can be easily extended to allow many more actions in other modules.
-func proposalOptionsExecutor(ctrl cash.Controller) gov.Executor {
+func proposalOptionsExecutor(ctrl cash.Controller, scheduler weave.Scheduler) gov.Executor {
 	r := app.NewRouter()
 
 	// we only allow these to be authenticated by the governance context, not by sigs or other items
@@ -35,7 +35,7 @@ func proposalOptionsExecutor(ctrl cash.Controller) gov.Executor {
 	// Make sure to register for all items in ProposalOptions
 	cash.RegisterRoutes(r, auth, ctrl)
 	validators.RegisterRoutes(r, auth)
-	escrow.RegisterRoutes(r, auth, ctrl)
+	escrow.RegisterRoutes(r, auth, ctrl, scheduler)
 	distribution.RegisterRoutes(r, auth, ctrl)
 	migration.RegisterRoutes(r, auth)
 	gov.RegisterBasicProposalRouters(r, auth)
@@ -15,6 +15,7 @@ import (
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
@@ -95,7 +96,7 @@ func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
 	application.WithInit(app.ChainInitializers(
 		&migration.Initializer{},
 		&multisig.Initializer{},
-		&cash.Initializer{},
+		&cash.Initializer{Scheduler: cron.NewScheduler(CronTaskMarshaler)},
 		&currency.Initializer{},
 		&validators.Initializer{},
 		&distribution.Initializer{},
@@ -94,10 +94,13 @@ func appStateGenesis(keyAddress weave.Address) []byte {
 			{"ver": 1, "pkg": "currency"},
 			{"ver": 1, "pkg": "distribution"},
 			{"ver": 1, "pkg": "escrow"},
+			{"ver": 1, "pkg": "freeze"},
 			{"ver": 1, "pkg": "gov"},
 			{"ver": 1, "pkg": "msgfee"},
 			{"ver": 1, "pkg": "multisig"},
 			{"ver": 1, "pkg": "paychan"},
+			{"ver": 1, "pkg": "recovery"},
+			{"ver": 1, "pkg": "session"},
 			{"ver": 1, "pkg": "sigs"},
 			{"ver": 1, "pkg": "username"},
 			{"ver": 1, "pkg": "utils"},
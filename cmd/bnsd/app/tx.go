@@ -26,6 +26,8 @@ func TxDecoder(bz []byte) (weave.Tx, error) {
 var _ weave.Tx = (*Tx)(nil)
 var _ cash.FeeTx = (*Tx)(nil)
 var _ sigs.SignedTx = (*Tx)(nil)
+var _ sigs.AggregatedSignedTx = (*Tx)(nil)
+var _ sigs.ExpiringTx = (*Tx)(nil)
 var _ multisig.MultiSigTx = (*Tx)(nil)
 
 // GetMsg switches over all types defined in the protobuf file
@@ -37,12 +39,12 @@ func (tx *Tx) GetMsg() (weave.Msg, error) {
 func (tx *Tx) GetSignBytes() ([]byte, error) {
 	// temporarily unset the signatures, as the sign bytes
 	// should only come from the data itself, not previous signatures
-	sigs := tx.Signatures
-	tx.Signatures = nil
+	sigs, agg := tx.Signatures, tx.AggregatedSignature
+	tx.Signatures, tx.AggregatedSignature = nil, nil
 
 	bz, err := tx.Marshal()
 
 	// reset the signatures after calculating the bytes
-	tx.Signatures = sigs
+	tx.Signatures, tx.AggregatedSignature = sigs, agg
 	return bz, err
 }
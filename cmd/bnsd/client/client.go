@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
@@ -9,6 +10,9 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/sigs"
 	"github.com/pkg/errors"
@@ -66,6 +70,7 @@ type Nonce struct {
 	mutex     sync.Mutex
 	client    Client
 	addr      weave.Address
+	lane      uint32
 	nonce     int64
 	fromQuery bool
 }
@@ -76,18 +81,41 @@ func NewNonce(client Client, addr weave.Address) *Nonce {
 	return &Nonce{client: client, addr: addr}
 }
 
+// NewLaneNonce creates a nonce for a client / address pair that is using
+// given nonce lane. Each lane maintains an independent sequence, so many
+// nonces of different lanes can be used in parallel.
+func NewLaneNonce(client Client, addr weave.Address, lane uint32) *Nonce {
+	return &Nonce{client: client, addr: addr, lane: lane}
+}
+
+// Lane returns the ID of the nonce lane used to sign transactions.
+func (n *Nonce) Lane() uint32 {
+	return n.lane
+}
+
 // Query always queries the blockchain for the next nonce
 func (n *Nonce) Query() (int64, error) {
-	user, err := n.client.GetUser(n.addr)
-	if err != nil {
-		return 0, err
-	}
-	n.mutex.Lock()
-	if user != nil {
-		n.nonce = user.UserData.Sequence
+	var seq int64
+	if n.lane == 0 {
+		user, err := n.client.GetUser(n.addr)
+		if err != nil {
+			return 0, err
+		}
+		if user != nil {
+			seq = user.UserData.Sequence
+		}
 	} else {
-		n.nonce = 0 // new account starts at 0
+		lane, err := getLane(n.client, n.addr, n.lane)
+		if err != nil {
+			return 0, err
+		}
+		if lane != nil {
+			seq = lane.LaneData.Sequence
+		}
 	}
+	n.mutex.Lock()
+	// new account or lane starts at 0
+	n.nonce = seq
 	n.fromQuery = true
 	n.mutex.Unlock()
 	return n.nonce, nil
@@ -196,6 +224,53 @@ func (b *BnsClient) TxSearch(query string, prove bool, page, perPage int) (*ctyp
 	return b.conn.TxSearch(query, prove, page, perPage)
 }
 
+// WalletHistoryEntry is a single transaction that changed the balance of a
+// wallet.
+type WalletHistoryEntry struct {
+	Height int64
+	Hash   []byte
+	Tx     bnsd.Tx
+}
+
+// WalletHistoryResponse is a single page of the wallet history.
+type WalletHistoryResponse struct {
+	Entries    []WalletHistoryEntry
+	TotalCount int
+}
+
+// WalletHistory returns transactions that changed the balance of given
+// address, ordered by height. Pages are numbered starting with 1.
+//
+// History is available only if the node indexes the cash.WalletHistoryTag
+// tag. This index is node local and not a part of the consensus.
+//
+// Only transactions are included. Balance changes done by the cron, like
+// escrow timeouts, vesting unlocks, governance proposal execution or payment
+// channel settlement, are tagged in the BeginBlock response of the block they
+// happen in and are not returned by a transaction search.
+func (b *BnsClient) WalletHistory(addr weave.Address, page, perPage int) (*WalletHistoryResponse, error) {
+	if err := addr.Validate(); err != nil {
+		return nil, errors.WithMessage(err, "Invalid Address")
+	}
+	query := fmt.Sprintf("%s='%s'", cash.WalletHistoryTag, addr)
+	res, err := b.TxSearch(query, false, page, perPage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to search transactions")
+	}
+	out := WalletHistoryResponse{
+		Entries:    make([]WalletHistoryEntry, len(res.Txs)),
+		TotalCount: res.TotalCount,
+	}
+	for i, r := range res.Txs {
+		out.Entries[i].Height = r.Height
+		out.Entries[i].Hash = r.Hash
+		if err := out.Entries[i].Tx.Unmarshal(r.Tx); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal transaction %X", r.Hash)
+		}
+	}
+	return &out, nil
+}
+
 // BroadcastTxResponse is the result of submitting a transaction.
 type BroadcastTxResponse struct {
 	Error    error                           // not-nil if there was an error sending
@@ -402,6 +477,17 @@ type CurrenciesResponse struct {
 	Currencies map[string]currency.TokenInfo
 }
 
+// Format returns a human readable representation of given amount, using the
+// number of decimals declared by its currency. Amount of an unknown currency
+// is displayed with all fractional digits.
+func (c CurrenciesResponse) Format(amount coin.Coin) string {
+	ti, ok := c.Currencies[amount.Ticker]
+	if !ok {
+		return amount.String()
+	}
+	return ti.Format(amount)
+}
+
 // Currencies will returns all currencies configured for the blockchain with their token details.
 func (b *BnsClient) Currencies() (CurrenciesResponse, error) {
 	out := CurrenciesResponse{
@@ -472,3 +558,52 @@ func (b *BnsClient) GetUser(addr weave.Address) (*UserResponse, error) {
 func userKeyToAddr(key []byte) weave.Address {
 	return key[5:]
 }
+
+// LaneResponse is a response on a query for a nonce lane
+type LaneResponse struct {
+	Address  weave.Address
+	Lane     uint32
+	LaneData sigs.LaneData
+	Height   int64
+}
+
+// GetLane will return the sequence of given nonce lane of an address.
+// If it returns (nil, nil), then this lane was never used
+// before (and can use nonce = 0)
+func (b *BnsClient) GetLane(addr weave.Address, lane uint32) (*LaneResponse, error) {
+	return getLane(b, addr, lane)
+}
+
+func getLane(c Client, addr weave.Address, lane uint32) (*LaneResponse, error) {
+	// make sure we send a valid address to the server
+	err := addr.Validate()
+	if err != nil {
+		return nil, errors.WithMessage(err, "Invalid Address")
+	}
+
+	key := sigs.LaneKey(addr, lane)
+	resp, err := c.AbciQuery("/authlanes", key)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Models) == 0 { // empty list or nil
+		return nil, nil // lane never used
+	}
+	// assume only one result
+	model := resp.Models[0]
+
+	// make sure the return value is expected, key is prefixed with "sigslane:"
+	if got := model.Key[len(sigs.LaneBucketName)+1:]; !bytes.Equal(key, got) {
+		return nil, errors.Errorf("Mismatch. Queried %X, returned %X", key, got)
+	}
+	out := LaneResponse{
+		Address: addr,
+		Lane:    lane,
+		Height:  resp.Height,
+	}
+	err = out.LaneData.Unmarshal(model.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -5,8 +5,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/currency"
+	"github.com/iov-one/weave/x/session"
 	"github.com/tendermint/tendermint/rpc/client"
 	rpctest "github.com/tendermint/tendermint/rpc/test"
 )
@@ -127,6 +132,140 @@ func TestSendMoney(t *testing.T) {
 	assert.Equal(t, initBalance.Ticker, coin.Ticker)
 }
 
+func TestWalletHistory(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bcp := NewClient(conn)
+
+	rcpt := GenPrivateKey().PublicKey().Address()
+	src := faucet.PublicKey().Address()
+	nonce := NewNonce(bcp, src)
+	chainID := getChainID()
+
+	for i := 0; i < 2; i++ {
+		amount := coin.Coin{Whole: 10, Ticker: initBalance.Ticker}
+		tx := BuildSendTx(src, rcpt, amount, "history")
+		n, err := nonce.Next()
+		assert.Nil(t, err)
+		assert.Nil(t, SignTx(tx, faucet, chainID, n))
+		res := bcp.BroadcastTx(tx)
+		assert.Nil(t, res.IsError())
+	}
+
+	// Transactions are indexed asynchronously.
+	var history *WalletHistoryResponse
+	for i := 0; i < 50; i++ {
+		var err error
+		history, err = bcp.WalletHistory(rcpt, 1, 1)
+		assert.Nil(t, err)
+		if history.TotalCount == 2 {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	assert.Equal(t, 2, history.TotalCount)
+	assert.Equal(t, 1, len(history.Entries))
+	msg, err := history.Entries[0].Tx.GetMsg()
+	assert.Nil(t, err)
+	assert.Equal(t, rcpt, msg.(*cash.SendMsg).Destination)
+
+	history, err = bcp.WalletHistory(rcpt, 2, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(history.Entries))
+}
+
+func TestSendMoneyUsingLanes(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bcp := NewClient(conn)
+
+	rcpt := GenPrivateKey().PublicKey().Address()
+	src := faucet.PublicKey().Address()
+	chainID := getChainID()
+	amount := coin.Coin{Whole: 1, Ticker: initBalance.Ticker}
+
+	defaultNonce := NewNonce(bcp, src)
+	defaultSeq, err := defaultNonce.Query()
+	assert.Nil(t, err)
+
+	// Each lane is independent, so both transactions can use the same
+	// sequence value.
+	for _, lane := range []uint32{1, 2} {
+		nonce := NewLaneNonce(bcp, src, lane)
+		n, err := nonce.Query()
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), n)
+
+		tx := BuildSendTx(src, rcpt, amount, "Send using a lane")
+		assert.Nil(t, SignLaneTx(tx, faucet, chainID, lane, n))
+		res := bcp.BroadcastTx(tx)
+		assert.Nil(t, res.IsError())
+
+		n, err = nonce.Query()
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), n)
+
+		resp, err := bcp.GetLane(src, lane)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), resp.LaneData.Sequence)
+	}
+
+	// The default lane is not affected.
+	n, err := defaultNonce.Query()
+	assert.Nil(t, err)
+	assert.Equal(t, defaultSeq, n)
+
+	// Lane that was never used has no data.
+	resp, err := bcp.GetLane(src, 3)
+	assert.Nil(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestSendMoneyUsingSessionKey(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bcp := NewClient(conn)
+
+	src := faucet.PublicKey().Address()
+	rcpt := GenPrivateKey().PublicKey().Address()
+	sessionKey := GenPrivateKey()
+	chainID := getChainID()
+
+	register := &bnsd.Tx{
+		Sum: &bnsd.Tx_SessionRegisterKeyMsg{
+			SessionRegisterKeyMsg: &session.RegisterKeyMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Key:          sessionKey.PublicKey().Address(),
+				AllowedPaths: []string{"cash/send"},
+				SpendCap:     []*coin.Coin{coin.NewCoinp(3, 0, initBalance.Ticker)},
+			},
+		},
+	}
+	n, err := NewNonce(bcp, src).Next()
+	assert.Nil(t, err)
+	assert.Nil(t, SignTx(register, faucet, chainID, n))
+	assert.Nil(t, bcp.BroadcastTx(register).IsError())
+
+	// Session key signs on behalf of the faucet.
+	amount := coin.Coin{Whole: 2, Ticker: initBalance.Ticker}
+	tx := BuildSendTx(src, rcpt, amount, "Send using a session key")
+	n, err = NewNonce(bcp, sessionKey.PublicKey().Address()).Next()
+	assert.Nil(t, err)
+	assert.Nil(t, SignTx(tx, sessionKey, chainID, n))
+	assert.Nil(t, bcp.BroadcastTx(tx).IsError())
+
+	wallet, err := bcp.GetWallet(rcpt)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(wallet.Wallet.Coins))
+	assert.Equal(t, int64(2), wallet.Wallet.Coins[0].Whole)
+
+	// Spend cap does not allow to send more.
+	tx = BuildSendTx(src, rcpt, amount, "Send above the spend cap")
+	n, err = NewNonce(bcp, sessionKey.PublicKey().Address()).Next()
+	assert.Nil(t, err)
+	assert.Nil(t, SignTx(tx, sessionKey, chainID, n))
+	if err := bcp.BroadcastTx(tx).IsError(); err == nil {
+		t.Fatal("spend cap exceeded but transaction accepted")
+	}
+}
+
 func TestSubscribeHeaders(t *testing.T) {
 	conn := NewLocalConnection(node)
 	bcp := NewClient(conn)
@@ -226,3 +365,14 @@ func TestSendMultipleTx(t *testing.T) {
 	assert.Equal(t, true, resp.Response.Height > prepH+1)
 	assert.Equal(t, true, resp2.Response.Height > prepH+1)
 }
+
+func TestCurrenciesFormat(t *testing.T) {
+	resp := CurrenciesResponse{
+		Currencies: map[string]currency.TokenInfo{
+			"IOV": {Name: "Internet of Values", Decimals: 2},
+		},
+	}
+	assert.Equal(t, "1.50 IOV", resp.Format(coin.NewCoin(1, coin.FracUnit/2, "IOV")))
+	assert.Equal(t, "0.000000001 IOV", resp.Format(coin.NewCoin(0, 1, "IOV")))
+	assert.Equal(t, "1.5 ETH", resp.Format(coin.NewCoin(1, coin.FracUnit/2, "ETH")))
+}
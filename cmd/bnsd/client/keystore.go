@@ -0,0 +1,280 @@
+package client
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/iov-one/weave"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Scrypt parameters used to derive the encryption key from a passphrase. The
+// values are stored together with the encrypted key, so they can be changed
+// without breaking already existing keys.
+const (
+	ScryptN = 1 << 15
+	ScryptR = 8
+	ScryptP = 1
+)
+
+const (
+	keystoreVersion = 1
+	scryptSaltSize  = 32
+	scryptKeySize   = 32
+	secretboxNonce  = 24
+)
+
+// ErrInvalidPassphrase is returned when an encrypted key cannot be decrypted
+// using given passphrase.
+var ErrInvalidPassphrase = errors.New("invalid passphrase")
+
+// EncryptedKey is a private key protected with a passphrase. The encryption
+// key is derived from the passphrase using scrypt and the private key is
+// sealed using NaCl secretbox (XSalsa20 and Poly1305).
+//
+// The address is not encrypted so that keys can be listed without knowing
+// the passphrase.
+type EncryptedKey struct {
+	Version    int           `json:"version"`
+	Address    weave.Address `json:"address"`
+	KDF        string        `json:"kdf"`
+	KDFParams  ScryptParams  `json:"kdfparams"`
+	Cipher     string        `json:"cipher"`
+	Nonce      []byte        `json:"nonce"`
+	Ciphertext []byte        `json:"ciphertext"`
+}
+
+// ScryptParams holds the scrypt parameters used to derive the encryption key.
+type ScryptParams struct {
+	N    int    `json:"n"`
+	R    int    `json:"r"`
+	P    int    `json:"p"`
+	Salt []byte `json:"salt"`
+}
+
+// EncryptPrivateKey returns the private key encrypted with given passphrase.
+func EncryptPrivateKey(key *PrivateKey, passphrase []byte) (*EncryptedKey, error) {
+	raw, err := key.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal private key")
+	}
+	params := ScryptParams{
+		N:    ScryptN,
+		R:    ScryptR,
+		P:    ScryptP,
+		Salt: make([]byte, scryptSaltSize),
+	}
+	if _, err := io.ReadFull(rand.Reader, params.Salt); err != nil {
+		return nil, errors.Wrap(err, "cannot generate salt")
+	}
+	secret, err := params.key(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	var nonce [secretboxNonce]byte
+	if _, err := io.ReadFull(rand.Reader, nonce[:]); err != nil {
+		return nil, errors.Wrap(err, "cannot generate nonce")
+	}
+	return &EncryptedKey{
+		Version:    keystoreVersion,
+		Address:    key.PublicKey().Address(),
+		KDF:        "scrypt",
+		KDFParams:  params,
+		Cipher:     "secretbox",
+		Nonce:      nonce[:],
+		Ciphertext: secretbox.Seal(nil, raw, &nonce, secret),
+	}, nil
+}
+
+// Decrypt returns the private key decrypted with given passphrase.
+// ErrInvalidPassphrase is returned if the passphrase does not match.
+func (k *EncryptedKey) Decrypt(passphrase []byte) (*PrivateKey, error) {
+	if k.Version != keystoreVersion {
+		return nil, errors.Errorf("unsupported version %d", k.Version)
+	}
+	if k.KDF != "scrypt" {
+		return nil, errors.Errorf("unsupported key derivation function %q", k.KDF)
+	}
+	if k.Cipher != "secretbox" {
+		return nil, errors.Errorf("unsupported cipher %q", k.Cipher)
+	}
+	if len(k.Nonce) != secretboxNonce {
+		return nil, errors.New("invalid nonce")
+	}
+	secret, err := k.KDFParams.key(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	var nonce [secretboxNonce]byte
+	copy(nonce[:], k.Nonce)
+	raw, ok := secretbox.Open(nil, k.Ciphertext, &nonce, secret)
+	if !ok {
+		return nil, ErrInvalidPassphrase
+	}
+	var key PrivateKey
+	if err := key.Unmarshal(raw); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal private key")
+	}
+	return &key, nil
+}
+
+func (p *ScryptParams) key(passphrase []byte) (*[scryptKeySize]byte, error) {
+	raw, err := scrypt.Key(passphrase, p.Salt, p.N, p.R, p.P, scryptKeySize)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot derive encryption key")
+	}
+	var key [scryptKeySize]byte
+	copy(key[:], raw)
+	return &key, nil
+}
+
+// LoadEncryptedPrivateKey will load a private key from a file, which was
+// previously written by SaveEncryptedPrivateKey, and decrypt it with given
+// passphrase.
+func LoadEncryptedPrivateKey(filename string, passphrase []byte) (*PrivateKey, error) {
+	raw, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var enc EncryptedKey
+	if err := json.Unmarshal(raw, &enc); err != nil {
+		return nil, err
+	}
+	return enc.Decrypt(passphrase)
+}
+
+// SaveEncryptedPrivateKey will encrypt the private key with given passphrase
+// and write it to the named file
+//
+// Refuses to overwrite a file unless force is true
+func SaveEncryptedPrivateKey(key *PrivateKey, filename string, passphrase []byte, force bool) error {
+	if err := canWrite(filename, force); err != nil {
+		return err
+	}
+	enc, err := EncryptPrivateKey(key, passphrase)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(enc, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, data, KeyPerm)
+}
+
+// Keystore is a directory of named, passphrase protected private keys. Each
+// key is stored in a separate file.
+type Keystore struct {
+	dir string
+}
+
+// NewKeystore returns a keystore that keeps keys in given directory. The
+// directory is created when the first key is saved.
+func NewKeystore(dir string) *Keystore {
+	return &Keystore{dir: dir}
+}
+
+const keystoreExt = ".json"
+
+var validKeyName = regexp.MustCompile(`^[a-zA-Z0-9_-][a-zA-Z0-9_.-]{0,63}$`).MatchString
+
+// ValidKeyName returns true if given name can be used to store a key.
+func ValidKeyName(name string) bool {
+	return validKeyName(name)
+}
+
+func (ks *Keystore) path(name string) (string, error) {
+	if !validKeyName(name) {
+		return "", errors.Errorf("invalid key name %q", name)
+	}
+	return filepath.Join(ks.dir, name+keystoreExt), nil
+}
+
+// Has returns true if a key with given name exists.
+func (ks *Keystore) Has(name string) bool {
+	path, err := ks.path(name)
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// Save encrypts the key with given passphrase and stores it under given
+// name.
+//
+// Refuses to overwrite a key unless force is true
+func (ks *Keystore) Save(name string, key *PrivateKey, passphrase []byte, force bool) error {
+	path, err := ks.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(ks.dir, 0700); err != nil {
+		return errors.Wrap(err, "cannot create keystore directory")
+	}
+	return SaveEncryptedPrivateKey(key, path, passphrase, force)
+}
+
+// Load returns the key stored under given name, decrypted with given
+// passphrase.
+func (ks *Keystore) Load(name string, passphrase []byte) (*PrivateKey, error) {
+	path, err := ks.path(name)
+	if err != nil {
+		return nil, err
+	}
+	return LoadEncryptedPrivateKey(path, passphrase)
+}
+
+// Delete removes the key stored under given name.
+func (ks *Keystore) Delete(name string) error {
+	path, err := ks.path(name)
+	if err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// KeyInfo describes a key stored in a keystore.
+type KeyInfo struct {
+	Name    string
+	Address weave.Address
+}
+
+// List returns all keys stored in the keystore, ordered by name. Listing does
+// not require a passphrase.
+func (ks *Keystore) List() ([]KeyInfo, error) {
+	files, err := ioutil.ReadDir(ks.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var infos []KeyInfo
+	for _, f := range files {
+		name := strings.TrimSuffix(f.Name(), keystoreExt)
+		if f.IsDir() || name == f.Name() || !validKeyName(name) {
+			continue
+		}
+		raw, err := ioutil.ReadFile(filepath.Join(ks.dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var enc EncryptedKey
+		if err := json.Unmarshal(raw, &enc); err != nil {
+			return nil, errors.Wrapf(err, "cannot decode %q key", name)
+		}
+		infos = append(infos, KeyInfo{Name: name, Address: enc.Address})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos, nil
+}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	private := GenPrivateKey()
+	passphrase := []byte("correct horse battery staple")
+
+	enc, err := EncryptPrivateKey(private, passphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, private.PublicKey().Address(), enc.Address)
+
+	dec, err := enc.Decrypt(passphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, private, dec)
+
+	// wrong passphrase should return error
+	_, err = enc.Decrypt([]byte("wrong passphrase"))
+	assert.Equal(t, ErrInvalidPassphrase, err)
+
+	// the same key is never encrypted the same way twice
+	enc2, err := EncryptPrivateKey(private, passphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, false, string(enc.Ciphertext) == string(enc2.Ciphertext))
+
+	// corrupted ciphertext should return error
+	enc2.Ciphertext[0] ^= 0xff
+	_, err = enc2.Decrypt(passphrase)
+	assert.Equal(t, ErrInvalidPassphrase, err)
+}
+
+func TestSaveLoadEncrypted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tools-util-encrypted")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "foo.json")
+	passphrase := []byte("secret")
+
+	private := GenPrivateKey()
+	private2 := GenPrivateKey()
+
+	err = SaveEncryptedPrivateKey(private, filename, passphrase, false)
+	assert.Nil(t, err)
+	loaded, err := LoadEncryptedPrivateKey(filename, passphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, private, loaded)
+
+	// the private key is not stored in plain text
+	raw, err := ioutil.ReadFile(filename)
+	assert.Nil(t, err)
+	hexKey, err := EncodePrivateKey(private)
+	assert.Nil(t, err)
+	assert.Equal(t, false, string(raw) == hexKey)
+
+	// try to over-write, but fails
+	err = SaveEncryptedPrivateKey(private2, filename, passphrase, false)
+	assert.Equal(t, true, err != nil)
+
+	// force over-write works
+	err = SaveEncryptedPrivateKey(private2, filename, passphrase, true)
+	assert.Nil(t, err)
+	loaded, err = LoadEncryptedPrivateKey(filename, passphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, private2, loaded)
+}
+
+func TestKeystore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tools-util-keystore")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	ks := NewKeystore(filepath.Join(dir, "keys"))
+	passphrase := []byte("secret")
+
+	// empty keystore does not require the directory to exist
+	infos, err := ks.List()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(infos))
+
+	alice := GenPrivateKey()
+	bob := GenPrivateKey()
+
+	assert.Nil(t, ks.Save("bob", bob, passphrase, false))
+	assert.Nil(t, ks.Save("alice", alice, passphrase, false))
+	assert.Equal(t, true, ks.Has("alice"))
+	assert.Equal(t, false, ks.Has("charlie"))
+
+	// names are unique
+	err = ks.Save("alice", bob, passphrase, false)
+	assert.Equal(t, true, err != nil)
+
+	// names must not escape the keystore directory
+	err = ks.Save("../alice", alice, passphrase, false)
+	assert.Equal(t, true, err != nil)
+	assert.Equal(t, false, ks.Has("../keys/alice"))
+
+	loaded, err := ks.Load("alice", passphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, alice, loaded)
+	_, err = ks.Load("alice", []byte("wrong"))
+	assert.Equal(t, ErrInvalidPassphrase, err)
+
+	infos, err = ks.List()
+	assert.Nil(t, err)
+	assert.Equal(t, []KeyInfo{
+		{Name: "alice", Address: alice.PublicKey().Address()},
+		{Name: "bob", Address: bob.PublicKey().Address()},
+	}, infos)
+
+	assert.Nil(t, ks.Delete("alice"))
+	assert.Equal(t, false, ks.Has("alice"))
+	assert.Equal(t, true, ks.Delete("alice") != nil)
+
+	infos, err = ks.List()
+	assert.Nil(t, err)
+	assert.Equal(t, []KeyInfo{
+		{Name: "bob", Address: bob.PublicKey().Address()},
+	}, infos)
+}
@@ -44,6 +44,8 @@ func TestMain(m *testing.M) {
 	// TODO: check out config file...
 	config := rpctest.GetConfig()
 	config.Moniker = "SetInTestMain"
+	// Wallet history is available only when indexed.
+	config.TxIndex.IndexTags = cash.WalletHistoryTag
 
 	// set up our application
 	admin := faucet.PublicKey().Address()
@@ -103,10 +105,13 @@ func initGenesis(filename string, addr weave.Address) error {
 			{"ver": 1, "pkg": "currency"},
 			{"ver": 1, "pkg": "distribution"},
 			{"ver": 1, "pkg": "escrow"},
+			{"ver": 1, "pkg": "freeze"},
 			{"ver": 1, "pkg": "gov"},
 			{"ver": 1, "pkg": "msgfee"},
 			{"ver": 1, "pkg": "multisig"},
 			{"ver": 1, "pkg": "paychan"},
+			{"ver": 1, "pkg": "recovery"},
+			{"ver": 1, "pkg": "session"},
 			{"ver": 1, "pkg": "sigs"},
 			{"ver": 1, "pkg": "utils"},
 			{"ver": 1, "pkg": "validators"},
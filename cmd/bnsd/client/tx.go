@@ -41,6 +41,17 @@ func SignTx(tx *bnsd.Tx, signer *PrivateKey, chainID string, nonce int64) error
 	return nil
 }
 
+// SignLaneTx modifies the tx in-place, adding a signature that is using
+// given nonce lane
+func SignLaneTx(tx *bnsd.Tx, signer *PrivateKey, chainID string, lane uint32, nonce int64) error {
+	sig, err := sigs.SignLaneTx(signer, tx, chainID, lane, nonce)
+	if err != nil {
+		return err
+	}
+	tx.Signatures = append(tx.Signatures, sig)
+	return nil
+}
+
 // ParseBcpTx will load a serialize tx into a format we can read
 func ParseBcpTx(data []byte) (*bnsd.Tx, error) {
 	var tx bnsd.Tx
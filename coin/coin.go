@@ -373,6 +373,54 @@ func (c Coin) String() string {
 	return b.String()
 }
 
+// FormatDecimals returns a human readable representation of the coin, with the
+// fractional part displayed using at least given number of digits. Digits
+// beyond the requested precision are displayed only if they are not zero, so
+// that the value is never rounded. The result can be parsed back using the
+// human readable format parser.
+func (c Coin) FormatDecimals(decimals int) string {
+	if decimals < 0 {
+		decimals = 0
+	}
+	if decimals > 9 {
+		decimals = 9
+	}
+
+	var b bytes.Buffer
+
+	if n, err := c.normalize(); err == nil {
+		c = n
+	}
+
+	whole, frac := c.Whole, c.Fractional
+	if whole < 0 || frac < 0 {
+		io.WriteString(&b, "-")
+	}
+	if whole < 0 {
+		whole = -whole
+	}
+	if frac < 0 {
+		frac = -frac
+	}
+	io.WriteString(&b, strconv.FormatInt(whole, 10))
+
+	s := strconv.FormatInt(frac, 10)
+	s = strings.Repeat("0", 9-len(s)) + s
+	// Remove trailing zeros that are beyond the requested precision.
+	for len(s) > decimals && s[len(s)-1] == '0' {
+		s = s[:len(s)-1]
+	}
+	if s != "" {
+		io.WriteString(&b, "."+s)
+	}
+
+	if c.Ticker != "" {
+		io.WriteString(&b, " "+c.Ticker)
+	}
+
+	return b.String()
+}
+
 // ParseHumanFormat parse a human readable coin representation. Accepted format
 // is a string:
 //   "<whole>[.<fractional>] <ticker>"
@@ -706,3 +706,55 @@ func TestCoinString(t *testing.T) {
 		})
 	}
 }
+
+func TestCoinFormatDecimals(t *testing.T) {
+	cases := map[string]struct {
+		c        Coin
+		decimals int
+		want     string
+	}{
+		"zero decimals": {
+			c:        NewCoin(3, 0, "IOV"),
+			decimals: 0,
+			want:     "3 IOV",
+		},
+		"padded to two decimals": {
+			c:        NewCoin(3, 0, "IOV"),
+			decimals: 2,
+			want:     "3.00 IOV",
+		},
+		"padded fractional": {
+			c:        NewCoin(3, FracUnit/10, "IOV"),
+			decimals: 6,
+			want:     "3.100000 IOV",
+		},
+		"precision is not reduced": {
+			c:        NewCoin(0, 123456, "IOV"),
+			decimals: 2,
+			want:     "0.000123456 IOV",
+		},
+		"negative fractional": {
+			c:        NewCoin(0, FracUnit/2, "IOV").Negative(),
+			decimals: 2,
+			want:     "-0.50 IOV",
+		},
+		"all decimals": {
+			c:        NewCoin(MaxInt, MaxFrac, "IOV"),
+			decimals: 9,
+			want:     "999999999999999.999999999 IOV",
+		},
+		"too many decimals": {
+			c:        NewCoin(1, 0, ""),
+			decimals: 12,
+			want:     "1.000000000",
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if got := tc.c.FormatDecimals(tc.decimals); got != tc.want {
+				t.Fatalf("unexpected string representation: %q", got)
+			}
+		})
+	}
+}
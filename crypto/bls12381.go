@@ -0,0 +1,178 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/iov-one/weave"
+	bls "github.com/kilic/bls12-381"
+)
+
+// BLS12-381 keys and signatures use the minimal public key size variant:
+//   - private key is a 32 byte big endian scalar,
+//   - public key is a 48 byte compressed G1 point,
+//   - signature is a 96 byte compressed G2 point.
+//
+// The message is hashed to G2 using the proof of possession cipher suite.
+//
+// BLS signatures of the same message can be aggregated into a single
+// signature that is verified against the aggregated public key of all
+// signers. Aggregation is safe only if every public key holder proved the
+// possession of the private key, otherwise a rogue key attack is possible.
+var bls12381Domain = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+const bls12381PrivKeySize = 32
+
+var _ PubKey = (*PublicKey_Bls12381)(nil)
+
+// Verify verifies the signature was created with this message and public key
+func (p *PublicKey_Bls12381) Verify(message []byte, sig *Signature) bool {
+	blssig, ok := sig.GetSig().(*Signature_Bls12381)
+	if !ok {
+		return false
+	}
+	engine := bls.NewEngine()
+	publicKey, err := engine.G1.FromCompressed(p.Bls12381)
+	if err != nil || engine.G1.IsZero(publicKey) {
+		return false
+	}
+	signature, err := engine.G2.FromCompressed(blssig.Bls12381)
+	if err != nil || engine.G2.IsZero(signature) {
+		return false
+	}
+	hash, err := engine.G2.HashToCurve(message, bls12381Domain)
+	if err != nil {
+		return false
+	}
+	// e(G1, signature) == e(publicKey, hash)
+	engine.AddPairInv(engine.G1.One(), signature)
+	engine.AddPair(publicKey, hash)
+	return engine.Check()
+}
+
+// Condition encodes the public key into a weave permission
+func (p *PublicKey_Bls12381) Condition() weave.Condition {
+	return weave.NewCondition(ExtensionName, "bls12381", p.Bls12381)
+}
+
+var _ Signer = (*PrivateKey_Bls12381)(nil)
+
+// Sign returns a matching signature for this private key
+func (p *PrivateKey_Bls12381) Sign(message []byte) (*Signature, error) {
+	g2 := bls.NewG2()
+	hash, err := g2.HashToCurve(message, bls12381Domain)
+	if err != nil {
+		return nil, err
+	}
+	g2.MulScalarBig(hash, hash, new(big.Int).SetBytes(p.Bls12381))
+	sig := &Signature{
+		Sig: &Signature_Bls12381{
+			Bls12381: g2.ToCompressed(hash),
+		},
+	}
+	return sig, nil
+}
+
+// PublicKey returns the corresponding PublicKey
+func (p *PrivateKey_Bls12381) PublicKey() *PublicKey {
+	g1 := bls.NewG1()
+	pub := g1.New()
+	g1.MulScalarBig(pub, g1.One(), new(big.Int).SetBytes(p.Bls12381))
+	return &PublicKey{
+		Pub: &PublicKey_Bls12381{
+			Bls12381: g1.ToCompressed(pub),
+		},
+	}
+}
+
+// GenPrivKeyBls12381 returns a random new private key
+func GenPrivKeyBls12381() *PrivateKey {
+	max := new(big.Int).Sub(bls.NewG1().Q(), big.NewInt(1))
+	d, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		panic(err)
+	}
+	d.Add(d, big.NewInt(1))
+	return bls12381PrivKey(d)
+}
+
+// PrivKeyBls12381FromSeed will deterministically generate a private key
+// from a given seed. The seed is hashed and the result is used as the
+// private key scalar. Use if you have a strong source of external
+// randomness, or for deterministic keys in test cases.
+func PrivKeyBls12381FromSeed(seed []byte) *PrivateKey {
+	hash := sha256.Sum256(seed)
+	d := new(big.Int).SetBytes(hash[:])
+	// Reduce the scalar to the range [1, Q-1].
+	d.Mod(d, new(big.Int).Sub(bls.NewG1().Q(), big.NewInt(1)))
+	d.Add(d, big.NewInt(1))
+	return bls12381PrivKey(d)
+}
+
+func bls12381PrivKey(d *big.Int) *PrivateKey {
+	b := d.Bytes()
+	key := make([]byte, bls12381PrivKeySize)
+	copy(key[bls12381PrivKeySize-len(b):], b)
+	return &PrivateKey{
+		Priv: &PrivateKey_Bls12381{
+			Bls12381: key,
+		},
+	}
+}
+
+// AggregateBls12381Signatures combines BLS12-381 signatures of the same
+// message into a single signature. Resulting signature can be verified using
+// the aggregated public key of all signers.
+func AggregateBls12381Signatures(sigs []*Signature) (*Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures")
+	}
+	g2 := bls.NewG2()
+	agg := g2.Zero()
+	for _, s := range sigs {
+		raw, ok := s.GetSig().(*Signature_Bls12381)
+		if !ok {
+			return nil, errors.New("not a bls12381 signature")
+		}
+		p, err := g2.FromCompressed(raw.Bls12381)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(agg, agg, p)
+	}
+	sig := &Signature{
+		Sig: &Signature_Bls12381{
+			Bls12381: g2.ToCompressed(agg),
+		},
+	}
+	return sig, nil
+}
+
+// AggregateBls12381PublicKeys combines BLS12-381 public keys into a single
+// public key that can be used to verify an aggregated signature.
+func AggregateBls12381PublicKeys(keys []*PublicKey) (*PublicKey, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no public keys")
+	}
+	g1 := bls.NewG1()
+	agg := g1.Zero()
+	for _, k := range keys {
+		raw, ok := k.GetPub().(*PublicKey_Bls12381)
+		if !ok {
+			return nil, errors.New("not a bls12381 public key")
+		}
+		p, err := g1.FromCompressed(raw.Bls12381)
+		if err != nil {
+			return nil, err
+		}
+		g1.Add(agg, agg, p)
+	}
+	pub := &PublicKey{
+		Pub: &PublicKey_Bls12381{
+			Bls12381: g1.ToCompressed(agg),
+		},
+	}
+	return pub, nil
+}
@@ -0,0 +1,139 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestBls12381Signing(t *testing.T) {
+	private := GenPrivKeyBls12381()
+	public := private.PublicKey()
+
+	msg := []byte("foobar")
+	msg2 := []byte("dingbooms")
+
+	sig, err := private.Sign(msg)
+	assert.Nil(t, err)
+	sig2, err := private.Sign(msg2)
+	assert.Nil(t, err)
+
+	bz, err := sig.Marshal()
+	assert.Nil(t, err)
+	bz2, err := sig2.Marshal()
+	assert.Nil(t, err)
+
+	if bytes.Equal(bz, bz2) {
+		t.Fatal("marshaling different signatures produce the same binary representation")
+	}
+
+	if !public.Verify(msg, sig) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+	if !public.Verify(msg2, sig2) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+
+	if public.Verify(msg, sig2) {
+		t.Fatal("verified message signature of the wrong message")
+	}
+	if public.Verify(msg2, sig) {
+		t.Fatal("verified message signature of the wrong message")
+	}
+
+	if public.Verify(msg, &Signature{}) {
+		t.Fatal("verified an empty signature of a message")
+	}
+	if public.Verify(msg, nil) {
+		t.Fatal("verified a nil signature of a message")
+	}
+}
+
+func TestBls12381Deterministic(t *testing.T) {
+	seed := []byte("a very deterministic seed")
+	priv := PrivKeyBls12381FromSeed(seed)
+	priv2 := PrivKeyBls12381FromSeed(seed)
+	assert.Equal(t, priv.GetBls12381(), priv2.GetBls12381())
+	assert.Equal(t, 32, len(priv.GetBls12381()))
+	assert.Equal(t, 48, len(priv.PublicKey().GetBls12381()))
+
+	sig, err := priv.Sign([]byte("foobar"))
+	assert.Nil(t, err)
+	sig2, err := priv2.Sign([]byte("foobar"))
+	assert.Nil(t, err)
+	assert.Equal(t, sig.GetBls12381(), sig2.GetBls12381())
+	assert.Equal(t, 96, len(sig.GetBls12381()))
+}
+
+func TestBls12381Aggregation(t *testing.T) {
+	msg := []byte("foobar")
+
+	var (
+		pubs []*PublicKey
+		sigs []*Signature
+	)
+	for i := 0; i < 4; i++ {
+		priv := GenPrivKeyBls12381()
+		sig, err := priv.Sign(msg)
+		assert.Nil(t, err)
+		pubs = append(pubs, priv.PublicKey())
+		sigs = append(sigs, sig)
+	}
+
+	aggSig, err := AggregateBls12381Signatures(sigs)
+	assert.Nil(t, err)
+	aggPub, err := AggregateBls12381PublicKeys(pubs)
+	assert.Nil(t, err)
+	if !aggPub.Verify(msg, aggSig) {
+		t.Fatal("cannot verify aggregated signature")
+	}
+	if aggPub.Verify([]byte("other message"), aggSig) {
+		t.Fatal("verified aggregated signature of the wrong message")
+	}
+
+	// Missing a single signature must invalidate the aggregate.
+	partialSig, err := AggregateBls12381Signatures(sigs[1:])
+	assert.Nil(t, err)
+	if aggPub.Verify(msg, partialSig) {
+		t.Fatal("verified partial aggregated signature")
+	}
+	partialPub, err := AggregateBls12381PublicKeys(pubs[1:])
+	assert.Nil(t, err)
+	if !partialPub.Verify(msg, partialSig) {
+		t.Fatal("cannot verify partial aggregated signature")
+	}
+
+	if _, err := AggregateBls12381Signatures(nil); err == nil {
+		t.Fatal("aggregated no signatures")
+	}
+	if _, err := AggregateBls12381PublicKeys(nil); err == nil {
+		t.Fatal("aggregated no public keys")
+	}
+	edsig, err := GenPrivKeyEd25519().Sign(msg)
+	assert.Nil(t, err)
+	if _, err := AggregateBls12381Signatures([]*Signature{sigs[0], edsig}); err == nil {
+		t.Fatal("aggregated a signature of a different type")
+	}
+	if _, err := AggregateBls12381PublicKeys([]*PublicKey{pubs[0], GenPrivKeyEd25519().PublicKey()}); err == nil {
+		t.Fatal("aggregated a public key of a different type")
+	}
+}
+
+func TestBls12381Address(t *testing.T) {
+	pub := GenPrivKeyBls12381().PublicKey()
+	pub2 := GenPrivKeyBls12381().PublicKey()
+
+	assert.Nil(t, pub.Condition().Validate())
+	assert.Nil(t, pub2.Condition().Validate())
+	if bytes.Equal(pub.Condition(), pub2.Condition()) {
+		t.Fatal("different public keys produce the same condition")
+	}
+
+	bz, err := pub.Marshal()
+	assert.Nil(t, err)
+	var read PublicKey
+	err = read.Unmarshal(bz)
+	assert.Nil(t, err)
+	assert.Equal(t, read.Condition(), pub.Condition())
+}
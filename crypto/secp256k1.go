@@ -0,0 +1,125 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/iov-one/weave"
+)
+
+// secp256k1 keys and signatures use the following encoding:
+//   - private key is a 32 byte big endian scalar,
+//   - public key is a 33 byte compressed point,
+//   - signature is a 64 byte concatenation of R and S, both 32 byte big
+//     endian. S must be in the lower half of the curve order.
+//
+// The signed message is always the sha256 hash of the message.
+const (
+	secp256k1PrivKeySize = 32
+	secp256k1SigSize     = 64
+)
+
+var _ PubKey = (*PublicKey_Secp256K1)(nil)
+
+// Verify verifies the signature was created with this message and public key
+func (p *PublicKey_Secp256K1) Verify(message []byte, sig *Signature) bool {
+	secpsig, ok := sig.GetSig().(*Signature_Secp256K1)
+	if !ok || len(secpsig.Secp256K1) != secp256k1SigSize {
+		return false
+	}
+	publicKey, err := btcec.ParsePubKey(p.Secp256K1, btcec.S256())
+	if err != nil {
+		return false
+	}
+	signature := &btcec.Signature{
+		R: new(big.Int).SetBytes(secpsig.Secp256K1[:32]),
+		S: new(big.Int).SetBytes(secpsig.Secp256K1[32:]),
+	}
+	// Reject malleable signatures. For every valid signature (R, S) there
+	// is another valid signature (R, N-S).
+	halfOrder := new(big.Int).Rsh(btcec.S256().N, 1)
+	if signature.S.Cmp(halfOrder) > 0 {
+		return false
+	}
+	hash := sha256.Sum256(message)
+	return signature.Verify(hash[:], publicKey)
+}
+
+// Condition encodes the public key into a weave permission.
+// Condition type is limited to 8 characters, so "secp256k" is used.
+func (p *PublicKey_Secp256K1) Condition() weave.Condition {
+	return weave.NewCondition(ExtensionName, "secp256k", p.Secp256K1)
+}
+
+var _ Signer = (*PrivateKey_Secp256K1)(nil)
+
+// Sign returns a matching signature for this private key
+func (p *PrivateKey_Secp256K1) Sign(message []byte) (*Signature, error) {
+	privateKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), p.Secp256K1)
+	hash := sha256.Sum256(message)
+	// Signature is deterministic (RFC6979) and always returns S in the
+	// lower half of the curve order.
+	signature, err := privateKey.Sign(hash[:])
+	if err != nil {
+		return nil, err
+	}
+	bz := make([]byte, secp256k1SigSize)
+	r, s := signature.R.Bytes(), signature.S.Bytes()
+	copy(bz[32-len(r):32], r)
+	copy(bz[64-len(s):], s)
+	sig := &Signature{
+		Sig: &Signature_Secp256K1{
+			Secp256K1: bz,
+		},
+	}
+	return sig, nil
+}
+
+// PublicKey returns the corresponding PublicKey
+func (p *PrivateKey_Secp256K1) PublicKey() *PublicKey {
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), p.Secp256K1)
+	return &PublicKey{
+		Pub: &PublicKey_Secp256K1{
+			Secp256K1: pub.SerializeCompressed(),
+		},
+	}
+}
+
+// GenPrivKeySecp256k1 returns a random new private key
+func GenPrivKeySecp256k1() *PrivateKey {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		panic(err)
+	}
+	return &PrivateKey{
+		Priv: &PrivateKey_Secp256K1{
+			Secp256K1: paddedKey(priv.D.Bytes()),
+		},
+	}
+}
+
+// PrivKeySecp256k1FromSeed will deterministically generate a private key
+// from a given seed. The seed is hashed and the result is used as the
+// private key scalar. Use if you have a strong source of external
+// randomness, or for deterministic keys in test cases.
+func PrivKeySecp256k1FromSeed(seed []byte) *PrivateKey {
+	hash := sha256.Sum256(seed)
+	d := new(big.Int).SetBytes(hash[:])
+	// Reduce the scalar to the range [1, N-1].
+	n1 := new(big.Int).Sub(btcec.S256().N, big.NewInt(1))
+	d.Mod(d, n1)
+	d.Add(d, big.NewInt(1))
+	return &PrivateKey{
+		Priv: &PrivateKey_Secp256K1{
+			Secp256K1: paddedKey(d.Bytes()),
+		},
+	}
+}
+
+// paddedKey returns the big endian scalar left padded to the private key size.
+func paddedKey(b []byte) []byte {
+	key := make([]byte, secp256k1PrivKeySize)
+	copy(key[secp256k1PrivKeySize-len(b):], b)
+	return key
+}
@@ -0,0 +1,113 @@
+package crypto
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestSecp256k1Signing(t *testing.T) {
+	private := GenPrivKeySecp256k1()
+	public := private.PublicKey()
+
+	msg := []byte("foobar")
+	msg2 := []byte("dingbooms")
+
+	sig, err := private.Sign(msg)
+	assert.Nil(t, err)
+	sig2, err := private.Sign(msg2)
+	assert.Nil(t, err)
+
+	bz, err := sig.Marshal()
+	assert.Nil(t, err)
+	bz2, err := sig2.Marshal()
+	assert.Nil(t, err)
+
+	if bytes.Equal(bz, bz2) {
+		t.Fatal("marshaling different signatures produce the same binary representation")
+	}
+
+	if !public.Verify(msg, sig) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+	if !public.Verify(msg2, sig2) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+
+	if public.Verify(msg, sig2) {
+		t.Fatal("verified message signature of the wrong message")
+	}
+	if public.Verify(msg2, sig) {
+		t.Fatal("verified message signature of the wrong message")
+	}
+
+	if public.Verify(msg, &Signature{}) {
+		t.Fatal("verified an empty signature of a message")
+	}
+	if public.Verify(msg, nil) {
+		t.Fatal("verified a nil signature of a message")
+	}
+
+	// Signature of a different type must be rejected.
+	edsig, err := GenPrivKeyEd25519().Sign(msg)
+	assert.Nil(t, err)
+	if public.Verify(msg, edsig) {
+		t.Fatal("verified an ed25519 signature with a secp256k1 key")
+	}
+
+	// A malleated signature with a high S value must be rejected.
+	raw := sig.GetSecp256K1()
+	n := btcec.S256().N
+	highS := new(big.Int).Sub(n, new(big.Int).SetBytes(raw[32:])).Bytes()
+	malleated := make([]byte, 64)
+	copy(malleated, raw[:32])
+	copy(malleated[64-len(highS):], highS)
+	if public.Verify(msg, &Signature{Sig: &Signature_Secp256K1{Secp256K1: malleated}}) {
+		t.Fatal("verified a high S signature")
+	}
+}
+
+func TestSecp256k1Deterministic(t *testing.T) {
+	seed := []byte("a very deterministic seed")
+	priv := PrivKeySecp256k1FromSeed(seed)
+	priv2 := PrivKeySecp256k1FromSeed(seed)
+	assert.Equal(t, priv.GetSecp256K1(), priv2.GetSecp256K1())
+	assert.Equal(t, 32, len(priv.GetSecp256K1()))
+	assert.Equal(t, 33, len(priv.PublicKey().GetSecp256K1()))
+
+	msg := []byte("foobar")
+	sig, err := priv.Sign(msg)
+	assert.Nil(t, err)
+	sig2, err := priv2.Sign(msg)
+	assert.Nil(t, err)
+	assert.Equal(t, sig.GetSecp256K1(), sig2.GetSecp256K1())
+	assert.Equal(t, 64, len(sig.GetSecp256K1()))
+}
+
+func TestSecp256k1Address(t *testing.T) {
+	pub := GenPrivKeySecp256k1().PublicKey()
+	pub2 := GenPrivKeySecp256k1().PublicKey()
+
+	assert.Nil(t, pub.Condition().Validate())
+	assert.Nil(t, pub2.Condition().Validate())
+	if bytes.Equal(pub.Condition(), pub2.Condition()) {
+		t.Fatal("different public keys produce the same condition")
+	}
+
+	// The same key material must not produce the same condition as an
+	// ed25519 key.
+	ed := &PublicKey{Pub: &PublicKey_Ed25519{Ed25519: pub.GetSecp256K1()}}
+	if bytes.Equal(pub.Condition(), ed.Condition()) {
+		t.Fatal("secp256k1 and ed25519 keys produce the same condition")
+	}
+
+	bz, err := pub.Marshal()
+	assert.Nil(t, err)
+	var read PublicKey
+	err = read.Unmarshal(bz)
+	assert.Nil(t, err)
+	assert.Equal(t, read.Condition(), pub.Condition())
+}
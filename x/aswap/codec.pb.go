@@ -25,11 +25,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// HashAlgorithm is the function used to compute the preimage hash. Using the
+// same algorithm as the counterparty allows to swap with other blockchains.
+type HashAlgorithm int32
+
+const (
+	// sha256 of the preimage, 32 bytes long. This is the default.
+	HashAlgorithmSha256 HashAlgorithm = 0
+	// ripemd160 of the sha256 of the preimage, 20 bytes long. This is
+	// HASH160 as used by Bitcoin scripts.
+	HashAlgorithmHash160 HashAlgorithm = 1
+	// keccak256 of the preimage, 32 bytes long. This is the hash function
+	// used by Ethereum.
+	HashAlgorithmKeccak256 HashAlgorithm = 2
+)
+
+var HashAlgorithm_name = map[int32]string{
+	0: "HASH_ALGORITHM_SHA256",
+	1: "HASH_ALGORITHM_HASH160",
+	2: "HASH_ALGORITHM_KECCAK256",
+}
+
+var HashAlgorithm_value = map[string]int32{
+	"HASH_ALGORITHM_SHA256":    0,
+	"HASH_ALGORITHM_HASH160":   1,
+	"HASH_ALGORITHM_KECCAK256": 2,
+}
+
+func (x HashAlgorithm) String() string {
+	return proto.EnumName(HashAlgorithm_name, int32(x))
+}
+
+func (HashAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ad79b700d8686a3f, []int{0}
+}
+
 // Swap is designed to hold some coins for atomic swap, locked by preimage_hash
 type Swap struct {
 	// metadata is used for schema versioning support
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// sha256 hash of preimage, 32 bytes long
+	// hash of preimage, computed using the hash algorithm
 	PreimageHash []byte `protobuf:"bytes,2,opt,name=preimage_hash,json=preimageHash,proto3" json:"preimage_hash,omitempty"`
 	// source is a sender address
 	Source github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=source,proto3,casttype=github.com/iov-one/weave.Address" json:"source,omitempty"`
@@ -47,6 +82,8 @@ type Swap struct {
 	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,8,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Hash algorithm used to compute the preimage hash.
+	HashAlgorithm HashAlgorithm `protobuf:"varint,9,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=aswap.HashAlgorithm" json:"hash_algorithm,omitempty"`
 }
 
 func (m *Swap) Reset()         { *m = Swap{} }
@@ -131,11 +168,18 @@ func (m *Swap) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Swap) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return HashAlgorithmSha256
+}
+
 // CreateMsg creates a Swap with some coins.
 type CreateMsg struct {
 	Metadata *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Source   github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=source,proto3,casttype=github.com/iov-one/weave.Address" json:"source,omitempty"`
-	// sha256 hash of preimage, 32 bytes long
+	// hash of preimage, computed using the hash algorithm
 	PreimageHash []byte                           `protobuf:"bytes,3,opt,name=preimage_hash,json=preimageHash,proto3" json:"preimage_hash,omitempty"`
 	Destination  github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=destination,proto3,casttype=github.com/iov-one/weave.Address" json:"destination,omitempty"`
 	// amount may contain multiple token types
@@ -144,6 +188,8 @@ type CreateMsg struct {
 	Timeout github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=timeout,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"timeout,omitempty"`
 	// max length 128 character
 	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Hash algorithm used to compute the preimage hash. Defaults to sha256.
+	HashAlgorithm HashAlgorithm `protobuf:"varint,8,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=aswap.HashAlgorithm" json:"hash_algorithm,omitempty"`
 }
 
 func (m *CreateMsg) Reset()         { *m = CreateMsg{} }
@@ -228,6 +274,13 @@ func (m *CreateMsg) GetMemo() string {
 	return ""
 }
 
+func (m *CreateMsg) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return HashAlgorithmSha256
+}
+
 // ReleaseMsg releases the tokens to the destination.
 // This operation is authorized by preimage, which is sent raw and then hashed on the backend.
 type ReleaseMsg struct {
@@ -349,6 +402,7 @@ func (m *ReturnMsg) GetSwapID() []byte {
 }
 
 func init() {
+	proto.RegisterEnum("aswap.HashAlgorithm", HashAlgorithm_name, HashAlgorithm_value)
 	proto.RegisterType((*Swap)(nil), "aswap.Swap")
 	proto.RegisterType((*CreateMsg)(nil), "aswap.CreateMsg")
 	proto.RegisterType((*ReleaseMsg)(nil), "aswap.ReleaseMsg")
@@ -358,35 +412,44 @@ func init() {
 func init() { proto.RegisterFile("x/aswap/codec.proto", fileDescriptor_ad79b700d8686a3f) }
 
 var fileDescriptor_ad79b700d8686a3f = []byte{
-	// 435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xb3, 0xf9, 0x70, 0x92, 0x49, 0x11, 0x68, 0xe1, 0xb0, 0xca, 0xc1, 0x31, 0x2e, 0x48,
-	0x96, 0x10, 0xb6, 0x54, 0xae, 0x08, 0x44, 0x8a, 0x10, 0x3d, 0xf4, 0xb2, 0xc0, 0x11, 0x55, 0x5b,
-	0x7b, 0xe4, 0xac, 0xc4, 0xee, 0x46, 0xde, 0x75, 0x53, 0xf1, 0x14, 0x3c, 0x16, 0xc7, 0x1e, 0x39,
-	0xa0, 0x08, 0x25, 0x8f, 0xc0, 0xad, 0x27, 0xe4, 0xc4, 0x29, 0x11, 0x1f, 0x87, 0x54, 0xca, 0x6d,
-	0xfc, 0x9f, 0xf9, 0xcf, 0x58, 0xbf, 0xd9, 0x81, 0xfb, 0x97, 0x89, 0xb0, 0x33, 0x31, 0x4d, 0x52,
-	0x93, 0x61, 0x1a, 0x4f, 0x0b, 0xe3, 0x0c, 0xed, 0xac, 0xa4, 0xe1, 0x60, 0x4b, 0x1b, 0xde, 0x4b,
-	0x8d, 0xd4, 0xdb, 0x55, 0xc3, 0x07, 0xb9, 0xc9, 0xcd, 0x2a, 0x4c, 0xaa, 0x68, 0xad, 0x86, 0x3f,
-	0x9b, 0xd0, 0x7e, 0x37, 0x13, 0x53, 0xfa, 0x04, 0x7a, 0x0a, 0x9d, 0xc8, 0x84, 0x13, 0x8c, 0x04,
-	0x24, 0x1a, 0x1c, 0xdd, 0x8d, 0x67, 0x28, 0x2e, 0x30, 0x3e, 0xad, 0x65, 0x7e, 0x53, 0x40, 0x0f,
-	0xe1, 0xce, 0xb4, 0x40, 0xa9, 0x44, 0x8e, 0x67, 0x13, 0x61, 0x27, 0xac, 0x19, 0x90, 0xe8, 0x80,
-	0x1f, 0x6c, 0xc4, 0xb7, 0xc2, 0x4e, 0xe8, 0x73, 0xf0, 0xac, 0x29, 0x8b, 0x14, 0x59, 0xab, 0xca,
-	0x8e, 0x1f, 0x5d, 0xcf, 0x47, 0x41, 0x2e, 0xdd, 0xa4, 0x3c, 0x8f, 0x53, 0xa3, 0x12, 0x69, 0x2e,
-	0x9e, 0x1a, 0x8d, 0xc9, 0x7a, 0xca, 0xab, 0x2c, 0x2b, 0xd0, 0x5a, 0x5e, 0x7b, 0xe8, 0x1b, 0x18,
-	0x64, 0x68, 0x9d, 0xd4, 0xc2, 0x49, 0xa3, 0x59, 0x67, 0x87, 0x16, 0xdb, 0x46, 0xfa, 0x12, 0xba,
-	0x4e, 0x2a, 0x34, 0xa5, 0x63, 0x5e, 0x40, 0xa2, 0xd6, 0xf8, 0xf1, 0xf5, 0x7c, 0xf4, 0xf0, 0xbf,
-	0x3d, 0x3e, 0x68, 0x79, 0xf9, 0x5e, 0x2a, 0xe4, 0x1b, 0x17, 0xa5, 0xd0, 0x56, 0xa8, 0x0c, 0xeb,
-	0x06, 0x24, 0xea, 0xf3, 0x55, 0x4c, 0x5f, 0x40, 0x57, 0xac, 0x87, 0xb1, 0xde, 0x0e, 0x3f, 0xb6,
-	0x31, 0x85, 0xdf, 0x9b, 0xd0, 0x3f, 0x2e, 0x50, 0x38, 0x3c, 0xb5, 0xf9, 0x6e, 0xe8, 0x7f, 0x53,
-	0x6d, 0xde, 0x82, 0xea, 0x5f, 0x8b, 0x6b, 0xfd, 0x63, 0x71, 0x7f, 0xa0, 0x6f, 0xdf, 0x16, 0x7d,
-	0x08, 0x9e, 0x50, 0xa6, 0xd4, 0x8e, 0x75, 0x82, 0x56, 0x34, 0x38, 0x82, 0xb8, 0x7a, 0x94, 0xf1,
-	0xb1, 0x91, 0x9a, 0xd7, 0x99, 0xbd, 0xac, 0x27, 0xfc, 0x0c, 0xc0, 0xf1, 0x13, 0x0a, 0xbb, 0x3b,
-	0xde, 0x43, 0xe8, 0x56, 0xc7, 0x74, 0x26, 0xb3, 0x9a, 0x2f, 0x2c, 0xe6, 0x23, 0xaf, 0xba, 0x90,
-	0x93, 0xd7, 0xdc, 0xab, 0x52, 0x27, 0x19, 0x1d, 0x42, 0x6f, 0x03, 0xac, 0x06, 0x78, 0xf3, 0x1d,
-	0x7e, 0x84, 0x3e, 0x47, 0x57, 0x16, 0x7a, 0x2f, 0xa3, 0xc7, 0xec, 0xeb, 0xc2, 0x27, 0x57, 0x0b,
-	0x9f, 0xfc, 0x58, 0xf8, 0xe4, 0xcb, 0xd2, 0x6f, 0x5c, 0x2d, 0xfd, 0xc6, 0xb7, 0xa5, 0xdf, 0x38,
-	0xf7, 0x56, 0x07, 0xfd, 0xec, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x9e, 0x36, 0x8b, 0x23,
-	0x04, 0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0x3a, 0x75, 0x92, 0x4d, 0x5b, 0xaa, 0x6d, 0x29, 0x2b, 0x1f, 0x5c, 0x93, 0x82,
+	0x64, 0x81, 0x70, 0x5a, 0x43, 0x22, 0x24, 0x10, 0xc8, 0x09, 0x1f, 0x89, 0x42, 0x84, 0xe4, 0x94,
+	0x23, 0x8a, 0xb6, 0xf6, 0xca, 0x5e, 0x51, 0x7b, 0x23, 0x7b, 0xd3, 0x54, 0x3c, 0x01, 0xca, 0x89,
+	0x2b, 0x87, 0x9c, 0x78, 0x01, 0xae, 0xbc, 0x01, 0xc7, 0x1e, 0x39, 0x45, 0x28, 0x79, 0x8b, 0x9e,
+	0x90, 0xf3, 0x51, 0x92, 0x14, 0x24, 0x52, 0xa9, 0xb7, 0x99, 0xff, 0xcc, 0x7f, 0x26, 0x99, 0x9f,
+	0x6d, 0xb0, 0x75, 0x9a, 0xc7, 0x51, 0x07, 0xb7, 0xf2, 0x36, 0x73, 0x88, 0xad, 0xb7, 0x42, 0xc6,
+	0x19, 0x5c, 0x1d, 0x49, 0x72, 0x76, 0x46, 0x93, 0x37, 0x6d, 0x46, 0x83, 0xd9, 0x2e, 0x79, 0xdb,
+	0x65, 0x2e, 0x1b, 0x85, 0xf9, 0x38, 0x1a, 0xab, 0xb9, 0x6f, 0x22, 0x48, 0x36, 0x3a, 0xb8, 0x05,
+	0xef, 0x83, 0xb4, 0x4f, 0x38, 0x76, 0x30, 0xc7, 0x48, 0x50, 0x05, 0x2d, 0x6b, 0xdc, 0xd0, 0x3b,
+	0x04, 0x9f, 0x10, 0xbd, 0x3e, 0x91, 0xad, 0x8b, 0x06, 0xb8, 0x07, 0xd6, 0x5b, 0x21, 0xa1, 0x3e,
+	0x76, 0x49, 0xd3, 0xc3, 0x91, 0x87, 0x56, 0x54, 0x41, 0x5b, 0xb3, 0xd6, 0xa6, 0x62, 0x05, 0x47,
+	0x1e, 0x7c, 0x0a, 0xa4, 0x88, 0xb5, 0x43, 0x9b, 0x20, 0x31, 0xae, 0x96, 0xee, 0x9c, 0xf7, 0x77,
+	0x55, 0x97, 0x72, 0xaf, 0x7d, 0xa4, 0xdb, 0xcc, 0xcf, 0x53, 0x76, 0xf2, 0x80, 0x05, 0x24, 0x3f,
+	0xde, 0x62, 0x3a, 0x4e, 0x48, 0xa2, 0xc8, 0x9a, 0x78, 0xe0, 0x2b, 0x90, 0x75, 0x48, 0xc4, 0x69,
+	0x80, 0x39, 0x65, 0x01, 0x5a, 0x5d, 0x62, 0xc4, 0xac, 0x11, 0x3e, 0x07, 0x29, 0x4e, 0x7d, 0xc2,
+	0xda, 0x1c, 0x49, 0xaa, 0xa0, 0x89, 0xa5, 0xbb, 0xe7, 0xfd, 0xdd, 0xdb, 0xff, 0x9c, 0xf1, 0x2e,
+	0xa0, 0xa7, 0x87, 0xd4, 0x27, 0xd6, 0xd4, 0x05, 0x21, 0x48, 0xfa, 0xc4, 0x67, 0x28, 0xa5, 0x0a,
+	0x5a, 0xc6, 0x1a, 0xc5, 0xf0, 0x19, 0x48, 0xe1, 0xf1, 0x32, 0x94, 0x5e, 0xe2, 0x87, 0x4d, 0x4d,
+	0xf0, 0x09, 0xd8, 0x88, 0xcf, 0xd6, 0xc4, 0xc7, 0x2e, 0x0b, 0x29, 0xf7, 0x7c, 0x94, 0x51, 0x05,
+	0x6d, 0xc3, 0xd8, 0xd6, 0x47, 0x28, 0xf5, 0xf8, 0x7e, 0xe6, 0xb4, 0x66, 0xad, 0x7b, 0xb3, 0x69,
+	0xee, 0x8b, 0x08, 0x32, 0xe5, 0x90, 0x60, 0x4e, 0xea, 0x91, 0xbb, 0x1c, 0xb7, 0x3f, 0x48, 0x56,
+	0xae, 0x80, 0xe4, 0x12, 0x75, 0xf1, 0x2f, 0xd4, 0x17, 0xb8, 0x25, 0xaf, 0xca, 0x2d, 0x07, 0x24,
+	0xec, 0xb3, 0x76, 0xc0, 0xd1, 0xaa, 0x2a, 0x6a, 0x59, 0x03, 0xe8, 0xf1, 0x13, 0xad, 0x97, 0x19,
+	0x0d, 0xac, 0x49, 0xe5, 0x7a, 0xd8, 0x5e, 0x66, 0x93, 0xfe, 0x7f, 0x36, 0x1f, 0x01, 0xb0, 0xc8,
+	0x31, 0xc1, 0xd1, 0xf2, 0x6c, 0xf6, 0x40, 0x2a, 0x9e, 0xdf, 0xa4, 0xce, 0x04, 0x0e, 0x18, 0xf4,
+	0x77, 0xa5, 0xf8, 0xdd, 0xac, 0xbe, 0xb0, 0xa4, 0xb8, 0x54, 0x75, 0xa0, 0x0c, 0xd2, 0xd3, 0x6b,
+	0x4f, 0xae, 0x7f, 0x91, 0xe7, 0xde, 0x83, 0x8c, 0x45, 0x78, 0x3b, 0x0c, 0xae, 0x65, 0xf5, 0xbd,
+	0xef, 0x02, 0x58, 0x9f, 0xfb, 0xef, 0xd0, 0x00, 0x37, 0x2b, 0x66, 0xa3, 0xd2, 0x34, 0xdf, 0xbc,
+	0x7e, 0x6b, 0x55, 0x0f, 0x2b, 0xf5, 0x66, 0xa3, 0x62, 0x1a, 0x85, 0xe2, 0x66, 0x42, 0xbe, 0xd5,
+	0xed, 0xa9, 0x5b, 0x73, 0xdd, 0x0d, 0x0f, 0x1b, 0x85, 0x22, 0x7c, 0x04, 0x76, 0x16, 0x3c, 0x71,
+	0x7a, 0x50, 0xdc, 0xdf, 0x14, 0x64, 0xd4, 0xed, 0xa9, 0xdb, 0x73, 0xa6, 0x38, 0x39, 0x28, 0xee,
+	0xc3, 0xc7, 0x00, 0x2d, 0xb8, 0x6a, 0x2f, 0xcb, 0x65, 0xb3, 0x16, 0x2f, 0x5b, 0x91, 0xe5, 0x6e,
+	0x4f, 0xdd, 0x99, 0xf3, 0xd5, 0x88, 0x6d, 0xe3, 0x0f, 0x46, 0xa1, 0x28, 0x27, 0x3f, 0x7d, 0x55,
+	0x12, 0x25, 0xf4, 0x63, 0xa0, 0x08, 0x67, 0x03, 0x45, 0xf8, 0x35, 0x50, 0x84, 0xcf, 0x43, 0x25,
+	0x71, 0x36, 0x54, 0x12, 0x3f, 0x87, 0x4a, 0xe2, 0x48, 0x1a, 0x7d, 0x06, 0x1f, 0xfe, 0x1e, 0x00,
+	0x00, 0x4e, 0xb0, 0xea, 0x59, 0x05, 0x00, 0x00,
 }
 
 func (m *Swap) Marshal() (dAtA []byte, err error) {
@@ -449,6 +512,11 @@ func (m *Swap) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
 		i += copy(dAtA[i:], m.Address)
 	}
+	if m.HashAlgorithm != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.HashAlgorithm))
+	}
 	return i, nil
 }
 
@@ -518,6 +586,11 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
 		i += copy(dAtA[i:], m.Memo)
 	}
+	if m.HashAlgorithm != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.HashAlgorithm))
+	}
 	return i, nil
 }
 
@@ -637,6 +710,9 @@ func (m *Swap) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovCodec(uint64(m.HashAlgorithm))
+	}
 	return n
 }
 
@@ -675,6 +751,9 @@ func (m *CreateMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovCodec(uint64(m.HashAlgorithm))
+	}
 	return n
 }
 
@@ -981,6 +1060,25 @@ func (m *Swap) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1257,6 +1355,25 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -13,14 +13,18 @@ the funds.
 
 The algorithm is as follows:
 1. Sender generates a preimage, stores it in a secure place.
-2. Sender makes a sha256 hash out of the preimage.
-3. With this hash sender creates a Swap.
+2. Sender makes a hash out of the preimage.
+3. With this hash and the hash algorithm used, sender creates a Swap.
 4. Sender can release the funds to the recipient by supplying a valid preimage, if the swap
 didn't time out.
 5. If the swap timed out sender will be able to retrieve the funds from it just by sending a valid
 swapID.
 6. Swap is deleted on successful retrieval for either step 4 or step 5.
 
+Supported hash algorithms are sha256 (default), HASH160 (ripemd160 of sha256)
+as used by Bitcoin scripts and keccak256 as used by Ethereum. Using the same
+hash algorithm as the counterparty HTLC allows to swap with other blockchains.
+
 
 */
 package aswap
@@ -2,7 +2,6 @@ package aswap
 
 import (
 	"bytes"
-	"crypto/sha256"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
@@ -69,13 +68,14 @@ func (h CreateSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave
 		return nil, errors.Wrap(err, "cannot acquire key")
 	}
 	swap := &Swap{
-		Metadata:     &weave.Metadata{Schema: 1},
-		Source:       msg.Source,
-		Destination:  msg.Destination,
-		Timeout:      msg.Timeout,
-		Memo:         msg.Memo,
-		PreimageHash: msg.PreimageHash,
-		Address:      swapAddr(key, msg.PreimageHash),
+		Metadata:      &weave.Metadata{Schema: 1},
+		Source:        msg.Source,
+		Destination:   msg.Destination,
+		Timeout:       msg.Timeout,
+		Memo:          msg.Memo,
+		PreimageHash:  msg.PreimageHash,
+		Address:       swapAddr(key, msg.PreimageHash),
+		HashAlgorithm: msg.HashAlgorithm,
 	}
 	if _, err := h.bucket.Put(db, key, swap); err != nil {
 		return nil, errors.Wrap(err, "cannot save swap entity")
@@ -165,7 +165,7 @@ func (h ReleaseSwapHandler) validate(ctx weave.Context, db weave.KVStore, tx wea
 		return nil, nil, errors.Wrap(err, "cannot load swap entity from the store")
 	}
 
-	preimageHash := HashBytes(msg.Preimage)
+	preimageHash := swap.HashAlgorithm.Sum(msg.Preimage)
 
 	if !bytes.Equal(swap.PreimageHash, preimageHash) {
 		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "invalid preimageHash")
@@ -240,8 +240,3 @@ func (h ReturnSwapHandler) validate(ctx weave.Context, db weave.KVStore, tx weav
 
 	return &msg, &swap, nil
 }
-
-func HashBytes(preimage []byte) []byte {
-	hash := sha256.Sum256(preimage)
-	return hash[:]
-}
@@ -163,6 +163,10 @@ func TestReleaseHandler(t *testing.T) {
 		wantDeliverErr *errors.Error
 		exp            Swap
 		mutator        func(db *ReleaseMsg)
+		hashAlgorithm  HashAlgorithm
+		// preimageHash overwrites the preimage hash computed using
+		// the hash algorithm.
+		preimageHash []byte
 	}{
 		"Happy Path, includes no auth check": {
 			wantDeliverErr: nil,
@@ -206,16 +210,32 @@ func TestReleaseHandler(t *testing.T) {
 			wantDeliverErr: errors.ErrState,
 			wantCheckErr:   errors.ErrState,
 		},
+		"HASH160 preimage hash": {
+			hashAlgorithm: HashAlgorithmHash160,
+		},
+		"Keccak256 preimage hash": {
+			hashAlgorithm: HashAlgorithmKeccak256,
+		},
+		"Preimage hashed with a different algorithm": {
+			hashAlgorithm:  HashAlgorithmKeccak256,
+			preimageHash:   preimageHash,
+			wantDeliverErr: errors.ErrUnauthorized,
+			wantCheckErr:   errors.ErrUnauthorized,
+		},
 	}
 
 	for name, spec := range cases {
 		createMsg := &CreateMsg{
-			Metadata:     &weave.Metadata{Schema: 1},
-			Source:       alice.Address(),
-			Destination:  bob.Address(),
-			PreimageHash: preimageHash,
-			Amount:       []*coin.Coin{&swapAmount},
-			Timeout:      weave.AsUnixTime(time.Now().Add(time.Hour)),
+			Metadata:      &weave.Metadata{Schema: 1},
+			Source:        alice.Address(),
+			Destination:   bob.Address(),
+			PreimageHash:  spec.hashAlgorithm.Sum(preimage),
+			Amount:        []*coin.Coin{&swapAmount},
+			Timeout:       weave.AsUnixTime(time.Now().Add(time.Hour)),
+			HashAlgorithm: spec.hashAlgorithm,
+		}
+		if spec.preimageHash != nil {
+			createMsg.PreimageHash = spec.preimageHash
 		}
 		t.Run(name, func(t *testing.T) {
 			db := store.MemStore()
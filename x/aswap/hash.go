@@ -0,0 +1,57 @@
+package aswap
+
+import (
+	"crypto/sha256"
+
+	"github.com/iov-one/weave/errors"
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/sha3"
+)
+
+// Validate returns an error if the hash algorithm is not supported.
+func (a HashAlgorithm) Validate() error {
+	if _, ok := HashAlgorithm_name[int32(a)]; !ok {
+		return errors.Wrapf(errors.ErrInput, "unknown hash algorithm %d", a)
+	}
+	return nil
+}
+
+// Size returns the length of the hash in bytes or zero if the algorithm is
+// not supported.
+func (a HashAlgorithm) Size() int {
+	switch a {
+	case HashAlgorithmSha256:
+		return sha256.Size
+	case HashAlgorithmHash160:
+		return ripemd160.Size
+	case HashAlgorithmKeccak256:
+		return 32
+	default:
+		return 0
+	}
+}
+
+// Sum returns the hash of the preimage computed using the algorithm or nil if
+// the algorithm is not supported.
+func (a HashAlgorithm) Sum(preimage []byte) []byte {
+	switch a {
+	case HashAlgorithmSha256:
+		return HashBytes(preimage)
+	case HashAlgorithmHash160:
+		h := ripemd160.New()
+		_, _ = h.Write(HashBytes(preimage))
+		return h.Sum(nil)
+	case HashAlgorithmKeccak256:
+		h := sha3.NewLegacyKeccak256()
+		_, _ = h.Write(preimage)
+		return h.Sum(nil)
+	default:
+		return nil
+	}
+}
+
+// HashBytes returns the sha256 hash of the preimage.
+func HashBytes(preimage []byte) []byte {
+	hash := sha256.Sum256(preimage)
+	return hash[:]
+}
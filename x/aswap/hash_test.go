@@ -0,0 +1,37 @@
+package aswap
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestHashAlgorithmSum(t *testing.T) {
+	// Well known hashes of an empty input.
+	cases := map[HashAlgorithm]string{
+		HashAlgorithmSha256:    "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		HashAlgorithmHash160:   "b472a266d0bd89c13706a4132ccfb16f7c3b9fcb",
+		HashAlgorithmKeccak256: "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+	}
+	for alg, want := range cases {
+		t.Run(alg.String(), func(t *testing.T) {
+			got := alg.Sum(nil)
+			if hex.EncodeToString(got) != want {
+				t.Fatalf("want %s, got %x", want, got)
+			}
+			if len(got) != alg.Size() {
+				t.Fatalf("want size %d, got %d", alg.Size(), len(got))
+			}
+			if err := alg.Validate(); err != nil {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+
+	unknown := HashAlgorithm(42)
+	if err := unknown.Validate(); err == nil {
+		t.Fatal("want unknown algorithm error")
+	}
+	if unknown.Sum(nil) != nil || unknown.Size() != 0 {
+		t.Fatal("unknown algorithm must not produce a hash")
+	}
+}
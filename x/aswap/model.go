@@ -23,9 +23,8 @@ func (s *Swap) Validate() error {
 	if err := s.Destination.Validate(); err != nil {
 		return errors.Wrap(err, "destination")
 	}
-	if len(s.PreimageHash) != preimageHashSize {
-		return errors.Wrapf(errors.ErrInput,
-			"preimage hash has to be exactly %d bytes", preimageHashSize)
+	if err := validatePreimageHash(s.PreimageHash, s.HashAlgorithm); err != nil {
+		return err
 	}
 	if s.Timeout == 0 {
 		// Zero timeout is a valid value that dates to 1970-01-01. We
@@ -48,13 +47,14 @@ func (s *Swap) Validate() error {
 // Copy makes a new swap
 func (s *Swap) Copy() orm.CloneableData {
 	return &Swap{
-		Metadata:     s.Metadata.Copy(),
-		PreimageHash: s.PreimageHash,
-		Source:       s.Source,
-		Destination:  s.Destination,
-		Timeout:      s.Timeout,
-		Memo:         s.Memo,
-		Address:      s.Address.Clone(),
+		Metadata:      s.Metadata.Copy(),
+		PreimageHash:  s.PreimageHash,
+		Source:        s.Source,
+		Destination:   s.Destination,
+		Timeout:       s.Timeout,
+		Memo:          s.Memo,
+		Address:       s.Address.Clone(),
+		HashAlgorithm: s.HashAlgorithm,
 	}
 }
 
@@ -17,8 +17,6 @@ const (
 	maxMemoSize int = 128
 	// preimage size in bytes
 	preimageSize int = 32
-	// preimageHash size in bytes
-	preimageHashSize int = 32
 )
 
 var _ weave.Msg = (*CreateMsg)(nil)
@@ -31,7 +29,7 @@ func (m *CreateMsg) Validate() error {
 	if err := m.Metadata.Validate(); err != nil {
 		return errors.Wrap(err, "metadata")
 	}
-	if err := validatePreimageHash(m.PreimageHash); err != nil {
+	if err := validatePreimageHash(m.PreimageHash, m.HashAlgorithm); err != nil {
 		return err
 	}
 	if err := m.Source.Validate(); err != nil {
@@ -109,10 +107,13 @@ func validateAmount(amount coin.Coins) (coin.Coins, error) {
 	return c, c.Validate()
 }
 
-func validatePreimageHash(preimageHash []byte) error {
-	if len(preimageHash) != preimageHashSize {
-		return errors.Wrapf(errors.ErrInput, "preimge hash is sha256 and therefore should be exactly "+
-			"%d bytes", preimageHashSize)
+func validatePreimageHash(preimageHash []byte, alg HashAlgorithm) error {
+	if err := alg.Validate(); err != nil {
+		return err
+	}
+	if len(preimageHash) != alg.Size() {
+		return errors.Wrapf(errors.ErrInput, "preimage hash is %s and therefore should be exactly "+
+			"%d bytes", alg, alg.Size())
 	}
 	return nil
 }
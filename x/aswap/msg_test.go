@@ -35,6 +35,29 @@ func TestCreateMsg(t *testing.T) {
 			},
 			Exp: errors.ErrInput,
 		},
+		"HASH160 hash": {
+			Mutator: func(msg *aswap.CreateMsg) {
+				msg.PreimageHash = make([]byte, 20)
+				msg.HashAlgorithm = aswap.HashAlgorithmHash160
+			},
+		},
+		"HASH160 hash of invalid length": {
+			Mutator: func(msg *aswap.CreateMsg) {
+				msg.HashAlgorithm = aswap.HashAlgorithmHash160
+			},
+			Exp: errors.ErrInput,
+		},
+		"Keccak256 hash": {
+			Mutator: func(msg *aswap.CreateMsg) {
+				msg.HashAlgorithm = aswap.HashAlgorithmKeccak256
+			},
+		},
+		"Unknown hash algorithm": {
+			Mutator: func(msg *aswap.CreateMsg) {
+				msg.HashAlgorithm = 42
+			},
+			Exp: errors.ErrInput,
+		},
 		"Invalid destination": {
 			Mutator: func(msg *aswap.CreateMsg) {
 				msg.Destination = nil
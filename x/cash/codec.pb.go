@@ -79,6 +79,175 @@ func (m *Set) GetCoins() []*coin.Coin {
 	return nil
 }
 
+// Supply is the total amount of a single currency that exists on the chain.
+// It is stored under the ticker of the currency and it is updated whenever
+// coins are minted or burned.
+type Supply struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Total    coin.Coin       `protobuf:"bytes,2,opt,name=total,proto3" json:"total"`
+}
+
+func (m *Supply) Reset()         { *m = Supply{} }
+func (m *Supply) String() string { return proto.CompactTextString(m) }
+func (*Supply) ProtoMessage()    {}
+func (*Supply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{1}
+}
+func (m *Supply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Supply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Supply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Supply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Supply.Merge(m, src)
+}
+func (m *Supply) XXX_Size() int {
+	return m.Size()
+}
+func (m *Supply) XXX_DiscardUnknown() {
+	xxx_messageInfo_Supply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Supply proto.InternalMessageInfo
+
+func (m *Supply) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Supply) GetTotal() coin.Coin {
+	if m != nil {
+		return m.Total
+	}
+	return coin.Coin{}
+}
+
+// Vesting describes how coins of an account are unlocked over time. It is
+// stored under the address of the account. Unvested coins cannot be moved
+// out of the account.
+//
+// Nothing is unlocked before the cliff time. After that, the vested amount
+// grows linearly between the start and the end time, but it is released only
+// once per period. Each release is executed by the cron, so the period cannot
+// be shorter than an hour and a linear schedule is approximated by hourly
+// releases. A long period gives a periodic schedule. Everything is unlocked at
+// the end time.
+type Vesting struct {
+	Metadata  *weave.Metadata                       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	StartTime github_com_iov_one_weave.UnixTime     `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"start_time,omitempty"`
+	CliffTime github_com_iov_one_weave.UnixTime     `protobuf:"varint,3,opt,name=cliff_time,json=cliffTime,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"cliff_time,omitempty"`
+	EndTime   github_com_iov_one_weave.UnixTime     `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"end_time,omitempty"`
+	Period    github_com_iov_one_weave.UnixDuration `protobuf:"varint,5,opt,name=period,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"period,omitempty"`
+	// Vested is the amount that was already unlocked.
+	Vested []*coin.Coin `protobuf:"bytes,6,rep,name=vested,proto3" json:"vested,omitempty"`
+	// Unvested is the amount that is still locked.
+	Unvested []*coin.Coin `protobuf:"bytes,7,rep,name=unvested,proto3" json:"unvested,omitempty"`
+	// Task ID is the ID of the cron task that unlocks the next portion of
+	// coins.
+	TaskID []byte `protobuf:"bytes,8,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *Vesting) Reset()         { *m = Vesting{} }
+func (m *Vesting) String() string { return proto.CompactTextString(m) }
+func (*Vesting) ProtoMessage()    {}
+func (*Vesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{2}
+}
+func (m *Vesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vesting.Merge(m, src)
+}
+func (m *Vesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vesting proto.InternalMessageInfo
+
+func (m *Vesting) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Vesting) GetStartTime() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *Vesting) GetCliffTime() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.CliffTime
+	}
+	return 0
+}
+
+func (m *Vesting) GetEndTime() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *Vesting) GetPeriod() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *Vesting) GetVested() []*coin.Coin {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *Vesting) GetUnvested() []*coin.Coin {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+func (m *Vesting) GetTaskID() []byte {
+	if m != nil {
+		return m.TaskID
+	}
+	return nil
+}
+
 // SendMsg is a request to move these coins from the given
 // source to the given destination address.
 // memo is an optional human-readable message
@@ -99,7 +268,7 @@ func (m *SendMsg) Reset()         { *m = SendMsg{} }
 func (m *SendMsg) String() string { return proto.CompactTextString(m) }
 func (*SendMsg) ProtoMessage()    {}
 func (*SendMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7149e4b58e322390, []int{1}
+	return fileDescriptor_7149e4b58e322390, []int{3}
 }
 func (m *SendMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -170,6 +339,194 @@ func (m *SendMsg) GetRef() []byte {
 	return nil
 }
 
+// MultiSendMsg is a request to move coins from many source addresses to many
+// destination addresses in a single operation. For each currency, the sum of
+// all inputs must be equal to the sum of all outputs. All input addresses
+// must authorize the operation.
+type MultiSendMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Inputs   []Input         `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs"`
+	Outputs  []Output        `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs"`
+	// max length 128 character
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	// max length 64 bytes
+	Ref []byte `protobuf:"bytes,5,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (m *MultiSendMsg) Reset()         { *m = MultiSendMsg{} }
+func (m *MultiSendMsg) String() string { return proto.CompactTextString(m) }
+func (*MultiSendMsg) ProtoMessage()    {}
+func (*MultiSendMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{4}
+}
+func (m *MultiSendMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiSendMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiSendMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiSendMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSendMsg.Merge(m, src)
+}
+func (m *MultiSendMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiSendMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSendMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSendMsg proto.InternalMessageInfo
+
+func (m *MultiSendMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *MultiSendMsg) GetInputs() []Input {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *MultiSendMsg) GetOutputs() []Output {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *MultiSendMsg) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *MultiSendMsg) GetRef() []byte {
+	if m != nil {
+		return m.Ref
+	}
+	return nil
+}
+
+// Input is a source of coins in a MultiSendMsg.
+type Input struct {
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	Amount  coin.Coin                        `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *Input) Reset()         { *m = Input{} }
+func (m *Input) String() string { return proto.CompactTextString(m) }
+func (*Input) ProtoMessage()    {}
+func (*Input) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{5}
+}
+func (m *Input) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Input.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Input) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Input.Merge(m, src)
+}
+func (m *Input) XXX_Size() int {
+	return m.Size()
+}
+func (m *Input) XXX_DiscardUnknown() {
+	xxx_messageInfo_Input.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Input proto.InternalMessageInfo
+
+func (m *Input) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *Input) GetAmount() coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return coin.Coin{}
+}
+
+// Output is a destination of coins in a MultiSendMsg.
+type Output struct {
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	Amount  coin.Coin                        `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *Output) Reset()         { *m = Output{} }
+func (m *Output) String() string { return proto.CompactTextString(m) }
+func (*Output) ProtoMessage()    {}
+func (*Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{6}
+}
+func (m *Output) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Output.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Output.Merge(m, src)
+}
+func (m *Output) XXX_Size() int {
+	return m.Size()
+}
+func (m *Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_Output.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Output proto.InternalMessageInfo
+
+func (m *Output) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *Output) GetAmount() coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return coin.Coin{}
+}
+
 // FeeInfo records who pays what fees to have this
 // message processed
 type FeeInfo struct {
@@ -181,7 +538,7 @@ func (m *FeeInfo) Reset()         { *m = FeeInfo{} }
 func (m *FeeInfo) String() string { return proto.CompactTextString(m) }
 func (*FeeInfo) ProtoMessage()    {}
 func (*FeeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7149e4b58e322390, []int{2}
+	return fileDescriptor_7149e4b58e322390, []int{7}
 }
 func (m *FeeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,7 +595,7 @@ func (m *Configuration) Reset()         { *m = Configuration{} }
 func (m *Configuration) String() string { return proto.CompactTextString(m) }
 func (*Configuration) ProtoMessage()    {}
 func (*Configuration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7149e4b58e322390, []int{3}
+	return fileDescriptor_7149e4b58e322390, []int{8}
 }
 func (m *Configuration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +661,7 @@ func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{}
 func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdateConfigurationMsg) ProtoMessage()    {}
 func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7149e4b58e322390, []int{4}
+	return fileDescriptor_7149e4b58e322390, []int{9}
 }
 func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,60 +704,188 @@ func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Set)(nil), "cash.Set")
-	proto.RegisterType((*SendMsg)(nil), "cash.SendMsg")
-	proto.RegisterType((*FeeInfo)(nil), "cash.FeeInfo")
-	proto.RegisterType((*Configuration)(nil), "cash.Configuration")
-	proto.RegisterType((*UpdateConfigurationMsg)(nil), "cash.UpdateConfigurationMsg")
+// UnlockVestingMsg releases coins of a vesting account that vested until now.
+// This message is executed by the cron only.
+type UnlockVestingMsg struct {
+	Metadata *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Address  github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
 }
 
-func init() { proto.RegisterFile("x/cash/codec.proto", fileDescriptor_7149e4b58e322390) }
+func (m *UnlockVestingMsg) Reset()         { *m = UnlockVestingMsg{} }
+func (m *UnlockVestingMsg) String() string { return proto.CompactTextString(m) }
+func (*UnlockVestingMsg) ProtoMessage()    {}
+func (*UnlockVestingMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{10}
+}
+func (m *UnlockVestingMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockVestingMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockVestingMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnlockVestingMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockVestingMsg.Merge(m, src)
+}
+func (m *UnlockVestingMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockVestingMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockVestingMsg.DiscardUnknown(m)
+}
 
-var fileDescriptor_7149e4b58e322390 = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x13, 0x27, 0x85, 0x31, 0x88, 0xb0, 0x20, 0x64, 0xe5, 0xe0, 0x5a, 0x16, 0x87, 0x20,
-	0x84, 0x2d, 0xc2, 0xad, 0xe2, 0x42, 0x2a, 0x45, 0xe2, 0xd0, 0x03, 0x2e, 0x9c, 0xab, 0xed, 0x7a,
-	0xec, 0xac, 0x14, 0xef, 0x44, 0xf6, 0xa6, 0x85, 0x1f, 0xe0, 0xcc, 0x67, 0xf5, 0xd8, 0x23, 0xa7,
-	0x0a, 0x25, 0x7f, 0xc1, 0x01, 0xa1, 0x5d, 0x5b, 0x55, 0xa2, 0x9e, 0x7c, 0x1b, 0xbf, 0x99, 0xf7,
-	0x3c, 0xef, 0xed, 0x2e, 0xb0, 0xef, 0x89, 0xe0, 0xf5, 0x32, 0x11, 0x94, 0xa1, 0x88, 0xd7, 0x15,
-	0x69, 0x62, 0xae, 0x41, 0x26, 0xde, 0x1e, 0x34, 0x19, 0x0b, 0x92, 0x6a, 0x7f, 0x68, 0xf2, 0xb2,
-	0xa0, 0x82, 0x6c, 0x99, 0x98, 0xaa, 0x41, 0xa3, 0xaf, 0x30, 0x38, 0x47, 0xcd, 0xde, 0xc2, 0xa3,
-	0x12, 0x35, 0xcf, 0xb8, 0xe6, 0xbe, 0x13, 0x3a, 0x53, 0x6f, 0xf6, 0x2c, 0xbe, 0x46, 0x7e, 0x85,
-	0xf1, 0x59, 0x0b, 0xa7, 0xf7, 0x03, 0x2c, 0x84, 0xa1, 0x51, 0xaf, 0xfd, 0x7e, 0x38, 0x98, 0x7a,
-	0x33, 0x88, 0xcd, 0x57, 0x7c, 0x4a, 0x52, 0xa5, 0x4d, 0x23, 0xfa, 0xd9, 0x87, 0xa3, 0x73, 0x54,
-	0xd9, 0x59, 0x5d, 0x74, 0x93, 0xfe, 0x08, 0xa3, 0x9a, 0x36, 0x95, 0x40, 0xbf, 0x1f, 0x3a, 0xd3,
-	0x27, 0xf3, 0xd7, 0x7f, 0xef, 0x8e, 0xc3, 0x42, 0xea, 0xe5, 0xe6, 0x32, 0x16, 0x54, 0x26, 0x92,
-	0xae, 0xde, 0x91, 0xc2, 0xa4, 0x11, 0xf8, 0x94, 0x65, 0x15, 0xd6, 0x75, 0xda, 0x72, 0xd8, 0x02,
-	0xbc, 0x0c, 0x6b, 0x2d, 0x15, 0xd7, 0x92, 0x94, 0x3f, 0xe8, 0x20, 0xb1, 0x4f, 0x64, 0x11, 0x8c,
-	0x78, 0x49, 0x1b, 0xa5, 0x7d, 0xd7, 0x2e, 0xbc, 0xef, 0xb0, 0xed, 0x30, 0x06, 0x6e, 0x89, 0x25,
-	0xf9, 0xc3, 0xd0, 0x99, 0x3e, 0x4e, 0x6d, 0xcd, 0xc6, 0x30, 0xa8, 0x30, 0xf7, 0x47, 0xe6, 0xbf,
-	0xa9, 0x29, 0x23, 0x84, 0xa3, 0x05, 0xe2, 0x67, 0x95, 0x13, 0x3b, 0x81, 0xe1, 0x9a, 0xff, 0xc0,
-	0xaa, 0x93, 0xb3, 0x86, 0xc2, 0x02, 0x70, 0x73, 0xc4, 0xda, 0x3a, 0x3a, 0x5c, 0xc7, 0xe2, 0xd1,
-	0x3f, 0x07, 0x9e, 0x9e, 0x92, 0xca, 0x65, 0xb1, 0xa9, 0x1a, 0x0b, 0x9d, 0x52, 0x3f, 0x81, 0x21,
-	0x5d, 0xab, 0xae, 0xab, 0x59, 0x0a, 0xfb, 0x02, 0xcf, 0x05, 0xad, 0x56, 0x28, 0x34, 0x55, 0x17,
-	0xbc, 0xe9, 0x75, 0x4a, 0x7e, 0x7c, 0x4f, 0x6f, 0x11, 0xf6, 0x1e, 0xbc, 0x52, 0x2a, 0x59, 0xf2,
-	0xd5, 0x45, 0x8e, 0xf8, 0xf0, 0x0c, 0xe6, 0xee, 0xcd, 0xdd, 0x71, 0x2f, 0x85, 0x76, 0x68, 0x81,
-	0x18, 0xad, 0xe1, 0xd5, 0xb7, 0x75, 0xc6, 0x35, 0x1e, 0xa4, 0xd0, 0xf9, 0xfa, 0xbd, 0x31, 0x67,
-	0xa4, 0xc5, 0xd2, 0x06, 0xe1, 0xcd, 0x5e, 0xc4, 0xe6, 0x61, 0xc5, 0x07, 0x9a, 0x69, 0x33, 0x31,
-	0xf7, 0x6f, 0xb6, 0x81, 0x73, 0xbb, 0x0d, 0x9c, 0x3f, 0xdb, 0xc0, 0xf9, 0xb5, 0x0b, 0x7a, 0xb7,
-	0xbb, 0xa0, 0xf7, 0x7b, 0x17, 0xf4, 0x2e, 0x47, 0xf6, 0x65, 0x7d, 0xf8, 0x1f, 0x00, 0x00, 0xff,
-	0xff, 0xe1, 0x8c, 0x17, 0xa5, 0xaa, 0x03, 0x00, 0x00,
+var xxx_messageInfo_UnlockVestingMsg proto.InternalMessageInfo
+
+func (m *UnlockVestingMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
 }
 
-func (m *Set) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *UnlockVestingMsg) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Set) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+// MigrateWalletsMsg rebuilds the data derived from balances of all wallets.
+// It must be executed by the configuration owner once after upgrading a chain
+// that stored wallets using an older version. Execution cost grows with the
+// number of wallets.
+type MigrateWalletsMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *MigrateWalletsMsg) Reset()         { *m = MigrateWalletsMsg{} }
+func (m *MigrateWalletsMsg) String() string { return proto.CompactTextString(m) }
+func (*MigrateWalletsMsg) ProtoMessage()    {}
+func (*MigrateWalletsMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{11}
+}
+func (m *MigrateWalletsMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateWalletsMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateWalletsMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrateWalletsMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateWalletsMsg.Merge(m, src)
+}
+func (m *MigrateWalletsMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateWalletsMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateWalletsMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateWalletsMsg proto.InternalMessageInfo
+
+func (m *MigrateWalletsMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Set)(nil), "cash.Set")
+	proto.RegisterType((*Supply)(nil), "cash.Supply")
+	proto.RegisterType((*Vesting)(nil), "cash.Vesting")
+	proto.RegisterType((*SendMsg)(nil), "cash.SendMsg")
+	proto.RegisterType((*MultiSendMsg)(nil), "cash.MultiSendMsg")
+	proto.RegisterType((*Input)(nil), "cash.Input")
+	proto.RegisterType((*Output)(nil), "cash.Output")
+	proto.RegisterType((*FeeInfo)(nil), "cash.FeeInfo")
+	proto.RegisterType((*Configuration)(nil), "cash.Configuration")
+	proto.RegisterType((*UpdateConfigurationMsg)(nil), "cash.UpdateConfigurationMsg")
+	proto.RegisterType((*UnlockVestingMsg)(nil), "cash.UnlockVestingMsg")
+	proto.RegisterType((*MigrateWalletsMsg)(nil), "cash.MigrateWalletsMsg")
+}
+
+func init() { proto.RegisterFile("x/cash/codec.proto", fileDescriptor_7149e4b58e322390) }
+
+var fileDescriptor_7149e4b58e322390 = []byte{
+	// 740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xe3, 0xd8, 0xce, 0xbe, 0x64, 0x45, 0x76, 0x40, 0xc8, 0xea, 0x21, 0x09, 0x86, 0x5d,
+	0x65, 0x05, 0x38, 0x62, 0xb9, 0xad, 0x10, 0x6a, 0xd3, 0xaa, 0x52, 0x0e, 0x11, 0xc2, 0x6d, 0xe1,
+	0x84, 0xa2, 0xa9, 0xfd, 0x92, 0x8e, 0x6a, 0xcf, 0x18, 0x7b, 0xdc, 0x3f, 0x27, 0x6e, 0x9c, 0xf9,
+	0x38, 0x88, 0x4f, 0xd0, 0x63, 0x8f, 0x9c, 0x22, 0x94, 0x7e, 0x8b, 0x1e, 0x10, 0xf2, 0xd8, 0x49,
+	0xd3, 0x56, 0x45, 0x72, 0x0f, 0x7b, 0x7b, 0x79, 0xef, 0xf7, 0xfb, 0xbd, 0xe7, 0xf7, 0x27, 0x03,
+	0xe4, 0x62, 0xe8, 0xd3, 0xf4, 0x64, 0xe8, 0x8b, 0x00, 0x7d, 0x37, 0x4e, 0x84, 0x14, 0xa4, 0x91,
+	0x7b, 0xb6, 0x5a, 0x1b, 0xae, 0xad, 0x8e, 0x2f, 0x18, 0xdf, 0x04, 0x6d, 0x7d, 0x32, 0x17, 0x73,
+	0xa1, 0xcc, 0x61, 0x6e, 0x15, 0x5e, 0xe7, 0x10, 0xf4, 0x03, 0x94, 0xe4, 0x4b, 0x68, 0x46, 0x28,
+	0x69, 0x40, 0x25, 0xb5, 0xb5, 0xbe, 0x36, 0x68, 0xbd, 0xfb, 0xc8, 0x3d, 0x47, 0x7a, 0x86, 0xee,
+	0xa4, 0x74, 0x7b, 0x6b, 0x00, 0xe9, 0x83, 0x91, 0xab, 0xa7, 0x76, 0xbd, 0xaf, 0x0f, 0x5a, 0xef,
+	0xc0, 0xcd, 0x7f, 0xb9, 0xbb, 0x82, 0x71, 0xaf, 0x08, 0x38, 0xbf, 0x80, 0x79, 0x90, 0xc5, 0x71,
+	0x78, 0x59, 0x4d, 0xf8, 0x0d, 0x18, 0x52, 0x48, 0x1a, 0xda, 0xf5, 0xbe, 0x76, 0x5f, 0x78, 0xd4,
+	0xb8, 0x5a, 0xf4, 0x6a, 0x5e, 0x11, 0x76, 0xfe, 0xd4, 0xc1, 0xfa, 0x09, 0x53, 0xc9, 0xf8, 0xbc,
+	0x5a, 0x82, 0x3d, 0x80, 0x54, 0xd2, 0x44, 0x4e, 0x25, 0x8b, 0x50, 0x65, 0xd1, 0x47, 0xaf, 0x6f,
+	0x17, 0xbd, 0xcf, 0xe6, 0x4c, 0x9e, 0x64, 0xc7, 0xae, 0x2f, 0xa2, 0x21, 0x13, 0x67, 0x5f, 0x0b,
+	0x8e, 0xc3, 0x42, 0xe4, 0x88, 0xb3, 0x8b, 0x43, 0x16, 0xa1, 0xf7, 0x42, 0x11, 0x73, 0x33, 0x57,
+	0xf1, 0x43, 0x36, 0x9b, 0x15, 0x2a, 0x7a, 0x25, 0x15, 0x45, 0x54, 0x2a, 0xdb, 0xd0, 0x44, 0x1e,
+	0x14, 0x1a, 0x8d, 0x2a, 0x1a, 0x16, 0xf2, 0x40, 0x29, 0xec, 0x80, 0x19, 0x63, 0xc2, 0x44, 0x60,
+	0x1b, 0x7d, 0x6d, 0xf0, 0x72, 0xf4, 0xf6, 0x76, 0xd1, 0x7b, 0xfd, 0xbf, 0xfc, 0xbd, 0x2c, 0xa1,
+	0x92, 0x09, 0xee, 0x95, 0x44, 0xe2, 0x80, 0x79, 0x86, 0xa9, 0xc4, 0xc0, 0x36, 0x1f, 0xcd, 0xb2,
+	0x8c, 0x90, 0x37, 0xd0, 0xcc, 0x78, 0x89, 0xb2, 0x1e, 0xa1, 0xd6, 0x31, 0xf2, 0x39, 0x58, 0x92,
+	0xa6, 0xa7, 0x53, 0x16, 0xd8, 0xcd, 0xbe, 0x36, 0x68, 0x8f, 0x60, 0xb9, 0xe8, 0x99, 0x87, 0x34,
+	0x3d, 0x1d, 0xef, 0x79, 0x66, 0x1e, 0x1a, 0x07, 0xce, 0xef, 0x75, 0xb0, 0x0e, 0x90, 0x07, 0x93,
+	0xb4, 0xe2, 0xe8, 0xbe, 0x03, 0x33, 0x15, 0x59, 0xe2, 0x17, 0x63, 0x6b, 0x8f, 0xbe, 0xb8, 0x5d,
+	0xf4, 0xfa, 0x4f, 0x7e, 0xec, 0x4e, 0x10, 0x24, 0x98, 0xa6, 0x5e, 0xc9, 0x21, 0xfb, 0xd0, 0x0a,
+	0xd4, 0xc2, 0xa8, 0xcf, 0xb7, 0xf5, 0x0a, 0x12, 0x9b, 0xc4, 0xbc, 0x5f, 0x34, 0x12, 0x19, 0x97,
+	0x6a, 0x64, 0x0f, 0xfa, 0x55, 0x44, 0x08, 0x81, 0x46, 0x84, 0x91, 0x50, 0x43, 0x79, 0xe1, 0x29,
+	0x9b, 0x74, 0x40, 0x4f, 0x70, 0x66, 0x9b, 0x79, 0x5e, 0x2f, 0x37, 0x9d, 0xbf, 0x34, 0x68, 0x4f,
+	0xb2, 0x50, 0xb2, 0x67, 0x75, 0xe3, 0x2d, 0x98, 0x8c, 0xc7, 0x99, 0x5c, 0xdd, 0x60, 0xcb, 0xcd,
+	0xff, 0x02, 0xdc, 0x71, 0xee, 0x2b, 0x6f, 0xa5, 0x04, 0x90, 0xaf, 0xc0, 0x12, 0x99, 0x54, 0x58,
+	0x5d, 0x61, 0xdb, 0x05, 0xf6, 0x87, 0x4c, 0xde, 0x81, 0x57, 0x90, 0x75, 0xf1, 0x8d, 0xc7, 0xc5,
+	0x1b, 0x77, 0xc5, 0xff, 0x0a, 0x86, 0x4a, 0x45, 0xbe, 0x07, 0x8b, 0x16, 0x7d, 0xb2, 0xb5, 0x0a,
+	0x3d, 0x5d, 0x91, 0xc8, 0x60, 0xdd, 0xcf, 0xa7, 0x4e, 0xbe, 0x8c, 0x3b, 0x09, 0x98, 0x45, 0xc5,
+	0x1f, 0x30, 0x27, 0x82, 0xb5, 0x8f, 0x38, 0xe6, 0x33, 0x41, 0xde, 0x83, 0x11, 0xd3, 0x4b, 0x4c,
+	0x2a, 0x6d, 0x5f, 0x41, 0x21, 0x5d, 0x68, 0xcc, 0x10, 0x53, 0x5b, 0x7f, 0x98, 0xce, 0x53, 0x7e,
+	0xe7, 0x5f, 0x0d, 0x5e, 0xee, 0x0a, 0x3e, 0x63, 0xf3, 0xf2, 0x3c, 0xab, 0xed, 0xc2, 0x7b, 0x30,
+	0xc4, 0x39, 0xaf, 0x5a, 0x9a, 0xa2, 0x90, 0x1f, 0xe1, 0x95, 0x2f, 0xc2, 0x10, 0x7d, 0x29, 0x92,
+	0xe9, 0xaa, 0xab, 0x55, 0xae, 0xa3, 0xb3, 0xa6, 0x97, 0x1e, 0xf2, 0x0d, 0xb4, 0x22, 0xc6, 0x59,
+	0x44, 0xc3, 0xe9, 0x0c, 0xd1, 0x6e, 0x3c, 0xd1, 0x63, 0x28, 0x41, 0xfb, 0x88, 0x4e, 0x0c, 0x9f,
+	0x1e, 0xc5, 0x01, 0x95, 0x78, 0xaf, 0x0b, 0xcf, 0x38, 0x0a, 0x23, 0xa6, 0xd2, 0x3f, 0x29, 0xe7,
+	0xfa, 0x71, 0xb1, 0xe7, 0xf7, 0x34, 0xbd, 0x02, 0xe1, 0xfc, 0x06, 0x9d, 0x23, 0x1e, 0x0a, 0xff,
+	0xb4, 0x7c, 0x46, 0x2a, 0xe7, 0xda, 0x58, 0xc2, 0xfa, 0x33, 0x96, 0xd0, 0xd9, 0x86, 0x57, 0x13,
+	0x36, 0x4f, 0xa8, 0xc4, 0x9f, 0x69, 0x18, 0xa2, 0x4c, 0xab, 0x56, 0x30, 0xb2, 0xaf, 0x96, 0x5d,
+	0xed, 0x7a, 0xd9, 0xd5, 0xfe, 0x59, 0x76, 0xb5, 0x3f, 0x6e, 0xba, 0xb5, 0xeb, 0x9b, 0x6e, 0xed,
+	0xef, 0x9b, 0x6e, 0xed, 0xd8, 0x54, 0x4f, 0xfb, 0xb7, 0xff, 0x0d, 0x00, 0x2f, 0x9c, 0xec, 0x12,
+	0x2b, 0x08, 0x00, 0x00,
+}
+
+func (m *Set) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Set) MarshalTo(dAtA []byte) (int, error) {
+	var i int
 	_ = i
 	var l int
 	_ = l
@@ -429,7 +914,7 @@ func (m *Set) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SendMsg) Marshal() (dAtA []byte, err error) {
+func (m *Supply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -439,7 +924,7 @@ func (m *SendMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SendMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -454,6 +939,120 @@ func (m *SendMsg) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n2
 	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Total.Size()))
+	n3, err := m.Total.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	return i, nil
+}
+
+func (m *Vesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vesting) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.StartTime != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StartTime))
+	}
+	if m.CliffTime != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CliffTime))
+	}
+	if m.EndTime != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EndTime))
+	}
+	if m.Period != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Period))
+	}
+	if len(m.Vested) > 0 {
+		for _, msg := range m.Vested {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, msg := range m.Unvested {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.TaskID) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TaskID)))
+		i += copy(dAtA[i:], m.TaskID)
+	}
+	return i, nil
+}
+
+func (m *SendMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	if len(m.Source) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -470,11 +1069,11 @@ func (m *SendMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
-		n3, err := m.Amount.MarshalTo(dAtA[i:])
+		n6, err := m.Amount.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n6
 	}
 	if len(m.Memo) > 0 {
 		dAtA[i] = 0x2a
@@ -491,6 +1090,134 @@ func (m *SendMsg) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *MultiSendMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.Inputs) > 0 {
+		for _, msg := range m.Inputs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, msg := range m.Outputs {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	if len(m.Ref) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Ref)))
+		i += copy(dAtA[i:], m.Ref)
+	}
+	return i, nil
+}
+
+func (m *Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Input) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+	n8, err := m.Amount.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	return i, nil
+}
+
+func (m *Output) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Output) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+	n9, err := m.Amount.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n9
+	return i, nil
+}
+
 func (m *FeeInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -516,11 +1243,11 @@ func (m *FeeInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Fees.Size()))
-		n4, err := m.Fees.MarshalTo(dAtA[i:])
+		n10, err := m.Fees.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n10
 	}
 	return i, nil
 }
@@ -544,11 +1271,11 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		n11, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n11
 	}
 	if len(m.Owner) > 0 {
 		dAtA[i] = 0x12
@@ -565,11 +1292,11 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.MinimalFee.Size()))
-	n6, err := m.MinimalFee.MarshalTo(dAtA[i:])
+	n12, err := m.MinimalFee.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n12
 	return i, nil
 }
 
@@ -592,33 +1319,95 @@ func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		n13, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n13
 	}
 	if m.Patch != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
-		n8, err := m.Patch.MarshalTo(dAtA[i:])
+		n14, err := m.Patch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n14
 	}
 	return i, nil
 }
 
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+func (m *UnlockVestingMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockVestingMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n15, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
+func (m *MigrateWalletsMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateWalletsMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n16, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
 }
 func (m *Set) Size() (n int) {
 	if m == nil {
@@ -639,6 +1428,62 @@ func (m *Set) Size() (n int) {
 	return n
 }
 
+func (m *Supply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func (m *Vesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovCodec(uint64(m.StartTime))
+	}
+	if m.CliffTime != 0 {
+		n += 1 + sovCodec(uint64(m.CliffTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovCodec(uint64(m.EndTime))
+	}
+	if m.Period != 0 {
+		n += 1 + sovCodec(uint64(m.Period))
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.TaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func (m *SendMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -672,6 +1517,69 @@ func (m *SendMsg) Size() (n int) {
 	return n
 }
 
+func (m *MultiSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Ref)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func (m *Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
 func (m *FeeInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -729,6 +1637,36 @@ func (m *UpdateConfigurationMsg) Size() (n int) {
 	return n
 }
 
+func (m *UnlockVestingMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *MigrateWalletsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -807,9 +1745,1241 @@ func (m *Set) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, &coin.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Supply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			m.CliffTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, &coin.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, &coin.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskID = append(m.TaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskID == nil {
+				m.TaskID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &coin.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = append(m.Ref[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ref == nil {
+				m.Ref = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiSendMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiSendMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiSendMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, Input{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, Output{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = append(m.Ref[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ref == nil {
+				m.Ref = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = append(m.Payer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payer == nil {
+				m.Payer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -836,8 +3006,10 @@ func (m *Set) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, &coin.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Fees == nil {
+				m.Fees = &coin.Coin{}
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -865,7 +3037,7 @@ func (m *Set) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendMsg) Unmarshal(dAtA []byte) error {
+func (m *Configuration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -888,10 +3060,10 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -932,7 +3104,7 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -959,14 +3131,14 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
-			if m.Source == nil {
-				m.Source = []byte{}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectorAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -993,14 +3165,14 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
-			if m.Destination == nil {
-				m.Destination = []byte{}
+			m.CollectorAddress = append(m.CollectorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.CollectorAddress == nil {
+				m.CollectorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimalFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1027,79 +3199,10 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Amount == nil {
-				m.Amount = &coin.Coin{}
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinimalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ref = append(m.Ref[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ref == nil {
-				m.Ref = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1124,7 +3227,7 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeInfo) Unmarshal(dAtA []byte) error {
+func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1147,17 +3250,17 @@ func (m *FeeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateConfigurationMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateConfigurationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1167,29 +3270,31 @@ func (m *FeeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payer = append(m.Payer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payer == nil {
-				m.Payer = []byte{}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1216,10 +3321,10 @@ func (m *FeeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fees == nil {
-				m.Fees = &coin.Coin{}
+			if m.Patch == nil {
+				m.Patch = &Configuration{}
 			}
-			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1247,7 +3352,7 @@ func (m *FeeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Configuration) Unmarshal(dAtA []byte) error {
+func (m *UnlockVestingMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1270,10 +3375,10 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockVestingMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockVestingMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1314,41 +3419,7 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollectorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1375,42 +3446,9 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollectorAddress = append(m.CollectorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.CollectorAddress == nil {
-				m.CollectorAddress = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimalFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinimalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1437,7 +3475,7 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
+func (m *MigrateWalletsMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1460,10 +3498,10 @@ func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateConfigurationMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrateWalletsMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateConfigurationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrateWalletsMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1502,42 +3540,6 @@ func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Patch == nil {
-				m.Patch = &Configuration{}
-			}
-			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
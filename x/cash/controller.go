@@ -20,12 +20,45 @@ type CoinMinter interface {
 	CoinMint(weave.KVStore, weave.Address, coin.Coin) error
 }
 
+// CoinBurner is an interface to destroy coins.
+type CoinBurner interface {
+	// CoinBurn decrease the number of funds on given account by a
+	// specified amount. Burning more than the account holds must result
+	// in an error.
+	CoinBurn(weave.KVStore, weave.Address, coin.Coin) error
+}
+
+// Supplier is an interface to query the amount of coins in circulation.
+type Supplier interface {
+	// TotalSupply returns the total amount of coins with given ticker
+	// that were minted and not burned.
+	TotalSupply(weave.ReadOnlyKVStore, string) (coin.Coin, error)
+}
+
 // Balancer is an interface to query the amount of coins.
 type Balancer interface {
 	// Balance returns the amount of funds stored under given account address.
 	Balance(weave.KVStore, weave.Address) (coin.Coins, error)
 }
 
+// HoldersLister is an interface to list all accounts holding a currency.
+type HoldersLister interface {
+	// Holders calls given function for all accounts holding a positive
+	// amount of coins with given ticker, ordered by the account address.
+	// Iteration stops with the first error returned by the function.
+	Holders(weave.ReadOnlyKVStore, string, func(weave.Address, coin.Coin) error) error
+}
+
+// TransferHook is an interface for checks that must pass before coins can be
+// moved between accounts. It allows other extensions to restrict transfers,
+// for example to freeze an account.
+type TransferHook interface {
+	// BeforeTransfer is called before the given amount is moved from the
+	// source to the destination address. Returning an error aborts the
+	// transfer.
+	BeforeTransfer(store weave.KVStore, src weave.Address, dest weave.Address, amount coin.Coin) error
+}
+
 // Controller is the functionality needed by cash.Handler and cash.Decorator.
 // BaseController should work plenty fine, but you can add other logic if so
 // desired
@@ -38,14 +71,16 @@ type Controller interface {
 // storage engine. Wallet must return something that supports AsSet.
 type BaseController struct {
 	bucket WalletBucket
+	hooks  []TransferHook
 }
 
 var _ Controller = BaseController{}
 
-// NewController returns a base controller implementation.
-func NewController(bucket WalletBucket) BaseController {
+// NewController returns a base controller implementation. All given hooks
+// are called, in order, before moving coins.
+func NewController(bucket WalletBucket, hooks ...TransferHook) BaseController {
 	ValidateWalletBucket(bucket)
-	return BaseController{bucket: bucket}
+	return BaseController{bucket: bucket, hooks: hooks}
 }
 
 // Balance returns the amount of funds stored under given account address.
@@ -60,9 +95,21 @@ func (c BaseController) Balance(store weave.KVStore, src weave.Address) (coin.Co
 	return AsCoins(state), nil
 }
 
+// Holders calls fn for all accounts holding a positive amount of coins with
+// given ticker. This functionality is available only if the wallet bucket
+// used by the controller implements HoldersLister.
+func (c BaseController) Holders(store weave.ReadOnlyKVStore, ticker string, fn func(weave.Address, coin.Coin) error) error {
+	lister, ok := c.bucket.(HoldersLister)
+	if !ok {
+		return errors.Wrap(errors.ErrHuman, "wallet bucket does not support listing holders")
+	}
+	return lister.Holders(store, ticker, fn)
+}
+
 // MoveCoins moves the given amount from src to dest.
 // If src doesn't exist, or doesn't have sufficient
-// coins, it fails.
+// unlocked coins, or any of the transfer hooks rejects
+// the transfer, it fails.
 func (c BaseController) MoveCoins(store weave.KVStore,
 	src weave.Address, dest weave.Address, amount coin.Coin) error {
 
@@ -72,6 +119,11 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 	if !amount.IsPositive() {
 		return errors.Wrapf(errors.ErrAmount, "non-positive SendMsg: %#v", &amount)
 	}
+	for _, h := range c.hooks {
+		if err := h.BeforeTransfer(store, src, dest, amount); err != nil {
+			return err
+		}
+	}
 
 	// load sender, subtract funds, and save
 	sender, err := c.bucket.Get(store, src)
@@ -88,6 +140,9 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 	if err != nil {
 		return err
 	}
+	if err := requireUnlocked(store, src, AsCoins(sender), amount.Ticker); err != nil {
+		return err
+	}
 	err = c.bucket.Save(store, sender)
 	if err != nil {
 		return err
@@ -105,8 +160,14 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 	return c.bucket.Save(store, recipient)
 }
 
+// TotalSupply returns the total amount of coins with given ticker that exist.
+func (c BaseController) TotalSupply(store weave.ReadOnlyKVStore, ticker string) (coin.Coin, error) {
+	return TotalSupply(store, ticker)
+}
+
 // CoinMint attempts to add the given amount of coins to
 // the destination address. Fails if it overflows the wallet.
+// The total supply of the currency is updated accordingly.
 //
 // Note the amount may also be negative:
 // "the lord giveth and the lord taketh away"
@@ -121,6 +182,35 @@ func (c BaseController) CoinMint(store weave.KVStore,
 	if err != nil {
 		return err
 	}
+	if err := updateSupply(store, amount); err != nil {
+		return err
+	}
 
 	return c.bucket.Save(store, recipient)
 }
+
+// CoinBurn removes the given amount of coins from the source
+// address and decreases the total supply of the currency.
+// Fails if the source does not hold enough coins.
+func (c BaseController) CoinBurn(store weave.KVStore,
+	src weave.Address, amount coin.Coin) error {
+
+	if !amount.IsPositive() {
+		return errors.Wrapf(errors.ErrAmount, "non-positive amount: %#v", &amount)
+	}
+	balance, err := c.Balance(store, src)
+	if err != nil {
+		return err
+	}
+	if !balance.Contains(amount) {
+		return errors.Wrap(errors.ErrAmount, "funds")
+	}
+	remaining, err := balance.Subtract(amount)
+	if err != nil {
+		return err
+	}
+	if err := requireUnlocked(store, src, remaining, amount.Ticker); err != nil {
+		return err
+	}
+	return c.CoinMint(store, src, amount.Negative())
+}
@@ -299,3 +299,200 @@ func TestBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestHolders(t *testing.T) {
+	store := store.MemStore()
+	migration.MustInitPkg(store, "cash")
+
+	ctrl := NewController(NewBucket())
+
+	addr1 := weave.NewAddress([]byte("first"))
+	addr2 := weave.NewAddress([]byte("second"))
+	addr3 := weave.NewAddress([]byte("third"))
+	addr4 := weave.NewAddress([]byte("fourth"))
+	mint := []struct {
+		addr weave.Address
+		coin coin.Coin
+	}{
+		{addr1, coin.NewCoin(1, 20, "BTC")},
+		{addr2, coin.NewCoin(3, 40, "ETH")},
+		{addr2, coin.NewCoin(5, 0, "BTC")},
+		{addr3, coin.NewCoin(7, 0, "DOGE")},
+		{addr4, coin.NewCoin(2, 0, "BTC")},
+	}
+	for _, m := range mint {
+		if err := ctrl.CoinMint(store, m.addr, m.coin); err != nil {
+			t.Fatalf("cannot issue coins: %s", err)
+		}
+	}
+	// A wallet that spent all its coins is no longer a holder.
+	if err := ctrl.MoveCoins(store, addr4, addr3, coin.NewCoin(2, 0, "BTC")); err != nil {
+		t.Fatalf("cannot move coins: %s", err)
+	}
+
+	type holder struct {
+		address weave.Address
+		amount  coin.Coin
+	}
+	cases := map[string]struct {
+		ticker string
+		want   []holder
+	}{
+		"many holders": {
+			ticker: "BTC",
+			want: []holder{
+				{address: addr1, amount: coin.NewCoin(1, 20, "BTC")},
+				{address: addr2, amount: coin.NewCoin(5, 0, "BTC")},
+				{address: addr3, amount: coin.NewCoin(2, 0, "BTC")},
+			},
+		},
+		"single holder": {
+			ticker: "DOGE",
+			want: []holder{
+				{address: addr3, amount: coin.NewCoin(7, 0, "DOGE")},
+			},
+		},
+		"no holders": {
+			ticker: "IOV",
+			want:   nil,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var holders []holder
+			err := ctrl.Holders(store, tc.ticker, func(addr weave.Address, amount coin.Coin) error {
+				holders = append(holders, holder{address: addr, amount: amount})
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("cannot list holders: %s", err)
+			}
+			if len(holders) != len(tc.want) {
+				t.Fatalf("want %d holders, got %d", len(tc.want), len(holders))
+			}
+			want := make(map[string]coin.Coin)
+			for _, h := range tc.want {
+				want[h.address.String()] = h.amount
+			}
+			for _, h := range holders {
+				amount, ok := want[h.address.String()]
+				if !ok {
+					t.Fatalf("unexpected holder: %s", h.address)
+				}
+				if !amount.Equals(h.amount) {
+					t.Fatalf("want %s for %s, got %s", amount, h.address, h.amount)
+				}
+			}
+		})
+	}
+
+	// Iteration stops with the first error.
+	var calls int
+	err := ctrl.Holders(store, "BTC", func(weave.Address, coin.Coin) error {
+		calls++
+		return errors.ErrState
+	})
+	if !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %+v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("want one call, got %d", calls)
+	}
+}
+
+func TestSupply(t *testing.T) {
+	store := store.MemStore()
+	migration.MustInitPkg(store, "cash")
+
+	ctrl := NewController(NewBucket())
+
+	addr1 := weavetest.NewCondition().Address()
+	addr2 := weavetest.NewCondition().Address()
+
+	assertSupply := func(t testing.TB, want coin.Coin) {
+		t.Helper()
+		got, err := TotalSupply(store, want.Ticker)
+		if err != nil {
+			t.Fatalf("cannot get supply: %s", err)
+		}
+		if !want.Equals(got) {
+			t.Fatalf("want %v supply, got %v", want, got)
+		}
+	}
+
+	assertSupply(t, coin.NewCoin(0, 0, "BTC"))
+
+	if err := ctrl.CoinMint(store, addr1, coin.NewCoin(5, 0, "BTC")); err != nil {
+		t.Fatalf("cannot issue coins: %s", err)
+	}
+	if err := ctrl.CoinMint(store, addr2, coin.NewCoin(2, 500, "BTC")); err != nil {
+		t.Fatalf("cannot issue coins: %s", err)
+	}
+	assertSupply(t, coin.NewCoin(7, 500, "BTC"))
+
+	// Moving coins does not change the supply.
+	if err := ctrl.MoveCoins(store, addr1, addr2, coin.NewCoin(1, 0, "BTC")); err != nil {
+		t.Fatalf("cannot move coins: %s", err)
+	}
+	assertSupply(t, coin.NewCoin(7, 500, "BTC"))
+
+	if err := ctrl.CoinBurn(store, addr1, coin.NewCoin(5, 0, "BTC")); !errors.ErrAmount.Is(err) {
+		t.Fatalf("want insufficient funds error, got %+v", err)
+	}
+	if err := ctrl.CoinBurn(store, addr1, coin.NewCoin(0, 0, "BTC")); !errors.ErrAmount.Is(err) {
+		t.Fatalf("want zero amount error, got %+v", err)
+	}
+	if err := ctrl.CoinBurn(store, addr1, coin.NewCoin(4, 0, "BTC")); err != nil {
+		t.Fatalf("cannot burn coins: %s", err)
+	}
+	assertSupply(t, coin.NewCoin(3, 500, "BTC"))
+	assertSupply(t, coin.NewCoin(0, 0, "ETH"))
+}
+
+func TestTransferHooks(t *testing.T) {
+	store := store.MemStore()
+	migration.MustInitPkg(store, "cash")
+
+	addr1 := weavetest.NewCondition().Address()
+	addr2 := weavetest.NewCondition().Address()
+
+	var calls int
+	allow := transferHookFunc(func(weave.KVStore, weave.Address, weave.Address, coin.Coin) error {
+		calls++
+		return nil
+	})
+	deny := transferHookFunc(func(_ weave.KVStore, src, dest weave.Address, amount coin.Coin) error {
+		if amount.Ticker == "ETH" {
+			return errors.Wrap(errors.ErrState, "ETH transfers are frozen")
+		}
+		return nil
+	})
+	ctrl := NewController(NewBucket(), allow, deny)
+
+	if err := ctrl.CoinMint(store, addr1, coin.NewCoin(5, 0, "BTC")); err != nil {
+		t.Fatalf("cannot issue coins: %s", err)
+	}
+	if err := ctrl.CoinMint(store, addr1, coin.NewCoin(5, 0, "ETH")); err != nil {
+		t.Fatalf("cannot issue coins: %s", err)
+	}
+
+	if err := ctrl.MoveCoins(store, addr1, addr2, coin.NewCoin(1, 0, "BTC")); err != nil {
+		t.Fatalf("cannot move coins: %s", err)
+	}
+	if err := ctrl.MoveCoins(store, addr1, addr2, coin.NewCoin(1, 0, "ETH")); !errors.ErrState.Is(err) {
+		t.Fatalf("want hook error, got %+v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("want the hook to be called 2 times, got %d", calls)
+	}
+	if got := wallet(t, store, addr2); !got.Equals(mustCombineCoins(coin.NewCoin(1, 0, "BTC"))) {
+		t.Fatalf("unexpected recipient wallet: %v", got)
+	}
+}
+
+type transferHookFunc func(weave.KVStore, weave.Address, weave.Address, coin.Coin) error
+
+func (fn transferHookFunc) BeforeTransfer(db weave.KVStore, src, dest weave.Address, amount coin.Coin) error {
+	return fn(db, src, dest, amount)
+}
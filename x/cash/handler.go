@@ -2,9 +2,11 @@ package cash
 
 import (
 	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
 )
 
@@ -14,12 +16,24 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, control Controller)
 	r = migration.SchemaMigratingRegistry("cash", r)
 
 	r.Handle(&SendMsg{}, NewSendHandler(auth, control))
+	r.Handle(&MultiSendMsg{}, NewMultiSendHandler(auth, control))
 	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
+	r.Handle(&MigrateWalletsMsg{}, NewMigrateWalletsHandler(auth))
 }
 
-// RegisterQuery will register this bucket as "/wallets"
+// RegisterCronRoutes registers handlers for messages executed by the cron.
+func RegisterCronRoutes(r weave.Registry, scheduler weave.Scheduler) {
+	r = migration.SchemaMigratingRegistry("cash", r)
+
+	r.Handle(&UnlockVestingMsg{}, NewUnlockVestingHandler(scheduler))
+}
+
+// RegisterQuery will register this bucket as "/wallets", the supply
+// bucket as "/supply" and the vesting bucket as "/vesting"
 func RegisterQuery(qr weave.QueryRouter) {
 	NewBucket().Register("wallets", qr)
+	NewSupplyBucket().Register("supply", qr)
+	NewVestingBucket().Register("vesting", qr)
 }
 
 // SendHandler will handle sending coins
@@ -80,3 +94,213 @@ func NewConfigHandler(auth x.Authenticator) weave.Handler {
 	var conf Configuration
 	return gconf.NewUpdateConfigurationHandler("cash", &conf, auth)
 }
+
+// MultiSendHandler will handle sending coins from many sources to many
+// destinations.
+type MultiSendHandler struct {
+	auth    x.Authenticator
+	control Controller
+}
+
+var _ weave.Handler = MultiSendHandler{}
+
+// NewMultiSendHandler creates a handler for MultiSendMsg.
+func NewMultiSendHandler(auth x.Authenticator, control Controller) MultiSendHandler {
+	return MultiSendHandler{
+		auth:    auth,
+		control: control,
+	}
+}
+
+// Check verifies that all sources authorized the transfer and returns the
+// cost of executing it. The cost is the same as sending each output
+// separately.
+func (h MultiSendHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	msg, err := h.validate(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	res := weave.CheckResult{
+		GasAllocated: sendTxCost * int64(len(msg.Outputs)),
+	}
+	return &res, nil
+}
+
+// Deliver moves the tokens from all sources to all destinations.
+func (h MultiSendHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Coins are moved directly from inputs to outputs of the same
+	// currency, in the order of declaration. Because inputs and outputs
+	// balance, all of them are exhausted at the end.
+	inputs := make([]Input, len(msg.Inputs))
+	copy(inputs, msg.Inputs)
+	for _, out := range msg.Outputs {
+		remaining := out.Amount
+		for i := range inputs {
+			in := &inputs[i]
+			if !in.Amount.SameType(remaining) || !in.Amount.IsPositive() {
+				continue
+			}
+			amount := remaining
+			if !in.Amount.IsGTE(remaining) {
+				amount = in.Amount
+			}
+			if err := h.control.MoveCoins(store, in.Address, out.Address, amount); err != nil {
+				return nil, errors.Wrapf(err, "cannot move coins from %s", in.Address)
+			}
+			if in.Amount, err = in.Amount.Subtract(amount); err != nil {
+				return nil, err
+			}
+			if remaining, err = remaining.Subtract(amount); err != nil {
+				return nil, err
+			}
+			if remaining.IsZero() {
+				break
+			}
+		}
+		if !remaining.IsZero() {
+			return nil, errors.Wrap(errors.ErrAmount, "inputs do not balance outputs")
+		}
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h MultiSendHandler) validate(ctx weave.Context, tx weave.Tx) (*MultiSendMsg, error) {
+	var msg MultiSendMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	for _, in := range msg.Inputs {
+		if !h.auth.HasAddress(ctx, in.Address) {
+			return nil, errors.Wrapf(errors.ErrUnauthorized, "signature of %s missing", in.Address)
+		}
+	}
+	return &msg, nil
+}
+
+// UnlockVestingHandler releases coins of a vesting account that vested until
+// the current block time and schedules the next unlock.
+type UnlockVestingHandler struct {
+	scheduler weave.Scheduler
+	bucket    orm.ModelBucket
+}
+
+var _ weave.Handler = UnlockVestingHandler{}
+
+// NewUnlockVestingHandler creates a handler for UnlockVestingMsg.
+func NewUnlockVestingHandler(scheduler weave.Scheduler) UnlockVestingHandler {
+	return UnlockVestingHandler{
+		scheduler: scheduler,
+		bucket:    NewVestingBucket(),
+	}
+}
+
+// Check always fails, because this message can be executed only by the
+// cron.
+func (h UnlockVestingHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	return nil, errors.Wrap(errors.ErrHuman, "this message can be executed by the cron only")
+}
+
+// Deliver unlocks vested coins.
+func (h UnlockVestingHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	var msg UnlockVestingMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+
+	var v Vesting
+	if err := h.bucket.One(store, msg.Address, &v); err != nil {
+		return nil, errors.Wrap(err, "cannot load vesting")
+	}
+	if err := v.unlock(weave.AsUnixTime(now)); err != nil {
+		return nil, errors.Wrap(err, "cannot unlock")
+	}
+	if err := scheduleUnlock(store, h.scheduler, msg.Address, &v, weave.AsUnixTime(now)); err != nil {
+		return nil, err
+	}
+	if _, err := h.bucket.Put(store, msg.Address, &v); err != nil {
+		return nil, errors.Wrap(err, "cannot store vesting")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// MigrateWalletsHandler rebuilds the data derived from balances of all
+// wallets. Currency holders of wallets stored before holders were tracked
+// are marked and the total supply of every currency is computed from the
+// balances, because supply is tracked only for coins minted after the
+// upgrade.
+type MigrateWalletsHandler struct {
+	auth   x.Authenticator
+	bucket Bucket
+}
+
+var _ weave.Handler = MigrateWalletsHandler{}
+
+// NewMigrateWalletsHandler creates a handler for MigrateWalletsMsg.
+func NewMigrateWalletsHandler(auth x.Authenticator) MigrateWalletsHandler {
+	return MigrateWalletsHandler{
+		auth:   auth,
+		bucket: NewBucket(),
+	}
+}
+
+// Check only authorizes the migration.
+func (h MigrateWalletsHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver marks all wallets as holders of currencies they have and sets the
+// total supply of each currency to the sum of all balances. It can be
+// executed many times, because the result depends only on the current
+// balances.
+func (h MigrateWalletsHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	if err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	// All wallets are loaded before any write, because no writes may
+	// happen within the domain of an iterator.
+	models, err := h.bucket.Query(store, weave.PrefixQueryMod, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot query wallets")
+	}
+	var total coin.Coins
+	for _, m := range models {
+		key := m.Key[len(BucketName)+1:]
+		obj, err := h.bucket.Parse(key, m.Value)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse wallet")
+		}
+		if err := updateHolders(store, key, nil, AsCoins(obj)); err != nil {
+			return nil, err
+		}
+		if total, err = total.Combine(AsCoins(obj)); err != nil {
+			return nil, errors.Wrap(err, "total supply")
+		}
+	}
+	if err := setSupply(store, total); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h MigrateWalletsHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) error {
+	var msg MigrateWalletsMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, mustLoadConf(store).Owner) {
+		return errors.Wrap(errors.ErrUnauthorized, "configuration owner signature required")
+	}
+	return nil
+}
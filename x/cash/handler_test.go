@@ -6,10 +6,12 @@ import (
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
 )
 
 type checkErr func(error) bool
@@ -108,3 +110,171 @@ func TestSend(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiSend(t *testing.T) {
+	alice := weavetest.NewCondition()
+	bobby := weavetest.NewCondition()
+	carol := weavetest.NewCondition().Address()
+	dave := weavetest.NewCondition().Address()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	ctrl := NewController(NewBucket())
+	assert.Nil(t, ctrl.CoinMint(db, alice.Address(), coin.NewCoin(10, 0, "FOO")))
+	assert.Nil(t, ctrl.CoinMint(db, bobby.Address(), coin.NewCoin(5, 0, "FOO")))
+	assert.Nil(t, ctrl.CoinMint(db, bobby.Address(), coin.NewCoin(2, 0, "BAR")))
+
+	msg := &MultiSendMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Inputs: []Input{
+			{Address: alice.Address(), Amount: coin.NewCoin(8, 0, "FOO")},
+			{Address: bobby.Address(), Amount: coin.NewCoin(4, 0, "FOO")},
+			{Address: bobby.Address(), Amount: coin.NewCoin(2, 0, "BAR")},
+		},
+		Outputs: []Output{
+			{Address: carol, Amount: coin.NewCoin(3, 0, "FOO")},
+			{Address: dave, Amount: coin.NewCoin(9, 0, "FOO")},
+			{Address: carol, Amount: coin.NewCoin(2, 0, "BAR")},
+		},
+	}
+	tx := &weavetest.Tx{Msg: msg}
+
+	h := NewMultiSendHandler(&weavetest.Auth{Signer: alice}, ctrl)
+	if _, err := h.Check(nil, db, tx); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+
+	h = NewMultiSendHandler(&weavetest.Auth{Signers: []weave.Condition{alice, bobby}}, ctrl)
+	cres, err := h.Check(nil, db, tx)
+	assert.Nil(t, err)
+	assert.Equal(t, 3*sendTxCost, cres.GasAllocated)
+	_, err = h.Deliver(nil, db, tx)
+	assert.Nil(t, err)
+
+	balances := map[string]coin.Coins{
+		alice.Address().String(): mustCombineCoins(coin.NewCoin(2, 0, "FOO")),
+		bobby.Address().String(): mustCombineCoins(coin.NewCoin(1, 0, "FOO")),
+		carol.String():           mustCombineCoins(coin.NewCoin(3, 0, "FOO"), coin.NewCoin(2, 0, "BAR")),
+		dave.String():            mustCombineCoins(coin.NewCoin(9, 0, "FOO")),
+	}
+	for _, addr := range []weave.Address{alice.Address(), bobby.Address(), carol, dave} {
+		got, err := ctrl.Balance(db, addr)
+		assert.Nil(t, err)
+		if want := balances[addr.String()]; !want.Equals(got) {
+			t.Errorf("%s: want %v balance, got %v", addr, want, got)
+		}
+	}
+
+	// Not enough funds fails the operation.
+	if _, err := h.Deliver(nil, db, tx); !errors.ErrAmount.Is(err) {
+		t.Fatalf("want amount error, got %+v", err)
+	}
+}
+
+func TestMigrateWallets(t *testing.T) {
+	owner := weavetest.NewCondition()
+	alice := weavetest.NewCondition().Address()
+	bobby := weavetest.NewCondition().Address()
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+	conf := Configuration{
+		Metadata:         &weave.Metadata{Schema: 1},
+		Owner:            owner.Address(),
+		CollectorAddress: weavetest.NewCondition().Address(),
+	}
+	assert.Nil(t, gconf.Save(kv, "cash", &conf))
+
+	// Wallets stored before holders were tracked are not marked.
+	bucket := NewBucket()
+	for _, w := range []orm.Object{
+		must(WalletWith(alice, coin.NewCoinp(5, 0, "IOV"), coin.NewCoinp(1, 0, "ETH"))),
+		must(WalletWith(bobby, coin.NewCoinp(3, 0, "IOV"))),
+	} {
+		assert.Nil(t, bucket.Bucket.Save(kv, w))
+	}
+	holders := func(ticker string) []weave.Address {
+		t.Helper()
+		var addrs []weave.Address
+		err := bucket.Holders(kv, ticker, func(a weave.Address, _ coin.Coin) error {
+			addrs = append(addrs, a)
+			return nil
+		})
+		assert.Nil(t, err)
+		return addrs
+	}
+	assert.Equal(t, 0, len(holders("IOV")))
+
+	tx := &weavetest.Tx{Msg: &MigrateWalletsMsg{Metadata: &weave.Metadata{Schema: 1}}}
+
+	h := NewMigrateWalletsHandler(&weavetest.Auth{Signer: weavetest.NewCondition()})
+	if _, err := h.Check(nil, kv, tx); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected check error: %+v", err)
+	}
+	if _, err := h.Deliver(nil, kv, tx); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected deliver error: %+v", err)
+	}
+
+	h = NewMigrateWalletsHandler(&weavetest.Auth{Signer: owner})
+	if _, err := h.Check(nil, kv, tx); err != nil {
+		t.Fatalf("unexpected check error: %+v", err)
+	}
+	// Migration can be repeated with the same result.
+	for i := 0; i < 2; i++ {
+		if _, err := h.Deliver(nil, kv, tx); err != nil {
+			t.Fatalf("unexpected deliver error: %+v", err)
+		}
+		assert.Equal(t, 2, len(holders("IOV")))
+		assert.Equal(t, []weave.Address{alice}, holders("ETH"))
+	}
+}
+
+func TestMigrateWalletsSupply(t *testing.T) {
+	owner := weavetest.NewCondition()
+	alice := weavetest.NewCondition().Address()
+	bobby := weavetest.NewCondition().Address()
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+	conf := Configuration{
+		Metadata:         &weave.Metadata{Schema: 1},
+		Owner:            owner.Address(),
+		CollectorAddress: weavetest.NewCondition().Address(),
+	}
+	assert.Nil(t, gconf.Save(kv, "cash", &conf))
+
+	// Coins created before supply was tracked have no supply record.
+	bucket := NewBucket()
+	for _, w := range []orm.Object{
+		must(WalletWith(alice, coin.NewCoinp(5, 0, "IOV"), coin.NewCoinp(1, 0, "ETH"))),
+		must(WalletWith(bobby, coin.NewCoinp(3, 0, "IOV"))),
+	} {
+		assert.Nil(t, bucket.Save(kv, w))
+	}
+	ctrl := NewController(bucket)
+	if err := ctrl.CoinBurn(kv, alice, coin.NewCoin(2, 0, "IOV")); !errors.ErrAmount.Is(err) {
+		t.Fatalf("unexpected burn error before the migration: %+v", err)
+	}
+
+	tx := &weavetest.Tx{Msg: &MigrateWalletsMsg{Metadata: &weave.Metadata{Schema: 1}}}
+	h := NewMigrateWalletsHandler(&weavetest.Auth{Signer: owner})
+	// Migration can be repeated with the same result.
+	for i := 0; i < 2; i++ {
+		if _, err := h.Deliver(nil, kv, tx); err != nil {
+			t.Fatalf("unexpected deliver error: %+v", err)
+		}
+		total, err := TotalSupply(kv, "IOV")
+		assert.Nil(t, err)
+		assert.Equal(t, coin.NewCoin(8, 0, "IOV"), total)
+	}
+
+	if err := ctrl.CoinBurn(kv, alice, coin.NewCoin(2, 0, "IOV")); err != nil {
+		t.Fatalf("unexpected burn error: %+v", err)
+	}
+	total, err := TotalSupply(kv, "IOV")
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoin(6, 0, "IOV"), total)
+	total, err = TotalSupply(kv, "ETH")
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoin(1, 0, "ETH"), total)
+}
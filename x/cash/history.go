@@ -0,0 +1,72 @@
+package cash
+
+import (
+	"strings"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/store"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+// WalletHistoryTag is the name of the tag added to every transaction that
+// changes the balance of a wallet. The value of the tag is the address of the
+// wallet.
+//
+// Tags are not part of the consensus. They are indexed by a node only when
+// configured, for example by setting tx_index.index_tags to "cash.wallet" in
+// the tendermint configuration. An indexed history can be searched using a
+// query like "cash.wallet='<address>'".
+const WalletHistoryTag = "cash.wallet"
+
+// HistoryTagger is a decorator that records all wallets modified while
+// processing a transaction and adds their addresses as DeliverTx tags. This
+// includes transfers, minting and fee payments done by any handler using the
+// wallet bucket.
+type HistoryTagger struct{}
+
+var _ weave.Decorator = HistoryTagger{}
+
+// NewHistoryTagger creates a HistoryTagger decorator.
+func NewHistoryTagger() HistoryTagger {
+	return HistoryTagger{}
+}
+
+// Check does nothing.
+func (HistoryTagger) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	return next.Check(ctx, db, tx)
+}
+
+// Deliver passes in a recording KVStore into the child and uses it to find
+// all modified wallets.
+func (HistoryTagger) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	record := store.NewRecordingStore(db)
+	res, err := next.Deliver(ctx, record, tx)
+	if err != nil {
+		return nil, err
+	}
+	if r, ok := record.(store.Recorder); ok {
+		res.Tags = append(res.Tags, walletTags(r.KVPairs())...)
+	}
+	return res, nil
+}
+
+// walletPrefix is the key prefix of all wallets stored by the bucket
+// returned by NewBucket.
+const walletPrefix = "cash:"
+
+func walletTags(changes map[string][]byte) common.KVPairs {
+	var tags common.KVPairs
+	for k := range changes {
+		if !strings.HasPrefix(k, walletPrefix) {
+			continue
+		}
+		addr := weave.Address(k[len(walletPrefix):])
+		tags = append(tags, common.KVPair{
+			Key:   []byte(WalletHistoryTag),
+			Value: []byte(addr.String()),
+		})
+	}
+	// Map iteration order is random, but the result must be deterministic.
+	tags.Sort()
+	return tags
+}
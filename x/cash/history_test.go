@@ -0,0 +1,48 @@
+package cash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+func TestHistoryTagger(t *testing.T) {
+	sender := weavetest.NewCondition()
+	recipient := weavetest.NewCondition().Address()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	ctrl := NewController(NewBucket())
+	assert.Nil(t, ctrl.CoinMint(db, sender.Address(), coin.NewCoin(10, 0, "IOV")))
+
+	auth := &weavetest.Auth{Signer: sender}
+	handler := weavetest.Decorate(NewSendHandler(auth, ctrl), NewHistoryTagger())
+	tx := &weavetest.Tx{Msg: &SendMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Source:      sender.Address(),
+		Destination: recipient,
+		Amount:      coin.NewCoinp(1, 0, "IOV"),
+	}}
+	res, err := handler.Deliver(context.Background(), db, tx)
+	assert.Nil(t, err)
+
+	want := common.KVPairs{
+		{Key: []byte(WalletHistoryTag), Value: []byte(sender.Address().String())},
+		{Key: []byte(WalletHistoryTag), Value: []byte(recipient.String())},
+	}
+	want.Sort()
+	assert.Equal(t, want, common.KVPairs(res.Tags))
+
+	// Failed transaction does not produce tags.
+	tx.Msg.(*SendMsg).Amount = coin.NewCoinp(100, 0, "IOV")
+	if _, err := handler.Deliver(context.Background(), db, tx); err == nil {
+		t.Fatal("want an error")
+	}
+}
@@ -0,0 +1,83 @@
+package cash
+
+import (
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+)
+
+// holderPrefix prefixes keys that mark a wallet as a holder of a currency.
+// There is a separate key for each currency and wallet, so that a balance
+// change writes only the keys of the changed wallet.
+const holderPrefix = "cashholder:"
+
+// holdersPrefix returns the prefix of all holder keys of given currency.
+func holdersPrefix(ticker string) []byte {
+	return []byte(holderPrefix + ticker + ":")
+}
+
+// holderKey returns the key marking the wallet as a holder of given
+// currency.
+func holderKey(ticker string, addr weave.Address) []byte {
+	return append(holdersPrefix(ticker), addr...)
+}
+
+// updateHolders marks the wallet as a holder of all currencies it has a
+// positive amount of after the balance change. Marks of currencies that
+// the wallet no longer holds are removed.
+func updateHolders(db weave.KVStore, addr weave.Address, before, after coin.Coins) error {
+	held := heldTickers(before)
+	for ticker := range heldTickers(after) {
+		if _, ok := held[ticker]; ok {
+			delete(held, ticker)
+			continue
+		}
+		if err := db.Set(holderKey(ticker, addr), []byte{1}); err != nil {
+			return errors.Wrap(err, "cannot mark holder")
+		}
+	}
+	for ticker := range held {
+		if err := db.Delete(holderKey(ticker, addr)); err != nil {
+			return errors.Wrap(err, "cannot unmark holder")
+		}
+	}
+	return nil
+}
+
+// heldTickers returns tickers of all currencies with a positive amount.
+func heldTickers(cs coin.Coins) map[string]struct{} {
+	tickers := make(map[string]struct{}, len(cs))
+	for _, c := range cs {
+		if c.IsPositive() {
+			tickers[c.Ticker] = struct{}{}
+		}
+	}
+	return tickers
+}
+
+// holderAddresses returns addresses of all wallets marked as holders of given
+// currency, ordered by the address.
+func holderAddresses(db weave.ReadOnlyKVStore, ticker string) ([]weave.Address, error) {
+	prefix := holdersPrefix(ticker)
+	// Ticker cannot contain a colon, so the range ends right after the
+	// prefix separator.
+	end := append(holdersPrefix(ticker)[:len(prefix)-1], ':'+1)
+	it, err := db.Iterator(prefix, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create iterator")
+	}
+	defer it.Release()
+
+	var addrs []weave.Address
+	for {
+		switch key, _, err := it.Next(); {
+		case err == nil:
+			addr := append(weave.Address(nil), key[len(prefix):]...)
+			addrs = append(addrs, addr)
+		case errors.ErrIteratorDone.Is(err):
+			return addrs, nil
+		default:
+			return nil, errors.Wrap(err, "cannot get next item")
+		}
+	}
+}
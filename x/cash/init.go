@@ -2,6 +2,7 @@ package cash
 
 import (
 	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 )
@@ -11,17 +12,31 @@ import (
 type GenesisAccount struct {
 	Address weave.Address `json:"address"`
 	Set
+	Vesting *GenesisVesting `json:"vesting,omitempty"`
+}
+
+// GenesisVesting declares a vesting schedule of an account. Given amount must
+// be held by the account and is locked until it vests.
+type GenesisVesting struct {
+	StartTime weave.UnixTime     `json:"start_time"`
+	CliffTime weave.UnixTime     `json:"cliff_time"`
+	EndTime   weave.UnixTime     `json:"end_time"`
+	Period    weave.UnixDuration `json:"period"`
+	Amount    coin.Coins         `json:"amount"`
 }
 
 // Initializer fulfils the InitStater interface to load data from
-// the genesis file
-type Initializer struct{}
+// the genesis file. Scheduler is required only when vesting accounts
+// are declared.
+type Initializer struct {
+	Scheduler weave.Scheduler
+}
 
 var _ weave.Initializer = Initializer{}
 
 // FromGenesis will parse initial account info from genesis
 // and save it to the database
-func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+func (i Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
 	accts := []GenesisAccount{}
 	if err := opts.ReadOptions("cash", &accts); err != nil {
 		return errors.Wrap(err, "read cash attribute")
@@ -39,6 +54,16 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 		if err != nil {
 			return err
 		}
+		for _, c := range AsCoins(wallet) {
+			if err := updateSupply(kv, *c); err != nil {
+				return errors.Wrap(err, "supply")
+			}
+		}
+		if acct.Vesting != nil {
+			if err := initVesting(kv, i.Scheduler, acct.Address, AsCoins(wallet), acct.Vesting); err != nil {
+				return errors.Wrapf(err, "vesting of %s", acct.Address)
+			}
+		}
 	}
 
 	if err := gconf.InitConfig(kv, opts, "cash", &Configuration{}); err != nil {
@@ -47,3 +72,35 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 
 	return nil
 }
+
+func initVesting(db weave.KVStore, scheduler weave.Scheduler, addr weave.Address, balance coin.Coins, gv *GenesisVesting) error {
+	if scheduler == nil {
+		return errors.Wrap(errors.ErrHuman, "scheduler is required to initialize vesting")
+	}
+	amount, err := coin.NormalizeCoins(gv.Amount)
+	if err != nil {
+		return errors.Wrap(err, "amount")
+	}
+	for _, c := range amount {
+		if !balance.Contains(*c) {
+			return errors.Wrapf(errors.ErrAmount, "account does not hold %s", c)
+		}
+	}
+	v := &Vesting{
+		Metadata:  &weave.Metadata{Schema: 1},
+		StartTime: gv.StartTime,
+		CliffTime: gv.CliffTime,
+		EndTime:   gv.EndTime,
+		Period:    gv.Period,
+		Unvested:  amount,
+	}
+	// Scheduling the first unlock before the start time results in an
+	// unlock at the cliff time.
+	if err := scheduleUnlock(db, scheduler, addr, v, v.StartTime-1); err != nil {
+		return err
+	}
+	if _, err := NewVestingBucket().Put(db, addr, v); err != nil {
+		return errors.Wrap(err, "cannot store vesting")
+	}
+	return nil
+}
@@ -84,6 +84,12 @@ func TestInitState(t *testing.T) {
 				for i := range tc.wallet.Coins {
 					assert.Equal(t, tc.wallet.Coins[i], AsCoins(acct)[i])
 				}
+				// Genesis balances are the total supply.
+				for _, c := range tc.wallet.Coins {
+					supply, err := TotalSupply(kv, c.Ticker)
+					assert.Nil(t, err)
+					assert.Equal(t, *c, supply)
+				}
 			}
 		})
 	}
@@ -125,9 +125,8 @@ var _ WalletBucket = Bucket{}
 
 // NewBucket initializes a cash.Bucket with default name
 func NewBucket() Bucket {
-	return Bucket{
-		Bucket: migration.NewBucket("cash", BucketName, NewWallet(nil)),
-	}
+	b := migration.NewBucket("cash", BucketName, NewWallet(nil))
+	return Bucket{Bucket: b}
 }
 
 // GetOrCreate will return the object if found, or create one
@@ -140,6 +139,56 @@ func (b Bucket) GetOrCreate(db weave.KVStore, key weave.Address) (orm.Object, er
 	return obj, err
 }
 
+// Save stores the wallet and updates the list of holders of the currencies
+// which balance changed from or to zero.
+func (b Bucket) Save(db weave.KVStore, obj orm.Object) error {
+	old, err := b.Bucket.Get(db, obj.Key())
+	if err != nil {
+		return errors.Wrap(err, "cannot load wallet")
+	}
+	if err := b.Bucket.Save(db, obj); err != nil {
+		return err
+	}
+	return updateHolders(db, obj.Key(), AsCoins(old), AsCoins(obj))
+}
+
+// Delete removes the wallet and its holder marks.
+func (b Bucket) Delete(db weave.KVStore, key []byte) error {
+	old, err := b.Bucket.Get(db, key)
+	if err != nil {
+		return errors.Wrap(err, "cannot load wallet")
+	}
+	if err := b.Bucket.Delete(db, key); err != nil {
+		return err
+	}
+	return updateHolders(db, key, AsCoins(old), nil)
+}
+
+// Holders calls fn for every wallet holding a positive amount of coins with
+// given ticker, ordered by the wallet address. Only wallets holding the
+// currency are read. Iteration stops with the first error returned by fn.
+func (b Bucket) Holders(db weave.ReadOnlyKVStore, ticker string, fn func(weave.Address, coin.Coin) error) error {
+	addrs, err := holderAddresses(db, ticker)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		obj, err := b.Get(db, addr)
+		if err != nil {
+			return errors.Wrap(err, "cannot load wallet")
+		}
+		for _, c := range AsCoins(obj) {
+			if c.Ticker != ticker || !c.IsPositive() {
+				continue
+			}
+			if err := fn(addr, *c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // WalletBucket is what we expect to be able to do with wallets
 // The object it returns must support AsSet (only checked runtime :()
 type WalletBucket interface {
@@ -1,6 +1,8 @@
 package cash
 
 import (
+	"fmt"
+
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
@@ -9,7 +11,10 @@ import (
 
 func init() {
 	migration.MustRegister(1, &SendMsg{}, migration.NoModification)
+	migration.MustRegister(1, &MultiSendMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UnlockVestingMsg{}, migration.NoModification)
+	migration.MustRegister(1, &MigrateWalletsMsg{}, migration.NoModification)
 }
 
 const (
@@ -17,6 +22,10 @@ const (
 
 	maxMemoSize int = 128
 	maxRefSize  int = 64
+
+	// maxMultiSendItems is the highest number of inputs and outputs
+	// allowed in a single MultiSendMsg.
+	maxMultiSendItems int = 1000
 )
 
 var _ weave.Msg = (*SendMsg)(nil)
@@ -62,6 +71,94 @@ func (s *SendMsg) DefaultSource(addr []byte) *SendMsg {
 	}
 }
 
+var _ weave.Msg = (*MultiSendMsg)(nil)
+
+// Path returns the routing path for this message.
+func (MultiSendMsg) Path() string {
+	return "cash/multisend"
+}
+
+// Validate makes sure that this is sensible. Inputs and outputs must balance
+// for each currency.
+func (m *MultiSendMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	switch n := len(m.Inputs); {
+	case n == 0:
+		errs = errors.AppendField(errs, "Inputs", errors.ErrEmpty)
+	case n > maxMultiSendItems:
+		errs = errors.AppendField(errs, "Inputs", errors.Wrapf(errors.ErrInput, "more than %d", maxMultiSendItems))
+	}
+	switch n := len(m.Outputs); {
+	case n == 0:
+		errs = errors.AppendField(errs, "Outputs", errors.ErrEmpty)
+	case n > maxMultiSendItems:
+		errs = errors.AppendField(errs, "Outputs", errors.Wrapf(errors.ErrInput, "more than %d", maxMultiSendItems))
+	}
+	if len(m.Memo) > maxMemoSize {
+		errs = errors.AppendField(errs, "Memo", errors.Wrap(errors.ErrState, "memo too long"))
+	}
+	if len(m.Ref) > maxRefSize {
+		errs = errors.AppendField(errs, "Ref", errors.Wrap(errors.ErrState, "ref too long"))
+	}
+	if errs != nil {
+		return errs
+	}
+
+	var in, out coin.Coins
+	for i, input := range m.Inputs {
+		field := fmt.Sprintf("Inputs.%d", i)
+		if err := input.Address.Validate(); err != nil {
+			errs = errors.AppendField(errs, field+".Address", err)
+		}
+		if err := validatePositive(input.Amount); err != nil {
+			errs = errors.AppendField(errs, field+".Amount", err)
+			continue
+		}
+		var err error
+		if in, err = in.Add(input.Amount); err != nil {
+			errs = errors.AppendField(errs, field+".Amount", err)
+		}
+	}
+	for i, output := range m.Outputs {
+		field := fmt.Sprintf("Outputs.%d", i)
+		if err := output.Address.Validate(); err != nil {
+			errs = errors.AppendField(errs, field+".Address", err)
+		}
+		if err := validatePositive(output.Amount); err != nil {
+			errs = errors.AppendField(errs, field+".Amount", err)
+			continue
+		}
+		var err error
+		if out, err = out.Add(output.Amount); err != nil {
+			errs = errors.AppendField(errs, field+".Amount", err)
+		}
+	}
+	if errs != nil {
+		return errs
+	}
+	if !in.Equals(out) {
+		return errors.Wrapf(errors.ErrAmount, "inputs %v do not balance outputs %v", in, out)
+	}
+	return nil
+}
+
+// FeeUnits returns the number of outputs. Message fee is charged for each
+// output, the same as for a SendMsg.
+func (m *MultiSendMsg) FeeUnits() int64 {
+	return int64(len(m.Outputs))
+}
+
+func validatePositive(c coin.Coin) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	if !c.IsPositive() {
+		return errors.Wrap(errors.ErrAmount, "must be greater than zero")
+	}
+	return nil
+}
+
 // FeeTx exposes information about the fees that should be paid.
 type FeeTx interface {
 	GetFees() *FeeInfo
@@ -128,3 +225,30 @@ func (m *UpdateConfigurationMsg) Validate() error {
 func (*UpdateConfigurationMsg) Path() string {
 	return "cash/update_configuration"
 }
+
+var _ weave.Msg = (*UnlockVestingMsg)(nil)
+
+// Path returns the routing path for this message.
+func (UnlockVestingMsg) Path() string {
+	return "cash/unlock_vesting"
+}
+
+// Validate makes sure that this is sensible.
+func (m *UnlockVestingMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+	return errs
+}
+
+var _ weave.Msg = (*MigrateWalletsMsg)(nil)
+
+// Path returns the routing path for this message.
+func (MigrateWalletsMsg) Path() string {
+	return "cash/migrate_wallets"
+}
+
+// Validate makes sure that this is sensible.
+func (m *MigrateWalletsMsg) Validate() error {
+	return errors.Wrap(m.Metadata.Validate(), "metadata")
+}
@@ -91,6 +91,90 @@ func TestValidateSendMsg(t *testing.T) {
 	}
 }
 
+func TestValidateMultiSendMsg(t *testing.T) {
+	addr1 := weavetest.NewCondition().Address()
+	addr2 := weavetest.NewCondition().Address()
+	addr3 := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		msg     weave.Msg
+		wantErr *errors.Error
+	}{
+		"success": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Inputs: []Input{
+					{Address: addr1, Amount: coin.NewCoin(10, 0, "FOO")},
+					{Address: addr1, Amount: coin.NewCoin(1, 0, "BAR")},
+				},
+				Outputs: []Output{
+					{Address: addr2, Amount: coin.NewCoin(7, 0, "FOO")},
+					{Address: addr3, Amount: coin.NewCoin(3, 0, "FOO")},
+					{Address: addr3, Amount: coin.NewCoin(1, 0, "BAR")},
+				},
+			},
+			wantErr: nil,
+		},
+		"missing metadata": {
+			msg: &MultiSendMsg{
+				Inputs:  []Input{{Address: addr1, Amount: coin.NewCoin(1, 0, "FOO")}},
+				Outputs: []Output{{Address: addr2, Amount: coin.NewCoin(1, 0, "FOO")}},
+			},
+			wantErr: errors.ErrMetadata,
+		},
+		"no outputs": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Inputs:   []Input{{Address: addr1, Amount: coin.NewCoin(1, 0, "FOO")}},
+			},
+			wantErr: errors.ErrEmpty,
+		},
+		"unbalanced": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Inputs:   []Input{{Address: addr1, Amount: coin.NewCoin(2, 0, "FOO")}},
+				Outputs:  []Output{{Address: addr2, Amount: coin.NewCoin(1, 0, "FOO")}},
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"different currencies": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Inputs:   []Input{{Address: addr1, Amount: coin.NewCoin(1, 0, "FOO")}},
+				Outputs:  []Output{{Address: addr2, Amount: coin.NewCoin(1, 0, "BAR")}},
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"zero output": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Inputs:   []Input{{Address: addr1, Amount: coin.NewCoin(1, 0, "FOO")}},
+				Outputs: []Output{
+					{Address: addr2, Amount: coin.NewCoin(1, 0, "FOO")},
+					{Address: addr3, Amount: coin.NewCoin(0, 0, "FOO")},
+				},
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"invalid address": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Inputs:   []Input{{Address: addr1, Amount: coin.NewCoin(1, 0, "FOO")}},
+				Outputs:  []Output{{Address: []byte("foo"), Amount: coin.NewCoin(1, 0, "FOO")}},
+			},
+			wantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.msg.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
 func TestValidateFeeTx(t *testing.T) {
 	addr1 := weavetest.NewCondition().Address()
 
@@ -0,0 +1,97 @@
+package cash
+
+import (
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+)
+
+func init() {
+	migration.MustRegister(1, &Supply{}, migration.NoModification)
+}
+
+var _ orm.CloneableData = (*Supply)(nil)
+
+// Validate ensures the supply is valid.
+func (s *Supply) Validate() error {
+	if err := s.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if err := s.Total.Validate(); err != nil {
+		return errors.Wrap(err, "total")
+	}
+	if !s.Total.IsNonNegative() {
+		return errors.Wrap(errors.ErrState, "negative total")
+	}
+	return nil
+}
+
+// Copy makes a new supply with the same total.
+func (s *Supply) Copy() orm.CloneableData {
+	return &Supply{
+		Metadata: s.Metadata.Copy(),
+		Total:    *s.Total.Clone(),
+	}
+}
+
+// NewSupplyBucket returns a bucket for storing the total supply of each
+// currency. Supply is stored under the currency ticker.
+func NewSupplyBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("supply", &Supply{})
+	return migration.NewModelBucket("cash", b)
+}
+
+// TotalSupply returns the total amount of coins with given ticker that exist
+// on the chain. Zero is returned for a currency that was never minted.
+func TotalSupply(db weave.ReadOnlyKVStore, ticker string) (coin.Coin, error) {
+	var s Supply
+	switch err := NewSupplyBucket().One(db, []byte(ticker), &s); {
+	case err == nil:
+		return s.Total, nil
+	case errors.ErrNotFound.Is(err):
+		return coin.Coin{Ticker: ticker}, nil
+	default:
+		return coin.Coin{}, errors.Wrap(err, "cannot load supply")
+	}
+}
+
+// updateSupply adds given amount to the total supply of its currency. The
+// amount is negative when coins are burned.
+func updateSupply(db weave.KVStore, amount coin.Coin) error {
+	total, err := TotalSupply(db, amount.Ticker)
+	if err != nil {
+		return err
+	}
+	total, err = total.Add(amount)
+	if err != nil {
+		return errors.Wrap(err, "total supply")
+	}
+	if !total.IsNonNegative() {
+		return errors.Wrapf(errors.ErrAmount, "cannot burn more than the total supply of %s", amount.Ticker)
+	}
+	s := &Supply{
+		Metadata: &weave.Metadata{Schema: 1},
+		Total:    total,
+	}
+	if _, err := NewSupplyBucket().Put(db, []byte(amount.Ticker), s); err != nil {
+		return errors.Wrap(err, "cannot store supply")
+	}
+	return nil
+}
+
+// setSupply replaces the total supply of each given currency.
+func setSupply(db weave.KVStore, total coin.Coins) error {
+	bucket := NewSupplyBucket()
+	for _, c := range total {
+		s := &Supply{
+			Metadata: &weave.Metadata{Schema: 1},
+			Total:    *c,
+		}
+		if _, err := bucket.Put(db, []byte(c.Ticker), s); err != nil {
+			return errors.Wrap(err, "cannot store supply")
+		}
+	}
+	return nil
+}
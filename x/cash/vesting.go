@@ -0,0 +1,213 @@
+package cash
+
+import (
+	"math/big"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+)
+
+func init() {
+	migration.MustRegister(1, &Vesting{}, migration.NoModification)
+}
+
+var _ orm.CloneableData = (*Vesting)(nil)
+
+// MinVestingPeriod is the shortest allowed period of a vesting schedule.
+// Each period unlock is executed by the cron, so a linear schedule is
+// approximated by periods not shorter than an hour.
+const MinVestingPeriod weave.UnixDuration = 3600
+
+// Validate ensures the vesting schedule is valid.
+func (v *Vesting) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", v.Metadata.Validate())
+	errs = errors.AppendField(errs, "StartTime", v.StartTime.Validate())
+	errs = errors.AppendField(errs, "CliffTime", v.CliffTime.Validate())
+	errs = errors.AppendField(errs, "EndTime", v.EndTime.Validate())
+	if v.EndTime <= v.StartTime {
+		errs = errors.AppendField(errs, "EndTime", errors.Wrap(errors.ErrInput, "must be after the start time"))
+	}
+	if v.CliffTime < v.StartTime || v.CliffTime > v.EndTime {
+		errs = errors.AppendField(errs, "CliffTime", errors.Wrap(errors.ErrInput, "must be between the start and the end time"))
+	}
+	if v.Period < MinVestingPeriod {
+		errs = errors.AppendField(errs, "Period", errors.Wrapf(errors.ErrInput, "must be at least %d seconds", MinVestingPeriod))
+	}
+	errs = errors.AppendField(errs, "Vested", validateNonNegative(v.Vested))
+	errs = errors.AppendField(errs, "Unvested", validateNonNegative(v.Unvested))
+	return errs
+}
+
+func validateNonNegative(cs coin.Coins) error {
+	if err := cs.Validate(); err != nil {
+		return err
+	}
+	if !cs.IsNonNegative() {
+		return errors.Wrap(errors.ErrAmount, "negative amount")
+	}
+	return nil
+}
+
+// Copy makes a new vesting schedule with the same data.
+func (v *Vesting) Copy() orm.CloneableData {
+	return &Vesting{
+		Metadata:  v.Metadata.Copy(),
+		StartTime: v.StartTime,
+		CliffTime: v.CliffTime,
+		EndTime:   v.EndTime,
+		Period:    v.Period,
+		Vested:    coin.Coins(v.Vested).Clone(),
+		Unvested:  coin.Coins(v.Unvested).Clone(),
+		TaskID:    copyBytes(v.TaskID),
+	}
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	cpy := make([]byte, len(b))
+	copy(cpy, b)
+	return cpy
+}
+
+// unlock recalculates vested and unvested amounts for given time.
+func (v *Vesting) unlock(now weave.UnixTime) error {
+	total, err := coin.Coins(v.Vested).Combine(v.Unvested)
+	if err != nil {
+		return errors.Wrap(err, "total")
+	}
+
+	var vested, unvested coin.Coins
+	for _, c := range total {
+		vc := v.vestedAt(*c, now)
+		if vested, err = vested.Add(vc); err != nil {
+			return errors.Wrap(err, "vested")
+		}
+		uc, err := c.Subtract(vc)
+		if err != nil {
+			return errors.Wrap(err, "unvested")
+		}
+		if unvested, err = unvested.Add(uc); err != nil {
+			return errors.Wrap(err, "unvested")
+		}
+	}
+	v.Vested = vested
+	v.Unvested = unvested
+	return nil
+}
+
+// vestedAt returns the portion of given total amount that is unlocked at
+// given time.
+func (v *Vesting) vestedAt(total coin.Coin, now weave.UnixTime) coin.Coin {
+	switch {
+	case now < v.CliffTime:
+		return coin.Coin{Ticker: total.Ticker}
+	case now >= v.EndTime:
+		return total
+	}
+
+	period := int64(v.Period)
+	elapsed := (int64(now-v.StartTime) / period) * period
+	duration := int64(v.EndTime - v.StartTime)
+
+	// Use big numbers because the amount multiplied by the elapsed time
+	// can overflow int64.
+	units := big.NewInt(total.Whole)
+	units.Mul(units, big.NewInt(coin.FracUnit))
+	units.Add(units, big.NewInt(total.Fractional))
+	units.Mul(units, big.NewInt(elapsed))
+	units.Quo(units, big.NewInt(duration))
+
+	whole, frac := new(big.Int).QuoRem(units, big.NewInt(coin.FracUnit), new(big.Int))
+	return coin.NewCoin(whole.Int64(), frac.Int64(), total.Ticker)
+}
+
+// nextUnlock returns the time when the next portion of coins is unlocked
+// after given time. False is returned if everything is unlocked.
+func (v *Vesting) nextUnlock(now weave.UnixTime) (weave.UnixTime, bool) {
+	switch {
+	case now >= v.EndTime:
+		return 0, false
+	case now < v.CliffTime:
+		return v.CliffTime, true
+	}
+	period := weave.UnixTime(v.Period)
+	next := v.StartTime + ((now-v.StartTime)/period+1)*period
+	if next > v.EndTime {
+		next = v.EndTime
+	}
+	return next, true
+}
+
+// NewVestingBucket returns a bucket for storing vesting schedules. Each
+// schedule is stored under the address of the account it locks.
+func NewVestingBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("vesting", &Vesting{})
+	return migration.NewModelBucket("cash", b)
+}
+
+// lockedAmount returns the amount of coins with given ticker that cannot be
+// moved out of the account.
+func lockedAmount(db weave.ReadOnlyKVStore, addr weave.Address, ticker string) (coin.Coin, error) {
+	var v Vesting
+	switch err := NewVestingBucket().One(db, addr, &v); {
+	case err == nil:
+		for _, c := range v.Unvested {
+			if c.Ticker == ticker {
+				return *c, nil
+			}
+		}
+		return coin.Coin{Ticker: ticker}, nil
+	case errors.ErrNotFound.Is(err):
+		return coin.Coin{Ticker: ticker}, nil
+	default:
+		return coin.Coin{}, errors.Wrap(err, "cannot load vesting")
+	}
+}
+
+// requireUnlocked returns an error if the account after the balance change
+// would hold less than its locked amount of the given currency.
+func requireUnlocked(db weave.ReadOnlyKVStore, addr weave.Address, balance coin.Coins, ticker string) error {
+	locked, err := lockedAmount(db, addr, ticker)
+	if err != nil {
+		return err
+	}
+	if locked.IsZero() {
+		return nil
+	}
+	available := coin.Coin{Ticker: ticker}
+	for _, c := range balance {
+		if c.Ticker == ticker {
+			available = *c
+		}
+	}
+	if !available.IsGTE(locked) {
+		return errors.Wrapf(errors.ErrAmount, "%s is locked by the vesting schedule", locked)
+	}
+	return nil
+}
+
+// scheduleUnlock queues the next unlock of the vesting schedule. Nothing is
+// scheduled if everything is already unlocked.
+func scheduleUnlock(db weave.KVStore, scheduler weave.Scheduler, addr weave.Address, v *Vesting, now weave.UnixTime) error {
+	v.TaskID = nil
+	next, ok := v.nextUnlock(now)
+	if !ok {
+		return nil
+	}
+	msg := &UnlockVestingMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  addr,
+	}
+	taskID, err := scheduler.Schedule(db, next.Time(), nil, msg)
+	if err != nil {
+		return errors.Wrap(err, "cannot schedule unlock")
+	}
+	v.TaskID = taskID
+	return nil
+}
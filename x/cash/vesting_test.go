@@ -0,0 +1,143 @@
+package cash
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestVesting(t *testing.T) {
+	const hour = weave.UnixTime(MinVestingPeriod)
+	owner := weavetest.NewCondition().Address()
+	other := weavetest.NewCondition().Address()
+
+	genesis := []GenesisAccount{
+		{
+			Address: owner,
+			Set:     Set{Coins: mustCombineCoins(coin.NewCoin(150, 0, "IOV"))},
+			Vesting: &GenesisVesting{
+				StartTime: 1000,
+				CliffTime: 1000 + hour,
+				EndTime:   1000 + 4*hour,
+				Period:    MinVestingPeriod,
+				Amount:    mustCombineCoins(coin.NewCoin(100, 0, "IOV")),
+			},
+		},
+	}
+	raw, err := json.Marshal(genesis)
+	assert.Nil(t, err)
+	conf, err := json.Marshal(map[string]interface{}{
+		"cash": Configuration{
+			CollectorAddress: weave.NewAddress([]byte("foo")),
+			MinimalFee:       coin.NewCoin(0, 20, "IOV"),
+		},
+	})
+	assert.Nil(t, err)
+	opts := weave.Options{"cash": raw, "conf": conf}
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+
+	if err := (Initializer{}).FromGenesis(opts, weave.GenesisParams{}, db.CacheWrap()); !errors.ErrHuman.Is(err) {
+		t.Fatalf("want missing scheduler error, got %+v", err)
+	}
+	cron := &weavetest.Cron{}
+	assert.Nil(t, Initializer{Scheduler: cron}.FromGenesis(opts, weave.GenesisParams{}, db))
+
+	ctrl := NewController(NewBucket())
+	handler := NewUnlockVestingHandler(cron)
+	unlock := func(now weave.UnixTime) *Vesting {
+		t.Helper()
+		ctx := weave.WithBlockTime(context.Background(), now.Time())
+		tx := &weavetest.Tx{Msg: &UnlockVestingMsg{Metadata: &weave.Metadata{Schema: 1}, Address: owner}}
+		if _, err := handler.Check(ctx, db, tx); !errors.ErrHuman.Is(err) {
+			t.Fatalf("want cron only error, got %+v", err)
+		}
+		if _, err := handler.Deliver(ctx, db, tx); err != nil {
+			t.Fatalf("cannot unlock: %s", err)
+		}
+		var v Vesting
+		assert.Nil(t, NewVestingBucket().One(db, owner, &v))
+		return &v
+	}
+
+	if err := ctrl.MoveCoins(db, owner, other, coin.NewCoin(60, 0, "IOV")); !errors.ErrAmount.Is(err) {
+		t.Fatalf("want locked error, got %+v", err)
+	}
+	assert.Nil(t, ctrl.MoveCoins(db, owner, other, coin.NewCoin(50, 0, "IOV")))
+	if err := ctrl.CoinBurn(db, owner, coin.NewCoin(1, 0, "IOV")); !errors.ErrAmount.Is(err) {
+		t.Fatalf("want locked error, got %+v", err)
+	}
+
+	// Nothing is unlocked before the cliff.
+	v := unlock(1000 + hour/2)
+	assert.Equal(t, 0, len(v.Vested))
+	assert.Equal(t, true, coin.Coins(v.Unvested).Equals(mustCombineCoins(coin.NewCoin(100, 0, "IOV"))))
+	if len(v.TaskID) == 0 {
+		t.Fatal("next unlock not scheduled")
+	}
+
+	// Coins vested until the last full period are unlocked.
+	v = unlock(1000 + 5*hour/2)
+	assert.Equal(t, true, coin.Coins(v.Vested).Equals(mustCombineCoins(coin.NewCoin(50, 0, "IOV"))))
+	assert.Equal(t, true, coin.Coins(v.Unvested).Equals(mustCombineCoins(coin.NewCoin(50, 0, "IOV"))))
+	assert.Nil(t, ctrl.MoveCoins(db, owner, other, coin.NewCoin(50, 0, "IOV")))
+	if err := ctrl.MoveCoins(db, owner, other, coin.NewCoin(0, 1, "IOV")); !errors.ErrAmount.Is(err) {
+		t.Fatalf("want locked error, got %+v", err)
+	}
+
+	// Everything is unlocked at the end.
+	v = unlock(1000 + 4*hour)
+	assert.Equal(t, true, coin.Coins(v.Vested).Equals(mustCombineCoins(coin.NewCoin(100, 0, "IOV"))))
+	assert.Equal(t, 0, len(v.Unvested))
+	assert.Equal(t, 0, len(v.TaskID))
+	assert.Nil(t, ctrl.MoveCoins(db, owner, other, coin.NewCoin(50, 0, "IOV")))
+}
+
+func TestVestingValidate(t *testing.T) {
+	v := Vesting{
+		Metadata:  &weave.Metadata{Schema: 1},
+		StartTime: 1000,
+		CliffTime: 1000,
+		EndTime:   1000 + 10*weave.UnixTime(MinVestingPeriod),
+		Period:    MinVestingPeriod,
+		Unvested:  mustCombineCoins(coin.NewCoin(100, 0, "IOV")),
+	}
+	assert.Nil(t, v.Validate())
+
+	v.Period = MinVestingPeriod - 1
+	assert.FieldError(t, v.Validate(), "Period", errors.ErrInput)
+
+	v.Period = 0
+	assert.FieldError(t, v.Validate(), "Period", errors.ErrInput)
+}
+
+func TestVestedAt(t *testing.T) {
+	v := Vesting{
+		StartTime: 0,
+		CliffTime: 10,
+		EndTime:   30,
+		Period:    1,
+	}
+	total := coin.NewCoin(coin.MaxInt, 0, "IOV")
+
+	cases := map[weave.UnixTime]coin.Coin{
+		9:  coin.NewCoin(0, 0, "IOV"),
+		10: coin.NewCoin(333333333333333, 0, "IOV"),
+		11: coin.NewCoin(366666666666666, 300000000, "IOV"),
+		30: total,
+	}
+	for now, want := range cases {
+		if got := v.vestedAt(total, now); !want.Equals(got) {
+			t.Errorf("at %d want %s, got %s", now, want, got)
+		}
+	}
+}
@@ -5,8 +5,11 @@ package currency
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_iov_one_weave "github.com/iov-one/weave"
 	weave "github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	io "io"
 	math "math"
 )
@@ -27,6 +30,24 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type TokenInfo struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Name     string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Mint authority is an optional address that is allowed to mint and burn
+	// tokens of this currency. If not set, the supply of the currency can be
+	// defined only in the genesis.
+	MintAuthority github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=mint_authority,json=mintAuthority,proto3,casttype=github.com/iov-one/weave.Address" json:"mint_authority,omitempty"`
+	// Owner is an optional address that is allowed to update the token
+	// information. If not set, token information cannot be changed.
+	Owner github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	// Decimals is the number of fractional digits that should be used when
+	// displaying an amount of this currency. It must not be greater than 9.
+	// This value is used only for presentation and does not limit the
+	// precision of the stored amounts.
+	Decimals    int32  `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// Icon URI is an optional location of an image representing the currency.
+	IconURI string `protobuf:"bytes,7,opt,name=icon_uri,json=iconUri,proto3" json:"icon_uri,omitempty"`
+	// Max supply is an optional limit of the total amount of tokens that can be
+	// minted.
+	MaxSupply *coin.Coin `protobuf:"bytes,8,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
 }
 
 func (m *TokenInfo) Reset()         { *m = TokenInfo{} }
@@ -76,12 +97,60 @@ func (m *TokenInfo) GetName() string {
 	return ""
 }
 
+func (m *TokenInfo) GetMintAuthority() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.MintAuthority
+	}
+	return nil
+}
+
+func (m *TokenInfo) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *TokenInfo) GetDecimals() int32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *TokenInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetIconURI() string {
+	if m != nil {
+		return m.IconURI
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetMaxSupply() *coin.Coin {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return nil
+}
+
 // CreateMsg will register a new currency. Ticker (currency symbol) can
 // be registered only once.
 type CreateMsg struct {
-	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Ticker   string          `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
-	Name     string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Metadata      *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Ticker        string                           `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	Name          string                           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MintAuthority github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=mint_authority,json=mintAuthority,proto3,casttype=github.com/iov-one/weave.Address" json:"mint_authority,omitempty"`
+	Owner         github_com_iov_one_weave.Address `protobuf:"bytes,5,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	Decimals      int32                            `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Description   string                           `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	IconURI       string                           `protobuf:"bytes,8,opt,name=icon_uri,json=iconUri,proto3" json:"icon_uri,omitempty"`
+	MaxSupply     *coin.Coin                       `protobuf:"bytes,9,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
 }
 
 func (m *CreateMsg) Reset()         { *m = CreateMsg{} }
@@ -138,27 +207,294 @@ func (m *CreateMsg) GetName() string {
 	return ""
 }
 
+func (m *CreateMsg) GetMintAuthority() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.MintAuthority
+	}
+	return nil
+}
+
+func (m *CreateMsg) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *CreateMsg) GetDecimals() int32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *CreateMsg) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateMsg) GetIconURI() string {
+	if m != nil {
+		return m.IconURI
+	}
+	return ""
+}
+
+func (m *CreateMsg) GetMaxSupply() *coin.Coin {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return nil
+}
+
+// UpdateMsg replaces the description of an existing currency. It must be
+// signed by the owner of the currency. Name, mint authority and owner cannot
+// be changed.
+type UpdateMsg struct {
+	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Ticker      string          `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	Decimals    int32           `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Description string          `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IconURI     string          `protobuf:"bytes,5,opt,name=icon_uri,json=iconUri,proto3" json:"icon_uri,omitempty"`
+	MaxSupply   *coin.Coin      `protobuf:"bytes,6,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+}
+
+func (m *UpdateMsg) Reset()         { *m = UpdateMsg{} }
+func (m *UpdateMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateMsg) ProtoMessage()    {}
+func (*UpdateMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_540c9a7fd55dd714, []int{2}
+}
+func (m *UpdateMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateMsg.Merge(m, src)
+}
+func (m *UpdateMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateMsg proto.InternalMessageInfo
+
+func (m *UpdateMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateMsg) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
+}
+
+func (m *UpdateMsg) GetDecimals() int32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *UpdateMsg) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateMsg) GetIconURI() string {
+	if m != nil {
+		return m.IconURI
+	}
+	return ""
+}
+
+func (m *UpdateMsg) GetMaxSupply() *coin.Coin {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return nil
+}
+
+// MintMsg creates new tokens and sends them to the destination. It must be
+// signed by the mint authority of the currency.
+type MintMsg struct {
+	Metadata    *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Destination github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=destination,proto3,casttype=github.com/iov-one/weave.Address" json:"destination,omitempty"`
+	Amount      *coin.Coin                       `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MintMsg) Reset()         { *m = MintMsg{} }
+func (m *MintMsg) String() string { return proto.CompactTextString(m) }
+func (*MintMsg) ProtoMessage()    {}
+func (*MintMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_540c9a7fd55dd714, []int{3}
+}
+func (m *MintMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintMsg.Merge(m, src)
+}
+func (m *MintMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintMsg proto.InternalMessageInfo
+
+func (m *MintMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *MintMsg) GetDestination() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (m *MintMsg) GetAmount() *coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// BurnMsg destroys tokens held by the mint authority of the currency. It must
+// be signed by the mint authority.
+type BurnMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Amount   *coin.Coin      `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *BurnMsg) Reset()         { *m = BurnMsg{} }
+func (m *BurnMsg) String() string { return proto.CompactTextString(m) }
+func (*BurnMsg) ProtoMessage()    {}
+func (*BurnMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_540c9a7fd55dd714, []int{4}
+}
+func (m *BurnMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnMsg.Merge(m, src)
+}
+func (m *BurnMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnMsg proto.InternalMessageInfo
+
+func (m *BurnMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *BurnMsg) GetAmount() *coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TokenInfo)(nil), "currency.TokenInfo")
 	proto.RegisterType((*CreateMsg)(nil), "currency.CreateMsg")
+	proto.RegisterType((*UpdateMsg)(nil), "currency.UpdateMsg")
+	proto.RegisterType((*MintMsg)(nil), "currency.MintMsg")
+	proto.RegisterType((*BurnMsg)(nil), "currency.BurnMsg")
 }
 
 func init() { proto.RegisterFile("x/currency/codec.proto", fileDescriptor_540c9a7fd55dd714) }
 
 var fileDescriptor_540c9a7fd55dd714 = []byte{
-	// 185 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xab, 0xd0, 0x4f, 0x2e,
-	0x2d, 0x2a, 0x4a, 0xcd, 0x4b, 0xae, 0xd4, 0x4f, 0xce, 0x4f, 0x49, 0x4d, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0x80, 0x89, 0x4a, 0x71, 0x23, 0x09, 0x2b, 0xf9, 0x70, 0x71, 0x86, 0xe4,
-	0x67, 0xa7, 0xe6, 0x79, 0xe6, 0xa5, 0xe5, 0x0b, 0x69, 0x73, 0x71, 0xe4, 0xa6, 0x96, 0x24, 0xa6,
-	0x24, 0x96, 0x24, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xf1, 0xeb, 0x95, 0xa7, 0x26, 0x96,
-	0xa5, 0xea, 0xf9, 0x42, 0x85, 0x83, 0xe0, 0x0a, 0x84, 0x84, 0xb8, 0x58, 0xf2, 0x12, 0x73, 0x53,
-	0x25, 0x98, 0x14, 0x18, 0x35, 0x38, 0x83, 0xc0, 0x6c, 0xa5, 0x14, 0x2e, 0x4e, 0xe7, 0xa2, 0xd4,
-	0xc4, 0x92, 0x54, 0xdf, 0xe2, 0x74, 0xd2, 0x4c, 0x13, 0xe3, 0x62, 0x2b, 0xc9, 0x4c, 0xce, 0x4e,
-	0x2d, 0x82, 0x9a, 0x07, 0xe5, 0xc1, 0x6d, 0x61, 0x46, 0xd8, 0xe2, 0x24, 0x71, 0xe2, 0x91, 0x1c,
-	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x49, 0x6c, 0x60, 0x4f, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xd9, 0x90, 0x01, 0xa9, 0x05, 0x01, 0x00, 0x00,
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcb, 0x6a, 0xdb, 0x40,
+	0x14, 0x86, 0x3d, 0xbe, 0xe8, 0x32, 0xee, 0x8d, 0xa1, 0x84, 0xc1, 0x0b, 0x45, 0x98, 0x52, 0x5c,
+	0x4a, 0x25, 0x68, 0x77, 0xdd, 0xc5, 0x81, 0x82, 0x29, 0xde, 0xa8, 0xf5, 0xa6, 0x1b, 0x33, 0x19,
+	0x4d, 0x9d, 0x21, 0xd1, 0x1c, 0x31, 0x1a, 0x25, 0xf6, 0x5b, 0xf4, 0x19, 0xfa, 0x34, 0x5d, 0x66,
+	0xd9, 0x55, 0x68, 0x6d, 0xe8, 0x43, 0x74, 0x51, 0x8a, 0x64, 0xc7, 0x15, 0x94, 0x8a, 0x88, 0x66,
+	0x77, 0xf4, 0x9f, 0x39, 0x87, 0xff, 0x3f, 0x1f, 0x08, 0x1f, 0x2c, 0x43, 0x9e, 0x6b, 0x2d, 0x14,
+	0x5f, 0x85, 0x1c, 0x62, 0xc1, 0x83, 0x54, 0x83, 0x01, 0xe2, 0xdc, 0xa8, 0x83, 0x7e, 0x45, 0x1e,
+	0x3c, 0xe2, 0x20, 0x55, 0xf5, 0xe1, 0xe0, 0xf1, 0x02, 0x16, 0x50, 0x96, 0x61, 0x51, 0x6d, 0xd5,
+	0xe1, 0x8f, 0x36, 0x76, 0xdf, 0xc3, 0x99, 0x50, 0x13, 0xf5, 0x11, 0xc8, 0x73, 0xec, 0x24, 0xc2,
+	0xb0, 0x98, 0x19, 0x46, 0x91, 0x8f, 0x46, 0xfd, 0x97, 0x0f, 0x83, 0x4b, 0xc1, 0x2e, 0x44, 0x30,
+	0xdd, 0xc9, 0xd1, 0xfe, 0x01, 0x21, 0xb8, 0xab, 0x58, 0x22, 0x68, 0xdb, 0x47, 0x23, 0x37, 0x2a,
+	0x6b, 0xf2, 0x16, 0x3f, 0x48, 0xa4, 0x32, 0x73, 0x96, 0x9b, 0x53, 0xd0, 0xd2, 0xac, 0x68, 0xc7,
+	0x47, 0xa3, 0x7b, 0xe3, 0x27, 0x3f, 0xaf, 0x0f, 0xfd, 0x85, 0x34, 0xa7, 0xf9, 0x49, 0xc0, 0x21,
+	0x09, 0x25, 0x5c, 0xbc, 0x00, 0x25, 0xc2, 0xed, 0xf2, 0xa3, 0x38, 0xd6, 0x22, 0xcb, 0xa2, 0xfb,
+	0xc5, 0xec, 0xd1, 0xcd, 0x28, 0x79, 0x8d, 0x7b, 0x70, 0xa9, 0x84, 0xa6, 0xdd, 0x06, 0x3b, 0xb6,
+	0x23, 0x64, 0x80, 0x9d, 0x58, 0x70, 0x99, 0xb0, 0xf3, 0x8c, 0xf6, 0x7c, 0x34, 0xea, 0x45, 0xfb,
+	0x6f, 0xe2, 0xe3, 0x7e, 0x2c, 0x32, 0xae, 0x65, 0x6a, 0x24, 0x28, 0x6a, 0x95, 0xfe, 0xab, 0x12,
+	0x79, 0x8a, 0x1d, 0xc9, 0x41, 0xcd, 0x73, 0x2d, 0xa9, 0x5d, 0xb4, 0xc7, 0xfd, 0xf5, 0xf5, 0xa1,
+	0x3d, 0xe1, 0xa0, 0x66, 0xd1, 0x24, 0xb2, 0x8b, 0xe6, 0x4c, 0x4b, 0xf2, 0x0c, 0xe3, 0x84, 0x2d,
+	0xe7, 0x59, 0x9e, 0xa6, 0xe7, 0x2b, 0xea, 0x94, 0x17, 0xc3, 0x41, 0x71, 0xfa, 0xe0, 0x18, 0xa4,
+	0x8a, 0xdc, 0x84, 0x2d, 0xdf, 0x95, 0xcd, 0xe1, 0xaf, 0x36, 0x76, 0x8f, 0xb5, 0x60, 0x46, 0x4c,
+	0xb3, 0x45, 0xb3, 0x43, 0x1f, 0x60, 0xcb, 0x48, 0x7e, 0x26, 0xf4, 0xee, 0xd4, 0xbb, 0xaf, 0x3d,
+	0x80, 0x4e, 0x2d, 0x80, 0xee, 0x1d, 0x00, 0xe8, 0xfd, 0x1f, 0x00, 0xab, 0x1e, 0x80, 0x5d, 0x0f,
+	0xc0, 0xb9, 0x35, 0x00, 0xb7, 0x0e, 0xc0, 0x77, 0x84, 0xdd, 0x59, 0x1a, 0xdf, 0x25, 0x80, 0x6a,
+	0xc6, 0x4e, 0x7d, 0xc6, 0x6e, 0x7d, 0xc6, 0xde, 0xad, 0x33, 0x5a, 0x75, 0x19, 0x3f, 0x23, 0x6c,
+	0x4f, 0xa5, 0x32, 0x8d, 0x13, 0xbe, 0x29, 0xdd, 0x1a, 0xa9, 0x58, 0xe9, 0xb6, 0xdd, 0x80, 0x77,
+	0x75, 0x90, 0x0c, 0xb1, 0xc5, 0x12, 0xc8, 0x95, 0xa1, 0x9d, 0xbf, 0x7c, 0xee, 0x3a, 0xc3, 0x0f,
+	0xd8, 0x1e, 0xe7, 0x5a, 0x35, 0xf6, 0xf8, 0x67, 0x77, 0xfb, 0x5f, 0xbb, 0xc7, 0xf4, 0xcb, 0xda,
+	0x43, 0x57, 0x6b, 0x0f, 0x7d, 0x5b, 0x7b, 0xe8, 0xd3, 0xc6, 0x6b, 0x5d, 0x6d, 0xbc, 0xd6, 0xd7,
+	0x8d, 0xd7, 0x3a, 0xb1, 0xca, 0xff, 0xdd, 0xab, 0xdf, 0x03, 0x00, 0xd6, 0x3c, 0x9f, 0x5a, 0x48,
+	0x05, 0x00, 0x00,
 }
 
 func (m *TokenInfo) Marshal() (dAtA []byte, err error) {
@@ -192,6 +528,45 @@ func (m *TokenInfo) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
+	if len(m.MintAuthority) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.MintAuthority)))
+		i += copy(dAtA[i:], m.MintAuthority)
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if m.Decimals != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Decimals))
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.IconURI) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.IconURI)))
+		i += copy(dAtA[i:], m.IconURI)
+	}
+	if m.MaxSupply != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxSupply.Size()))
+		n2, err := m.MaxSupply.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
 	return i, nil
 }
 
@@ -214,11 +589,11 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+		n3, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	if len(m.Ticker) > 0 {
 		dAtA[i] = 0x12
@@ -232,46 +607,251 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
-	return i, nil
-}
-
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.MintAuthority) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.MintAuthority)))
+		i += copy(dAtA[i:], m.MintAuthority)
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *TokenInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if m.Decimals != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Decimals))
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
 	}
-	return n
+	if len(m.IconURI) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.IconURI)))
+		i += copy(dAtA[i:], m.IconURI)
+	}
+	if m.MaxSupply != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxSupply.Size()))
+		n4, err := m.MaxSupply.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
 }
 
-func (m *CreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
-	l = len(m.Ticker)
+	if len(m.Ticker) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Ticker)))
+		i += copy(dAtA[i:], m.Ticker)
+	}
+	if m.Decimals != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Decimals))
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.IconURI) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.IconURI)))
+		i += copy(dAtA[i:], m.IconURI)
+	}
+	if m.MaxSupply != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxSupply.Size()))
+		n6, err := m.MaxSupply.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+
+func (m *MintMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
+	}
+	if m.Amount != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+		n8, err := m.Amount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+
+func (m *BurnMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Amount != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+		n10, err := m.Amount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *TokenInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.MintAuthority)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovCodec(uint64(m.Decimals))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.IconURI)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Ticker)
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
@@ -279,6 +859,99 @@ func (m *CreateMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	l = len(m.MintAuthority)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovCodec(uint64(m.Decimals))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.IconURI)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovCodec(uint64(m.Decimals))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.IconURI)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *MintMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *BurnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -295,7 +968,655 @@ func sovCodec(x uint64) (n int) {
 func sozCodec(x uint64) (n int) {
 	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *TokenInfo) Unmarshal(dAtA []byte) error {
+func (m *TokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAuthority", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAuthority = append(m.MintAuthority[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintAuthority == nil {
+				m.MintAuthority = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IconURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxSupply == nil {
+				m.MaxSupply = &coin.Coin{}
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAuthority", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAuthority = append(m.MintAuthority[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintAuthority == nil {
+				m.MintAuthority = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IconURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxSupply == nil {
+				m.MaxSupply = &coin.Coin{}
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -318,10 +1639,10 @@ func (m *TokenInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -362,7 +1683,7 @@ func (m *TokenInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -390,7 +1711,126 @@ func (m *TokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IconURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxSupply == nil {
+				m.MaxSupply = &coin.Coin{}
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -416,7 +1856,7 @@ func (m *TokenInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateMsg) Unmarshal(dAtA []byte) error {
+func (m *MintMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -439,10 +1879,10 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -483,9 +1923,9 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -495,29 +1935,31 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ticker = string(dAtA[iNdEx:postIndex])
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -527,23 +1969,152 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Amount == nil {
+				m.Amount = &coin.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &coin.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2,21 +2,40 @@ package currency
 
 import (
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
 )
 
-const newTokenInfoCost = 100
+const (
+	newTokenInfoCost    = 100
+	updateTokenInfoCost = 50
+	mintCost            = 100
+	burnCost            = 0
+)
+
+// CashController allows to mint and burn coins and to check the total supply
+// without the need to directly access the bucket.
+// Required functionality is implemented by the x/cash extension.
+type CashController interface {
+	CoinMint(weave.KVStore, weave.Address, coin.Coin) error
+	CoinBurn(weave.KVStore, weave.Address, coin.Coin) error
+	TotalSupply(weave.ReadOnlyKVStore, string) (coin.Coin, error)
+}
 
 func RegisterQuery(qr weave.QueryRouter) {
 	NewTokenInfoBucket().Register("tokens", qr)
 }
 
-func RegisterRoutes(r weave.Registry, auth x.Authenticator, issuer weave.Address) {
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, issuer weave.Address, ctrl CashController) {
 	r = migration.SchemaMigratingRegistry("currency", r)
 
 	r.Handle(&CreateMsg{}, newCreateTokenInfoHandler(auth, issuer))
+	r.Handle(&MintMsg{}, newMintHandler(auth, ctrl))
+	r.Handle(&BurnMsg{}, newBurnHandler(auth, ctrl))
+	r.Handle(&UpdateMsg{}, newUpdateTokenInfoHandler(auth, ctrl))
 }
 
 func newCreateTokenInfoHandler(auth x.Authenticator, issuer weave.Address) weave.Handler {
@@ -45,7 +64,13 @@ func (h *createTokenInfoHandler) Deliver(ctx weave.Context, db weave.KVStore, tx
 	if err != nil {
 		return nil, err
 	}
-	obj := NewTokenInfo(msg.Ticker, msg.Name)
+	obj := NewTokenInfo(msg.Ticker, msg.Name, msg.MintAuthority)
+	info := AsTokenInfo(obj)
+	info.Owner = msg.Owner
+	info.Decimals = msg.Decimals
+	info.Description = msg.Description
+	info.IconURI = msg.IconURI
+	info.MaxSupply = msg.MaxSupply
 	return &weave.DeliverResult{}, h.bucket.Save(db, obj)
 }
 
@@ -70,3 +95,199 @@ func (h *createTokenInfoHandler) validate(ctx weave.Context, db weave.KVStore, t
 
 	return &msg, nil
 }
+
+func newUpdateTokenInfoHandler(auth x.Authenticator, ctrl CashController) weave.Handler {
+	return &updateTokenInfoHandler{
+		auth:   auth,
+		bucket: NewTokenInfoBucket(),
+		ctrl:   ctrl,
+	}
+}
+
+// updateTokenInfoHandler changes the description of a currency. Only the
+// owner of the currency is allowed to update it.
+type updateTokenInfoHandler struct {
+	auth   x.Authenticator
+	bucket *TokenInfoBucket
+	ctrl   CashController
+}
+
+func (h *updateTokenInfoHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: updateTokenInfoCost}, nil
+}
+
+func (h *updateTokenInfoHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, obj, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	info := AsTokenInfo(obj)
+	info.Decimals = msg.Decimals
+	info.Description = msg.Description
+	info.IconURI = msg.IconURI
+	info.MaxSupply = msg.MaxSupply
+	if err := h.bucket.Save(db, obj); err != nil {
+		return nil, errors.Wrap(err, "cannot save token info")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *updateTokenInfoHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UpdateMsg, orm.Object, error) {
+	var msg UpdateMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	obj, err := h.bucket.Get(db, msg.Ticker)
+	if err != nil {
+		return nil, nil, err
+	}
+	if obj == nil {
+		return nil, nil, errors.Wrapf(errors.ErrNotFound, "ticker %s", msg.Ticker)
+	}
+	owner := AsTokenInfo(obj).Owner
+	if len(owner) == 0 {
+		return nil, nil, errors.Wrapf(errors.ErrState, "ticker %s has no owner", msg.Ticker)
+	}
+	if !h.auth.HasAddress(ctx, owner) {
+		return nil, nil, errors.Wrapf(errors.ErrUnauthorized, "ticker %s can be updated only by %s", msg.Ticker, owner)
+	}
+	if msg.MaxSupply != nil {
+		total, err := h.ctrl.TotalSupply(db, msg.Ticker)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "total supply")
+		}
+		if !msg.MaxSupply.IsGTE(total) {
+			return nil, nil, errors.Wrapf(errors.ErrState, "max supply lower than the total supply %s", total)
+		}
+	}
+	return &msg, obj, nil
+}
+
+func newMintHandler(auth x.Authenticator, ctrl CashController) weave.Handler {
+	return &mintHandler{
+		auth:   auth,
+		bucket: NewTokenInfoBucket(),
+		ctrl:   ctrl,
+	}
+}
+
+// mintHandler creates new tokens of a currency. Only the mint authority of
+// the currency is allowed to mint.
+type mintHandler struct {
+	auth   x.Authenticator
+	bucket *TokenInfoBucket
+	ctrl   CashController
+}
+
+func (h *mintHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: mintCost}, nil
+}
+
+func (h *mintHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.ctrl.CoinMint(db, msg.Destination, *msg.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot mint")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *mintHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*MintMsg, error) {
+	var msg MintMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	info, err := requireMintAuthority(ctx, db, h.auth, h.bucket, msg.Amount.Ticker)
+	if err != nil {
+		return nil, err
+	}
+	if info.MaxSupply != nil {
+		total, err := h.ctrl.TotalSupply(db, msg.Amount.Ticker)
+		if err != nil {
+			return nil, errors.Wrap(err, "total supply")
+		}
+		total, err = total.Add(*msg.Amount)
+		if err != nil {
+			return nil, errors.Wrap(err, "total supply")
+		}
+		if !info.MaxSupply.IsGTE(total) {
+			return nil, errors.Wrapf(errors.ErrAmount, "max supply of %s exceeded", info.MaxSupply)
+		}
+	}
+	return &msg, nil
+}
+
+func newBurnHandler(auth x.Authenticator, ctrl CashController) weave.Handler {
+	return &burnHandler{
+		auth:   auth,
+		bucket: NewTokenInfoBucket(),
+		ctrl:   ctrl,
+	}
+}
+
+// burnHandler destroys tokens held by the mint authority of a currency.
+type burnHandler struct {
+	auth   x.Authenticator
+	bucket *TokenInfoBucket
+	ctrl   CashController
+}
+
+func (h *burnHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: burnCost}, nil
+}
+
+func (h *burnHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, authority, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.ctrl.CoinBurn(db, authority, *msg.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot burn")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *burnHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*BurnMsg, weave.Address, error) {
+	var msg BurnMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	info, err := requireMintAuthority(ctx, db, h.auth, h.bucket, msg.Amount.Ticker)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &msg, info.MintAuthority, nil
+}
+
+// requireMintAuthority returns the information of the currency with given
+// ticker. An error is returned if the currency does not exist, has no mint
+// authority or the mint authority did not sign the transaction.
+func requireMintAuthority(ctx weave.Context, db weave.KVStore, auth x.Authenticator, bucket *TokenInfoBucket, ticker string) (*TokenInfo, error) {
+	obj, err := bucket.Get(db, ticker)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "ticker %s", ticker)
+	}
+	info := AsTokenInfo(obj)
+	authority := info.MintAuthority
+	if len(authority) == 0 {
+		return nil, errors.Wrapf(errors.ErrState, "ticker %s has no mint authority", ticker)
+	}
+	if !auth.HasAddress(ctx, authority) {
+		return nil, errors.Wrapf(errors.ErrUnauthorized, "ticker %s can be minted only by %s", ticker, authority)
+	}
+	return info, nil
+}
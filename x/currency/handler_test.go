@@ -5,11 +5,13 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/x/cash"
 )
 
 func TestNewTokenInfoHandler(t *testing.T) {
@@ -118,3 +120,232 @@ func TestNewTokenInfoHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestMintAndBurn(t *testing.T) {
+	authority := weavetest.NewCondition()
+	other := weavetest.NewCondition()
+	dest := weavetest.NewCondition().Address()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "currency", "cash")
+	bucket := NewTokenInfoBucket()
+	if err := bucket.Save(db, NewTokenInfo("DOGE", "Doge Coin", authority.Address())); err != nil {
+		t.Fatalf("cannot save token info: %s", err)
+	}
+	if err := bucket.Save(db, NewTokenInfo("FIX", "Fixed Coin", nil)); err != nil {
+		t.Fatalf("cannot save token info: %s", err)
+	}
+
+	ctrl := cash.NewController(cash.NewBucket())
+	mint := newMintHandler(&weavetest.Auth{Signers: []weave.Condition{authority}}, ctrl)
+	burn := newBurnHandler(&weavetest.Auth{Signers: []weave.Condition{authority}}, ctrl)
+
+	// Steps are executed in order, because each depends on the state
+	// created by the previous ones.
+	steps := []struct {
+		name    string
+		handler weave.Handler
+		msg     weave.Msg
+		wantErr *errors.Error
+	}{
+		{
+			name:    "mint to destination",
+			handler: mint,
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: dest,
+				Amount:      coin.NewCoinp(10, 0, "DOGE"),
+			},
+		},
+		{
+			name:    "mint to the mint authority",
+			handler: mint,
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: authority.Address(),
+				Amount:      coin.NewCoinp(5, 0, "DOGE"),
+			},
+		},
+		{
+			name:    "mint signed by other",
+			handler: newMintHandler(&weavetest.Auth{Signers: []weave.Condition{other}}, ctrl),
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: dest,
+				Amount:      coin.NewCoinp(1, 0, "DOGE"),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		{
+			name:    "mint without mint authority",
+			handler: mint,
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: dest,
+				Amount:      coin.NewCoinp(1, 0, "FIX"),
+			},
+			wantErr: errors.ErrState,
+		},
+		{
+			name:    "mint unknown ticker",
+			handler: mint,
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: dest,
+				Amount:      coin.NewCoinp(1, 0, "UNK"),
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		{
+			name:    "burn more than owned",
+			handler: burn,
+			msg: &BurnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Amount:   coin.NewCoinp(6, 0, "DOGE"),
+			},
+			wantErr: errors.ErrAmount,
+		},
+		{
+			name:    "burn",
+			handler: burn,
+			msg: &BurnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Amount:   coin.NewCoinp(3, 0, "DOGE"),
+			},
+		},
+	}
+
+	for _, tc := range steps {
+		if _, err := tc.handler.Deliver(nil, db, &weavetest.Tx{Msg: tc.msg}); !tc.wantErr.Is(err) {
+			t.Fatalf("%s: want %v error, got %+v", tc.name, tc.wantErr, err)
+		}
+	}
+
+	supply, err := cash.TotalSupply(db, "DOGE")
+	if err != nil {
+		t.Fatalf("cannot get supply: %s", err)
+	}
+	if want := coin.NewCoin(12, 0, "DOGE"); !want.Equals(supply) {
+		t.Fatalf("want %v supply, got %v", want, supply)
+	}
+}
+
+func TestUpdateTokenInfo(t *testing.T) {
+	owner := weavetest.NewCondition()
+	other := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "currency", "cash")
+	bucket := NewTokenInfoBucket()
+	obj := NewTokenInfo("DOGE", "Doge Coin", owner.Address())
+	AsTokenInfo(obj).Owner = owner.Address()
+	if err := bucket.Save(db, obj); err != nil {
+		t.Fatalf("cannot save token info: %s", err)
+	}
+	if err := bucket.Save(db, NewTokenInfo("FIX", "Fixed Coin", nil)); err != nil {
+		t.Fatalf("cannot save token info: %s", err)
+	}
+
+	ctrl := cash.NewController(cash.NewBucket())
+	auth := &weavetest.Auth{Signers: []weave.Condition{owner}}
+	update := newUpdateTokenInfoHandler(auth, ctrl)
+	mint := newMintHandler(auth, ctrl)
+
+	// Steps are executed in order, because each depends on the state
+	// created by the previous ones.
+	steps := []struct {
+		name    string
+		handler weave.Handler
+		msg     weave.Msg
+		wantErr *errors.Error
+	}{
+		{
+			name:    "mint",
+			handler: mint,
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: owner.Address(),
+				Amount:      coin.NewCoinp(10, 0, "DOGE"),
+			},
+		},
+		{
+			name:    "update signed by other",
+			handler: newUpdateTokenInfoHandler(&weavetest.Auth{Signers: []weave.Condition{other}}, ctrl),
+			msg: &UpdateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "DOGE",
+				Decimals: 2,
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		{
+			name:    "update token without owner",
+			handler: update,
+			msg: &UpdateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "FIX",
+				Decimals: 2,
+			},
+			wantErr: errors.ErrState,
+		},
+		{
+			name:    "max supply lower than the total supply",
+			handler: update,
+			msg: &UpdateMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Ticker:    "DOGE",
+				MaxSupply: coin.NewCoinp(9, 0, "DOGE"),
+			},
+			wantErr: errors.ErrState,
+		},
+		{
+			name:    "update",
+			handler: update,
+			msg: &UpdateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Ticker:      "DOGE",
+				Decimals:    2,
+				Description: "Such coin",
+				IconURI:     "https://example.com/doge.png",
+				MaxSupply:   coin.NewCoinp(15, 0, "DOGE"),
+			},
+		},
+		{
+			name:    "mint beyond max supply",
+			handler: mint,
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: owner.Address(),
+				Amount:      coin.NewCoinp(5, 1, "DOGE"),
+			},
+			wantErr: errors.ErrAmount,
+		},
+		{
+			name:    "mint up to max supply",
+			handler: mint,
+			msg: &MintMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Destination: owner.Address(),
+				Amount:      coin.NewCoinp(5, 0, "DOGE"),
+			},
+		},
+	}
+
+	for _, tc := range steps {
+		if _, err := tc.handler.Deliver(nil, db, &weavetest.Tx{Msg: tc.msg}); !tc.wantErr.Is(err) {
+			t.Fatalf("%s: want %v error, got %+v", tc.name, tc.wantErr, err)
+		}
+	}
+
+	obj, err := bucket.Get(db, "DOGE")
+	if err != nil {
+		t.Fatalf("cannot get token info: %s", err)
+	}
+	info := AsTokenInfo(obj)
+	if info.Decimals != 2 || info.Description != "Such coin" {
+		t.Fatalf("token info not updated: %+v", info)
+	}
+	if got := info.Format(coin.NewCoin(1, 0, "DOGE")); got != "1.00 DOGE" {
+		t.Fatalf("unexpected format: %q", got)
+	}
+}
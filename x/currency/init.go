@@ -2,6 +2,7 @@ package currency
 
 import (
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -14,8 +15,14 @@ var _ weave.Initializer = (*Initializer)(nil)
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
 	var tokens []struct {
-		Ticker string `json:"ticker"`
-		Name   string `json:"name"`
+		Ticker        string        `json:"ticker"`
+		Name          string        `json:"name"`
+		MintAuthority weave.Address `json:"mint_authority"`
+		Owner         weave.Address `json:"owner"`
+		Decimals      int32         `json:"decimals"`
+		Description   string        `json:"description"`
+		IconURI       string        `json:"icon_uri"`
+		MaxSupply     *coin.Coin    `json:"max_supply"`
 	}
 	if err := opts.ReadOptions("currencies", &tokens); err != nil {
 		return err
@@ -23,7 +30,13 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 
 	bucket := NewTokenInfoBucket()
 	for _, t := range tokens {
-		obj := NewTokenInfo(t.Ticker, t.Name)
+		obj := NewTokenInfo(t.Ticker, t.Name, t.MintAuthority)
+		info := AsTokenInfo(obj)
+		info.Owner = t.Owner
+		info.Decimals = t.Decimals
+		info.Description = t.Description
+		info.IconURI = t.IconURI
+		info.MaxSupply = t.MaxSupply
 		if err := bucket.Save(kv, obj); err != nil {
 			return err
 		}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 )
@@ -14,7 +15,7 @@ func TestGenesisKey(t *testing.T) {
 		{
 			"currencies": [
 				{"ticker": "MCR", "name": "my currency"},
-				{"ticker": "DOGE", "name": "Doge Coin"}
+				{"ticker": "DOGE", "name": "Doge Coin", "decimals": 2, "max_supply": "1000 DOGE"}
 			]
 		}
 	`
@@ -43,4 +44,18 @@ func TestGenesisKey(t *testing.T) {
 	if info.Name != "my currency" {
 		t.Errorf("invalid token name: %q", info.Name)
 	}
+
+	obj, err = bucket.Get(db, "DOGE")
+	if err != nil {
+		t.Fatalf("cannot fetch token information: %s", err)
+	} else if obj == nil {
+		t.Fatal("token information not found")
+	}
+	info = obj.Value().(*TokenInfo)
+	if info.Decimals != 2 {
+		t.Errorf("invalid decimals: %d", info.Decimals)
+	}
+	if want := coin.NewCoinp(1000, 0, "DOGE"); !want.Equals(*info.MaxSupply) {
+		t.Errorf("invalid max supply: %v", info.MaxSupply)
+	}
 }
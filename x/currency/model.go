@@ -1,6 +1,7 @@
 package currency
 
 import (
+	"net/url"
 	"regexp"
 
 	"github.com/iov-one/weave"
@@ -16,14 +17,23 @@ func init() {
 
 var isTokenName = regexp.MustCompile(`^[A-Za-z0-9 \-_:]{3,32}$`).MatchString
 
+const (
+	// maxDecimals is the highest precision that a coin can represent.
+	maxDecimals = 9
+
+	maxDescriptionLength = 512
+	maxIconURILength     = 256
+)
+
 var _ orm.CloneableData = (*TokenInfo)(nil)
 
 // NewTokenInfo returns a new instance of Token Info, as represented by orm
-// object.
-func NewTokenInfo(ticker, name string) orm.Object {
+// object. Mint authority is optional.
+func NewTokenInfo(ticker, name string, mintAuthority weave.Address) orm.Object {
 	return orm.NewSimpleObj([]byte(ticker), &TokenInfo{
-		Metadata: &weave.Metadata{Schema: 1},
-		Name:     name,
+		Metadata:      &weave.Metadata{Schema: 1},
+		Name:          name,
+		MintAuthority: mintAuthority,
 	})
 }
 
@@ -34,16 +44,68 @@ func (t *TokenInfo) Validate() error {
 	if !isTokenName(t.Name) {
 		return errors.Wrapf(errors.ErrState, "invalid token name %v", t.Name)
 	}
+	if len(t.MintAuthority) != 0 {
+		if err := t.MintAuthority.Validate(); err != nil {
+			return errors.Wrap(err, "mint authority")
+		}
+	}
+	if len(t.Owner) != 0 {
+		if err := t.Owner.Validate(); err != nil {
+			return errors.Wrap(err, "owner")
+		}
+	}
+	return validateDetails(t.Decimals, t.Description, t.IconURI, t.MaxSupply)
+}
+
+// validateDetails validates the descriptive part of the token information
+// that can be changed by the token owner.
+func validateDetails(decimals int32, description, iconURI string, maxSupply *coin.Coin) error {
+	if decimals < 0 || decimals > maxDecimals {
+		return errors.Wrapf(errors.ErrInput, "decimals must be between 0 and %d", maxDecimals)
+	}
+	if len(description) > maxDescriptionLength {
+		return errors.Wrapf(errors.ErrInput, "description must not be longer than %d characters", maxDescriptionLength)
+	}
+	if iconURI != "" {
+		if len(iconURI) > maxIconURILength {
+			return errors.Wrapf(errors.ErrInput, "icon URI must not be longer than %d characters", maxIconURILength)
+		}
+		if u, err := url.Parse(iconURI); err != nil || u.Scheme == "" {
+			return errors.Wrapf(errors.ErrInput, "invalid icon URI %q", iconURI)
+		}
+	}
+	if maxSupply != nil {
+		if err := maxSupply.Validate(); err != nil {
+			return errors.Wrap(err, "max supply")
+		}
+		if !maxSupply.IsPositive() {
+			return errors.Wrap(errors.ErrAmount, "max supply must be greater than zero")
+		}
+	}
 	return nil
 }
 
 func (t *TokenInfo) Copy() orm.CloneableData {
 	return &TokenInfo{
-		Metadata: t.Metadata.Copy(),
-		Name:     t.Name,
+		Metadata:      t.Metadata.Copy(),
+		Name:          t.Name,
+		MintAuthority: t.MintAuthority.Clone(),
+		Owner:         t.Owner.Clone(),
+		Decimals:      t.Decimals,
+		Description:   t.Description,
+		IconURI:       t.IconURI,
+		MaxSupply:     t.MaxSupply.Clone(),
 	}
 }
 
+// Format returns a human readable representation of given amount, using the
+// number of decimals declared by the currency. Precision of the amount is
+// never reduced, so an amount with more fractional digits than declared is
+// displayed with all of them.
+func (t *TokenInfo) Format(c coin.Coin) string {
+	return c.FormatDecimals(int(t.Decimals))
+}
+
 // TokenInfoBucket stores TokenInfo instances, using ticker name (currency
 // symbol) as the key.
 type TokenInfoBucket struct {
@@ -60,12 +122,24 @@ func (b *TokenInfoBucket) Get(db weave.KVStore, ticker string) (orm.Object, erro
 	return b.Bucket.Get(db, []byte(ticker))
 }
 
+// AsTokenInfo safely extracts a TokenInfo value from the object.
+func AsTokenInfo(obj orm.Object) *TokenInfo {
+	if obj == nil || obj.Value() == nil {
+		return nil
+	}
+	return obj.Value().(*TokenInfo)
+}
+
 func (b *TokenInfoBucket) Save(db weave.KVStore, obj orm.Object) error {
 	if _, ok := obj.Value().(*TokenInfo); !ok {
 		return errors.WithType(errors.ErrModel, obj.Value())
 	}
-	if n := string(obj.Key()); !coin.IsCC(n) {
+	n := string(obj.Key())
+	if !coin.IsCC(n) {
 		return errors.Wrapf(errors.ErrCurrency, "invalid ticker: %s", n)
 	}
+	if max := obj.Value().(*TokenInfo).MaxSupply; max != nil && max.Ticker != n {
+		return errors.Wrapf(errors.ErrCurrency, "max supply must be declared in %s", n)
+	}
 	return b.Bucket.Save(db, obj)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
@@ -28,6 +29,41 @@ func TestValidateTokenInfo(t *testing.T) {
 			},
 			WantErr: errors.ErrMetadata,
 		},
+		"valid model with details": {
+			TokenInfo: &TokenInfo{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Name:        "foobar",
+				Decimals:    6,
+				Description: "Foo bar token",
+				IconURI:     "https://example.com/foo.png",
+				MaxSupply:   coin.NewCoinp(1000, 0, "FOO"),
+			},
+			WantErr: nil,
+		},
+		"too many decimals": {
+			TokenInfo: &TokenInfo{
+				Metadata: &weave.Metadata{Schema: 1},
+				Name:     "foobar",
+				Decimals: 10,
+			},
+			WantErr: errors.ErrInput,
+		},
+		"invalid icon URI": {
+			TokenInfo: &TokenInfo{
+				Metadata: &weave.Metadata{Schema: 1},
+				Name:     "foobar",
+				IconURI:  "foo.png",
+			},
+			WantErr: errors.ErrInput,
+		},
+		"zero max supply": {
+			TokenInfo: &TokenInfo{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Name:      "foobar",
+				MaxSupply: coin.NewCoinp(0, 0, "FOO"),
+			},
+			WantErr: errors.ErrAmount,
+		},
 	}
 
 	for testName, tc := range cases {
@@ -47,16 +83,16 @@ func TestTokenInfoBucketQuery(t *testing.T) {
 	migration.MustInitPkg(db, "currency")
 
 	// Registration of invalid token must fail.
-	obj := NewTokenInfo("this is not a valid name", "Invalid Token")
+	obj := NewTokenInfo("this is not a valid name", "Invalid Token", nil)
 	if err := bucket.Save(db, obj); err == nil {
 		t.Fatal("want error")
 	}
 
-	doge := NewTokenInfo("DOGE", "Doge Coin")
+	doge := NewTokenInfo("DOGE", "Doge Coin", nil)
 	if err := bucket.Save(db, doge); err != nil {
 		t.Fatalf("cannot register doge: %s", err)
 	}
-	plop := NewTokenInfo("PLP", "Plop Coin")
+	plop := NewTokenInfo("PLP", "Plop Coin", nil)
 	if err := bucket.Save(db, plop); err != nil {
 		t.Fatalf("cannot register plop: %s", err)
 	}
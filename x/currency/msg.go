@@ -1,6 +1,7 @@
 package currency
 
 import (
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
@@ -8,6 +9,9 @@ import (
 
 func init() {
 	migration.MustRegister(1, &CreateMsg{}, migration.NoModification)
+	migration.MustRegister(1, &MintMsg{}, migration.NoModification)
+	migration.MustRegister(1, &BurnMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateMsg{}, migration.NoModification)
 }
 
 func (CreateMsg) Path() string {
@@ -24,5 +28,85 @@ func (t *CreateMsg) Validate() error {
 	if !isTokenName(t.Name) {
 		return errors.Wrapf(errors.ErrState, "invalid token name %v", t.Name)
 	}
+	if len(t.MintAuthority) != 0 {
+		if err := t.MintAuthority.Validate(); err != nil {
+			return errors.Wrap(err, "mint authority")
+		}
+	}
+	if len(t.Owner) != 0 {
+		if err := t.Owner.Validate(); err != nil {
+			return errors.Wrap(err, "owner")
+		}
+	}
+	if err := validateDetails(t.Decimals, t.Description, t.IconURI, t.MaxSupply); err != nil {
+		return err
+	}
+	if t.MaxSupply != nil && t.MaxSupply.Ticker != t.Ticker {
+		return errors.Wrapf(errors.ErrCurrency, "max supply must be declared in %s", t.Ticker)
+	}
+	return nil
+}
+
+var _ weave.Msg = (*UpdateMsg)(nil)
+
+func (UpdateMsg) Path() string {
+	return "currency/update"
+}
+
+func (m *UpdateMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if !coin.IsCC(m.Ticker) {
+		return errors.Wrapf(errors.ErrCurrency, "invalid ticker: %s", m.Ticker)
+	}
+	if err := validateDetails(m.Decimals, m.Description, m.IconURI, m.MaxSupply); err != nil {
+		return err
+	}
+	if m.MaxSupply != nil && m.MaxSupply.Ticker != m.Ticker {
+		return errors.Wrapf(errors.ErrCurrency, "max supply must be declared in %s", m.Ticker)
+	}
+	return nil
+}
+
+var _ weave.Msg = (*MintMsg)(nil)
+
+func (MintMsg) Path() string {
+	return "currency/mint"
+}
+
+func (m *MintMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if err := m.Destination.Validate(); err != nil {
+		return errors.Wrap(err, "destination")
+	}
+	return validateAmount(m.Amount)
+}
+
+var _ weave.Msg = (*BurnMsg)(nil)
+
+func (BurnMsg) Path() string {
+	return "currency/burn"
+}
+
+func (m *BurnMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	return validateAmount(m.Amount)
+}
+
+func validateAmount(amount *coin.Coin) error {
+	if amount == nil {
+		return errors.Wrap(errors.ErrEmpty, "amount")
+	}
+	if err := amount.Validate(); err != nil {
+		return errors.Wrap(err, "amount")
+	}
+	if !amount.IsPositive() {
+		return errors.Wrapf(errors.ErrAmount, "non-positive amount: %s", amount)
+	}
 	return nil
 }
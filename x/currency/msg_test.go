@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 )
 
@@ -27,6 +28,40 @@ func TestValidateCreateMsg(t *testing.T) {
 			},
 			WantErr: errors.ErrMetadata,
 		},
+		"max supply in another currency": {
+			Msg: &CreateMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Ticker:    "IOV",
+				Name:      "mytoken",
+				MaxSupply: coin.NewCoinp(100, 0, "ETH"),
+			},
+			WantErr: errors.ErrCurrency,
+		},
+		"valid update message": {
+			Msg: &UpdateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Ticker:      "IOV",
+				Decimals:    9,
+				Description: "IOV token",
+				IconURI:     "ipfs://QmTzQ1JRkWErjk39mryYw2WVaphAZNAREyMchXzYQ7c15n",
+			},
+			WantErr: nil,
+		},
+		"update with invalid ticker": {
+			Msg: &UpdateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "iov",
+			},
+			WantErr: errors.ErrCurrency,
+		},
+		"update with negative decimals": {
+			Msg: &UpdateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "IOV",
+				Decimals: -1,
+			},
+			WantErr: errors.ErrInput,
+		},
 	}
 
 	for testName, tc := range cases {
@@ -25,10 +25,47 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// TimeoutAction declares what happens with the escrow content on timeout.
+type TimeoutAction int32
+
+const (
+	// Nothing happens automatically. The content can be returned to the
+	// source using ReturnMsg.
+	TimeoutActionNone TimeoutAction = 0
+	// The whole content is released to the destination.
+	TimeoutActionRelease TimeoutAction = 1
+	// The whole content is returned to the source.
+	TimeoutActionReturn TimeoutAction = 2
+)
+
+var TimeoutAction_name = map[int32]string{
+	0: "TIMEOUT_ACTION_NONE",
+	1: "TIMEOUT_ACTION_RELEASE",
+	2: "TIMEOUT_ACTION_RETURN",
+}
+
+var TimeoutAction_value = map[string]int32{
+	"TIMEOUT_ACTION_NONE":    0,
+	"TIMEOUT_ACTION_RELEASE": 1,
+	"TIMEOUT_ACTION_RETURN":  2,
+}
+
+func (x TimeoutAction) String() string {
+	return proto.EnumName(TimeoutAction_name, int32(x))
+}
+
+func (TimeoutAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{0}
+}
+
 // Escrow holds some coins.
 // The arbiter or source can release them to the destination.
 // The destination can return them to the source.
 // Upon timeout, they will be returned to the source.
+//
+// Instead of a single arbiter, an escrow can declare a set of weighted
+// arbiters. Such escrow can be released by the source or by any group of
+// arbiters whose combined weight reaches the arbiter threshold.
 type Escrow struct {
 	Metadata    *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Source      github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=source,proto3,casttype=github.com/iov-one/weave.Address" json:"source,omitempty"`
@@ -46,6 +83,21 @@ type Escrow struct {
 	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,7,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Arbiters is a weighted set of arbiters. It is used instead of the
+	// arbiter field, which must be empty if arbiters are declared.
+	Arbiters []*Arbiter `protobuf:"bytes,8,rep,name=arbiters,proto3" json:"arbiters,omitempty"`
+	// Arbiter threshold is the combined weight of arbiters required to
+	// release the escrow. It is used only together with arbiters.
+	ArbiterThreshold uint32 `protobuf:"varint,9,opt,name=arbiter_threshold,json=arbiterThreshold,proto3" json:"arbiter_threshold,omitempty"`
+	// Milestones are predefined tranches of the escrow amount that are
+	// released separately. If declared, milestones split the whole escrow
+	// amount.
+	Milestones []*Milestone `protobuf:"bytes,10,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	// Timeout action is executed automatically by the cron on timeout.
+	TimeoutAction TimeoutAction `protobuf:"varint,11,opt,name=timeout_action,json=timeoutAction,proto3,enum=escrow.TimeoutAction" json:"timeout_action,omitempty"`
+	// Task ID is the ID of the scheduled task that executes the timeout
+	// action. It is set only if the timeout action is declared.
+	TaskID []byte `protobuf:"bytes,12,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
 func (m *Escrow) Reset()         { *m = Escrow{} }
@@ -130,6 +182,158 @@ func (m *Escrow) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Escrow) GetArbiters() []*Arbiter {
+	if m != nil {
+		return m.Arbiters
+	}
+	return nil
+}
+
+func (m *Escrow) GetArbiterThreshold() uint32 {
+	if m != nil {
+		return m.ArbiterThreshold
+	}
+	return 0
+}
+
+func (m *Escrow) GetMilestones() []*Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *Escrow) GetTimeoutAction() TimeoutAction {
+	if m != nil {
+		return m.TimeoutAction
+	}
+	return TimeoutActionNone
+}
+
+func (m *Escrow) GetTaskID() []byte {
+	if m != nil {
+		return m.TaskID
+	}
+	return nil
+}
+
+// Arbiter is a member of an escrow arbiter set.
+type Arbiter struct {
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	Weight  uint32                           `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *Arbiter) Reset()         { *m = Arbiter{} }
+func (m *Arbiter) String() string { return proto.CompactTextString(m) }
+func (*Arbiter) ProtoMessage()    {}
+func (*Arbiter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{1}
+}
+func (m *Arbiter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Arbiter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Arbiter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Arbiter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Arbiter.Merge(m, src)
+}
+func (m *Arbiter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Arbiter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Arbiter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Arbiter proto.InternalMessageInfo
+
+func (m *Arbiter) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *Arbiter) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// Milestone is a part of the escrow amount that is released to the
+// destination at once.
+type Milestone struct {
+	Amount []*coin.Coin `protobuf:"bytes,1,rep,name=amount,proto3" json:"amount,omitempty"`
+	// max length 128 character
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Released is set once the milestone amount was sent to the destination.
+	Released bool `protobuf:"varint,3,opt,name=released,proto3" json:"released,omitempty"`
+}
+
+func (m *Milestone) Reset()         { *m = Milestone{} }
+func (m *Milestone) String() string { return proto.CompactTextString(m) }
+func (*Milestone) ProtoMessage()    {}
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{2}
+}
+func (m *Milestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Milestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Milestone.Merge(m, src)
+}
+func (m *Milestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Milestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Milestone proto.InternalMessageInfo
+
+func (m *Milestone) GetAmount() []*coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Milestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Milestone) GetReleased() bool {
+	if m != nil {
+		return m.Released
+	}
+	return false
+}
+
 // CreateMsg is a request to create an Escrow with some tokens.
 // If source is not defined, it defaults to the first signer
 // The rest must be defined
@@ -144,13 +348,22 @@ type CreateMsg struct {
 	Timeout github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=timeout,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"timeout,omitempty"`
 	// max length 128 character
 	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Arbiters and arbiter threshold can be used instead of a single
+	// arbiter.
+	Arbiters         []*Arbiter `protobuf:"bytes,8,rep,name=arbiters,proto3" json:"arbiters,omitempty"`
+	ArbiterThreshold uint32     `protobuf:"varint,9,opt,name=arbiter_threshold,json=arbiterThreshold,proto3" json:"arbiter_threshold,omitempty"`
+	// Milestones, if provided, must sum up to the amount. Released flag of
+	// all milestones must not be set.
+	Milestones []*Milestone `protobuf:"bytes,10,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	// Timeout action, if declared, is executed automatically on timeout.
+	TimeoutAction TimeoutAction `protobuf:"varint,11,opt,name=timeout_action,json=timeoutAction,proto3,enum=escrow.TimeoutAction" json:"timeout_action,omitempty"`
 }
 
 func (m *CreateMsg) Reset()         { *m = CreateMsg{} }
 func (m *CreateMsg) String() string { return proto.CompactTextString(m) }
 func (*CreateMsg) ProtoMessage()    {}
 func (*CreateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36017ee554579951, []int{1}
+	return fileDescriptor_36017ee554579951, []int{3}
 }
 func (m *CreateMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,21 +441,54 @@ func (m *CreateMsg) GetMemo() string {
 	return ""
 }
 
+func (m *CreateMsg) GetArbiters() []*Arbiter {
+	if m != nil {
+		return m.Arbiters
+	}
+	return nil
+}
+
+func (m *CreateMsg) GetArbiterThreshold() uint32 {
+	if m != nil {
+		return m.ArbiterThreshold
+	}
+	return 0
+}
+
+func (m *CreateMsg) GetMilestones() []*Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *CreateMsg) GetTimeoutAction() TimeoutAction {
+	if m != nil {
+		return m.TimeoutAction
+	}
+	return TimeoutActionNone
+}
+
 // ReleaseMsg releases the content to the destination.
 // Must be authorized by source or arbiter.
 // If amount not provided, defaults to entire escrow,
 // May be a subset of the current balance.
+//
+// An escrow with milestones cannot be released by amount. Milestones are
+// referenced by their index. If no milestone is provided, the whole escrow
+// is released and all milestones are marked as released.
 type ReleaseMsg struct {
-	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	EscrowId []byte          `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
-	Amount   []*coin.Coin    `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	EscrowId   []byte          `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	Amount     []*coin.Coin    `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+	Milestones []uint32        `protobuf:"varint,4,rep,packed,name=milestones,proto3" json:"milestones,omitempty"`
 }
 
 func (m *ReleaseMsg) Reset()         { *m = ReleaseMsg{} }
 func (m *ReleaseMsg) String() string { return proto.CompactTextString(m) }
 func (*ReleaseMsg) ProtoMessage()    {}
 func (*ReleaseMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36017ee554579951, []int{2}
+	return fileDescriptor_36017ee554579951, []int{4}
 }
 func (m *ReleaseMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,6 +538,13 @@ func (m *ReleaseMsg) GetAmount() []*coin.Coin {
 	return nil
 }
 
+func (m *ReleaseMsg) GetMilestones() []uint32 {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
 // ReturnMsg returns the content to the source.
 // Must be authorized by the source or an expired timeout
 type ReturnMsg struct {
@@ -303,7 +556,7 @@ func (m *ReturnMsg) Reset()         { *m = ReturnMsg{} }
 func (m *ReturnMsg) String() string { return proto.CompactTextString(m) }
 func (*ReturnMsg) ProtoMessage()    {}
 func (*ReturnMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36017ee554579951, []int{3}
+	return fileDescriptor_36017ee554579951, []int{5}
 }
 func (m *ReturnMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,7 +616,7 @@ func (m *UpdatePartiesMsg) Reset()         { *m = UpdatePartiesMsg{} }
 func (m *UpdatePartiesMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdatePartiesMsg) ProtoMessage()    {}
 func (*UpdatePartiesMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36017ee554579951, []int{4}
+	return fileDescriptor_36017ee554579951, []int{6}
 }
 func (m *UpdatePartiesMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,7 +681,10 @@ func (m *UpdatePartiesMsg) GetDestination() github_com_iov_one_weave.Address {
 }
 
 func init() {
+	proto.RegisterEnum("escrow.TimeoutAction", TimeoutAction_name, TimeoutAction_value)
 	proto.RegisterType((*Escrow)(nil), "escrow.Escrow")
+	proto.RegisterType((*Arbiter)(nil), "escrow.Arbiter")
+	proto.RegisterType((*Milestone)(nil), "escrow.Milestone")
 	proto.RegisterType((*CreateMsg)(nil), "escrow.CreateMsg")
 	proto.RegisterType((*ReleaseMsg)(nil), "escrow.ReleaseMsg")
 	proto.RegisterType((*ReturnMsg)(nil), "escrow.ReturnMsg")
@@ -438,34 +694,52 @@ func init() {
 func init() { proto.RegisterFile("x/escrow/codec.proto", fileDescriptor_36017ee554579951) }
 
 var fileDescriptor_36017ee554579951 = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x54, 0xc1, 0x6a, 0xdb, 0x40,
-	0x14, 0xb4, 0x2c, 0x47, 0x8e, 0x9f, 0x0b, 0x0d, 0x22, 0x07, 0xe1, 0x82, 0xa2, 0x8a, 0x16, 0x04,
-	0xa5, 0x12, 0xa4, 0xd7, 0xd2, 0x52, 0x87, 0x16, 0x7a, 0x08, 0x94, 0xa5, 0x3e, 0x97, 0xb5, 0xf6,
-	0xe1, 0x2e, 0x54, 0xfb, 0xc2, 0xee, 0x2a, 0x09, 0xfd, 0x8a, 0x7c, 0x43, 0xbf, 0xa6, 0xc7, 0x1c,
-	0x7b, 0x0a, 0xc5, 0xfe, 0x88, 0x42, 0x4e, 0xc5, 0x5a, 0xa7, 0xd1, 0x25, 0x07, 0x35, 0xbe, 0xe5,
-	0x36, 0x8c, 0x76, 0xde, 0x32, 0x6f, 0x46, 0x0b, 0xfb, 0xe7, 0x05, 0x9a, 0x52, 0xd3, 0x59, 0x51,
-	0x92, 0xc0, 0x32, 0x3f, 0xd1, 0x64, 0x29, 0x0c, 0x1c, 0x37, 0x19, 0xb7, 0xc8, 0xc9, 0x5e, 0x49,
-	0x52, 0xb5, 0x8f, 0x4d, 0xf6, 0x17, 0xb4, 0xa0, 0x06, 0x16, 0x6b, 0xe4, 0xd8, 0xf4, 0xc2, 0x87,
-	0xe0, 0x7d, 0xa3, 0x0f, 0x5f, 0xc0, 0x6e, 0x85, 0x96, 0x0b, 0x6e, 0x79, 0xe4, 0x25, 0x5e, 0x36,
-	0x3e, 0x7c, 0x9c, 0x9f, 0x21, 0x3f, 0xc5, 0xfc, 0x78, 0x43, 0xb3, 0x7f, 0x07, 0xc2, 0xd7, 0x10,
-	0x18, 0xaa, 0x75, 0x89, 0x51, 0x3f, 0xf1, 0xb2, 0x47, 0xd3, 0x67, 0xd7, 0x57, 0x07, 0xc9, 0x42,
-	0xda, 0xaf, 0xf5, 0x3c, 0x2f, 0xa9, 0x2a, 0x24, 0x9d, 0xbe, 0x24, 0x85, 0x85, 0x1b, 0xf0, 0x4e,
-	0x08, 0x8d, 0xc6, 0xb0, 0x8d, 0x26, 0x7c, 0x03, 0x43, 0xae, 0xe7, 0xd2, 0xa2, 0x8e, 0xfc, 0x0e,
-	0xf2, 0x1b, 0x51, 0xf8, 0x01, 0xc6, 0x02, 0x8d, 0x95, 0x8a, 0x5b, 0x49, 0x2a, 0x1a, 0x74, 0x98,
-	0xd1, 0x16, 0x86, 0x6f, 0x61, 0x68, 0x65, 0x85, 0x54, 0xdb, 0x68, 0x27, 0xf1, 0x32, 0x7f, 0xfa,
-	0xfc, 0xfa, 0xea, 0xe0, 0xe9, 0x9d, 0x33, 0x66, 0x4a, 0x9e, 0x7f, 0x96, 0x15, 0xb2, 0x1b, 0x55,
-	0x18, 0xc2, 0xa0, 0xc2, 0x8a, 0xa2, 0x20, 0xf1, 0xb2, 0x11, 0x6b, 0x70, 0x63, 0xce, 0x5d, 0x16,
-	0x0d, 0x3b, 0x99, 0x73, 0x20, 0xfd, 0xd3, 0x87, 0xd1, 0x91, 0x46, 0x6e, 0xf1, 0xd8, 0x2c, 0x1e,
-	0x62, 0x2a, 0x29, 0x04, 0xbc, 0xa2, 0x5a, 0xad, 0x43, 0xf1, 0xb3, 0xf1, 0x21, 0xe4, 0xeb, 0x32,
-	0xe7, 0x47, 0x24, 0x15, 0xdb, 0x7c, 0x69, 0x27, 0x17, 0xdc, 0x2b, 0xb9, 0xe1, 0x6d, 0x72, 0xe9,
-	0x77, 0x00, 0x86, 0xdf, 0x90, 0x9b, 0xee, 0x9b, 0x7f, 0x02, 0x23, 0xf7, 0x1b, 0x7e, 0x91, 0xc2,
-	0x2d, 0x9f, 0xed, 0x3a, 0xe2, 0xa3, 0x68, 0x19, 0xf2, 0xef, 0x32, 0x94, 0xce, 0x60, 0xc4, 0xd0,
-	0xd6, 0x5a, 0x6d, 0xf5, 0xea, 0xf4, 0x47, 0x1f, 0xf6, 0x66, 0x27, 0x82, 0x5b, 0xfc, 0xc4, 0xb5,
-	0x95, 0x68, 0xb6, 0xeb, 0xec, 0xb6, 0x70, 0xfe, 0xfd, 0x0a, 0x37, 0xd8, 0x42, 0xe1, 0x76, 0xfe,
-	0xb3, 0x70, 0xd3, 0xe8, 0xe7, 0x32, 0xf6, 0x2e, 0x97, 0xb1, 0xf7, 0x7b, 0x19, 0x7b, 0x17, 0xab,
-	0xb8, 0x77, 0xb9, 0x8a, 0x7b, 0xbf, 0x56, 0x71, 0x6f, 0x1e, 0x34, 0xaf, 0xe4, 0xab, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x4a, 0x80, 0x06, 0xba, 0x7a, 0x05, 0x00, 0x00,
+	// 717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0xf3, 0xe1, 0x24, 0x93, 0xa6, 0xa4, 0xdb, 0x0f, 0x2c, 0x23, 0xb9, 0xc6, 0x80, 0x14,
+	0x51, 0xe1, 0x88, 0xc2, 0xb1, 0x02, 0xa5, 0x25, 0x48, 0x91, 0x48, 0x8a, 0x16, 0xe7, 0x1c, 0xb9,
+	0xf6, 0x2a, 0x59, 0xb5, 0xf6, 0x56, 0xf6, 0xa6, 0xed, 0x91, 0x23, 0xea, 0x89, 0x33, 0x52, 0x2f,
+	0xf0, 0x03, 0xf8, 0x0f, 0x9c, 0x38, 0xf6, 0xc8, 0xa9, 0x42, 0xe9, 0xbf, 0xe8, 0x09, 0x65, 0xed,
+	0xa4, 0x4e, 0x51, 0x0f, 0x69, 0x7b, 0x82, 0xdb, 0xec, 0xcc, 0xbc, 0x71, 0xdf, 0xcc, 0x7b, 0x55,
+	0x60, 0xe9, 0xa8, 0x46, 0x42, 0x27, 0x60, 0x87, 0x35, 0x87, 0xb9, 0xc4, 0x31, 0xf7, 0x03, 0xc6,
+	0x19, 0x92, 0xa3, 0x9c, 0x5a, 0x4a, 0x24, 0xd5, 0x8a, 0xc3, 0xa8, 0x9f, 0x6c, 0x53, 0x97, 0x7a,
+	0xac, 0xc7, 0x44, 0x58, 0x1b, 0x45, 0x51, 0xd6, 0xf8, 0x98, 0x03, 0xb9, 0x21, 0xf0, 0x68, 0x0d,
+	0x0a, 0x1e, 0xe1, 0xb6, 0x6b, 0x73, 0x5b, 0x91, 0x74, 0xa9, 0x5a, 0x5a, 0xbf, 0x67, 0x1e, 0x12,
+	0xfb, 0x80, 0x98, 0xad, 0x38, 0x8d, 0x27, 0x0d, 0x68, 0x03, 0xe4, 0x90, 0x0d, 0x02, 0x87, 0x28,
+	0x69, 0x5d, 0xaa, 0xce, 0x6d, 0x3e, 0xbe, 0x38, 0x5b, 0xd5, 0x7b, 0x94, 0xf7, 0x07, 0x3b, 0xa6,
+	0xc3, 0xbc, 0x1a, 0x65, 0x07, 0xcf, 0x98, 0x4f, 0x6a, 0xd1, 0x80, 0xba, 0xeb, 0x06, 0x24, 0x0c,
+	0x71, 0x8c, 0x41, 0xaf, 0x20, 0x6f, 0x07, 0x3b, 0x94, 0x93, 0x40, 0xc9, 0xcc, 0x00, 0x1f, 0x83,
+	0xd0, 0x5b, 0x28, 0xb9, 0x24, 0xe4, 0xd4, 0xb7, 0x39, 0x65, 0xbe, 0x92, 0x9d, 0x61, 0x46, 0x12,
+	0x88, 0x5e, 0x43, 0x9e, 0x53, 0x8f, 0xb0, 0x01, 0x57, 0x72, 0xba, 0x54, 0xcd, 0x6c, 0x3e, 0xb9,
+	0x38, 0x5b, 0x7d, 0x78, 0xed, 0x8c, 0x8e, 0x4f, 0x8f, 0x2c, 0xea, 0x11, 0x3c, 0x46, 0x21, 0x04,
+	0x59, 0x8f, 0x78, 0x4c, 0x91, 0x75, 0xa9, 0x5a, 0xc4, 0x22, 0x16, 0xe4, 0xa2, 0x8f, 0x29, 0xf9,
+	0x99, 0xc8, 0x45, 0xc1, 0xe8, 0x0e, 0x31, 0xcf, 0x50, 0x29, 0xe8, 0x19, 0x71, 0x87, 0xe8, 0xc4,
+	0x66, 0x3d, 0xca, 0xe3, 0x49, 0x03, 0x5a, 0x83, 0x85, 0x38, 0xee, 0xf2, 0x7e, 0x40, 0xc2, 0x3e,
+	0xdb, 0x73, 0x95, 0xa2, 0x2e, 0x55, 0xcb, 0xb8, 0x12, 0x17, 0xac, 0x71, 0x1e, 0x3d, 0x07, 0xf0,
+	0xe8, 0x1e, 0x09, 0x39, 0xf3, 0x49, 0xa8, 0x80, 0x98, 0xbd, 0x30, 0x9e, 0xdd, 0x1a, 0x57, 0x70,
+	0xa2, 0x09, 0x6d, 0xc0, 0x7c, 0xcc, 0xb5, 0x6b, 0x3b, 0x62, 0xd9, 0x25, 0x5d, 0xaa, 0xce, 0xaf,
+	0x2f, 0x8f, 0x61, 0x56, 0x54, 0xad, 0x8b, 0x22, 0x2e, 0xf3, 0xe4, 0x13, 0x3d, 0x82, 0x3c, 0xb7,
+	0xc3, 0xdd, 0x2e, 0x75, 0x95, 0x39, 0xb1, 0x0a, 0x18, 0x9e, 0xad, 0xca, 0x96, 0x1d, 0xee, 0x36,
+	0xdf, 0x60, 0x79, 0x54, 0x6a, 0xba, 0x86, 0x0d, 0xf9, 0x98, 0x57, 0x72, 0x75, 0xd2, 0x4d, 0x56,
+	0xb7, 0x02, 0xf2, 0x21, 0xa1, 0xbd, 0x3e, 0x17, 0xaa, 0x2c, 0xe3, 0xf8, 0x65, 0x78, 0x50, 0x9c,
+	0xd0, 0x43, 0x06, 0xc8, 0xb6, 0xc7, 0x06, 0x3e, 0x57, 0x24, 0xb1, 0x01, 0x30, 0x47, 0x5e, 0x31,
+	0xb7, 0x18, 0xf5, 0x71, 0x5c, 0x41, 0xba, 0x10, 0x98, 0x13, 0xd0, 0x7d, 0xc1, 0x39, 0x2d, 0xce,
+	0x9b, 0x4c, 0x21, 0x15, 0x0a, 0x01, 0xd9, 0x23, 0x76, 0x48, 0x5c, 0xa1, 0xe1, 0x02, 0x9e, 0xbc,
+	0x8d, 0x1f, 0x59, 0x28, 0x6e, 0x05, 0xc4, 0xe6, 0xa4, 0x15, 0xf6, 0xfe, 0x47, 0x5f, 0x5d, 0xae,
+	0x38, 0x77, 0xed, 0x8a, 0x13, 0xde, 0x93, 0x6f, 0xe5, 0xbd, 0x7c, 0xc2, 0x7b, 0xff, 0x8e, 0x77,
+	0x8c, 0x2f, 0x12, 0x00, 0x8e, 0x14, 0x35, 0xb3, 0x8a, 0x1e, 0x40, 0x31, 0xfa, 0xc4, 0xc8, 0x79,
+	0x42, 0x48, 0xb8, 0x10, 0x25, 0x9a, 0x6e, 0xe2, 0x38, 0x99, 0x6b, 0x8f, 0xa3, 0x4d, 0xb1, 0xcd,
+	0xea, 0x99, 0x6a, 0x39, 0x49, 0xcd, 0xe8, 0x40, 0x11, 0x13, 0x3e, 0x08, 0xfc, 0x3b, 0xfd, 0xd3,
+	0x8c, 0xaf, 0x69, 0xa8, 0x74, 0xf6, 0x5d, 0x9b, 0x93, 0xf7, 0x76, 0xc0, 0x29, 0x09, 0xef, 0x96,
+	0xf9, 0xa5, 0xb9, 0x32, 0xb7, 0x33, 0x57, 0xf6, 0x0e, 0xcc, 0x95, 0xbb, 0xa1, 0xb9, 0x9e, 0x7e,
+	0x97, 0xa0, 0x3c, 0xa5, 0x1c, 0x64, 0xc2, 0xa2, 0xd5, 0x6c, 0x35, 0xb6, 0x3b, 0x56, 0xb7, 0xbe,
+	0x65, 0x35, 0xb7, 0xdb, 0xdd, 0xf6, 0x76, 0xbb, 0x51, 0x49, 0xa9, 0xcb, 0xc7, 0x27, 0xfa, 0xc2,
+	0x54, 0x6f, 0x7b, 0xf4, 0x1f, 0xf0, 0x25, 0xac, 0x5c, 0xe9, 0xc7, 0x8d, 0x77, 0x8d, 0xfa, 0x87,
+	0x46, 0x45, 0x52, 0x95, 0xe3, 0x13, 0x7d, 0x69, 0x5a, 0x98, 0x91, 0x08, 0xd1, 0x3a, 0x2c, 0xff,
+	0x85, 0xb2, 0x3a, 0xb8, 0x5d, 0x49, 0xab, 0xf7, 0x8f, 0x4f, 0xf4, 0xc5, 0x2b, 0xa0, 0x91, 0x3a,
+	0xd4, 0xec, 0xa7, 0x6f, 0x5a, 0x6a, 0x53, 0xf9, 0x39, 0xd4, 0xa4, 0xd3, 0xa1, 0x26, 0xfd, 0x1e,
+	0x6a, 0xd2, 0xe7, 0x73, 0x2d, 0x75, 0x7a, 0xae, 0xa5, 0x7e, 0x9d, 0x6b, 0xa9, 0x1d, 0x59, 0xfc,
+	0x0a, 0x79, 0xf1, 0x67, 0x00, 0x3a, 0x54, 0x26, 0x55, 0xda, 0x08, 0x00, 0x00,
 }
 
 func (m *Escrow) Marshal() (dAtA []byte, err error) {
@@ -528,6 +802,121 @@ func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
 		i += copy(dAtA[i:], m.Address)
 	}
+	if len(m.Arbiters) > 0 {
+		for _, msg := range m.Arbiters {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ArbiterThreshold != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ArbiterThreshold))
+	}
+	if len(m.Milestones) > 0 {
+		for _, msg := range m.Milestones {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.TimeoutAction != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TimeoutAction))
+	}
+	if len(m.TaskID) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TaskID)))
+		i += copy(dAtA[i:], m.TaskID)
+	}
+	return i, nil
+}
+
+func (m *Arbiter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Arbiter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.Weight != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Weight))
+	}
+	return i, nil
+}
+
+func (m *Milestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Milestone) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, msg := range m.Amount {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if m.Released {
+		dAtA[i] = 0x18
+		i++
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -597,6 +986,40 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
 		i += copy(dAtA[i:], m.Memo)
 	}
+	if len(m.Arbiters) > 0 {
+		for _, msg := range m.Arbiters {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ArbiterThreshold != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ArbiterThreshold))
+	}
+	if len(m.Milestones) > 0 {
+		for _, msg := range m.Milestones {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.TimeoutAction != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TimeoutAction))
+	}
 	return i, nil
 }
 
@@ -643,6 +1066,23 @@ func (m *ReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Milestones) > 0 {
+		dAtA5 := make([]byte, len(m.Milestones)*10)
+		var j4 int
+		for _, num := range m.Milestones {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(j4))
+		i += copy(dAtA[i:], dAtA5[:j4])
+	}
 	return i, nil
 }
 
@@ -665,11 +1105,11 @@ func (m *ReturnMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n6
 	}
 	if len(m.EscrowId) > 0 {
 		dAtA[i] = 0x12
@@ -699,11 +1139,11 @@ func (m *UpdatePartiesMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n7
 	}
 	if len(m.EscrowId) > 0 {
 		dAtA[i] = 0x12
@@ -774,14 +1214,74 @@ func (m *Escrow) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
-	return n
-}
-
-func (m *CreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+	if len(m.Arbiters) > 0 {
+		for _, e := range m.Arbiters {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ArbiterThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.ArbiterThreshold))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.TimeoutAction != 0 {
+		n += 1 + sovCodec(uint64(m.TimeoutAction))
+	}
+	l = len(m.TaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Arbiter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovCodec(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *Milestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Released {
+		n += 2
+	}
+	return n
+}
+
+func (m *CreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if m.Metadata != nil {
 		l = m.Metadata.Size()
@@ -812,6 +1312,24 @@ func (m *CreateMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if len(m.Arbiters) > 0 {
+		for _, e := range m.Arbiters {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ArbiterThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.ArbiterThreshold))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.TimeoutAction != 0 {
+		n += 1 + sovCodec(uint64(m.TimeoutAction))
+	}
 	return n
 }
 
@@ -835,6 +1353,13 @@ func (m *ReleaseMsg) Size() (n int) {
 			n += 1 + l + sovCodec(uint64(l))
 		}
 	}
+	if len(m.Milestones) > 0 {
+		l = 0
+		for _, e := range m.Milestones {
+			l += sovCodec(uint64(e))
+		}
+		n += 1 + sovCodec(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1149,62 +1674,9 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateMsg) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateMsg: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1231,86 +1703,16 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &weave.Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Arbiters = append(m.Arbiters, &Arbiter{})
+			if err := m.Arbiters[len(m.Arbiters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
-			if m.Source == nil {
-				m.Source = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Arbiter = append(m.Arbiter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Arbiter == nil {
-				m.Arbiter = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterThreshold", wireType)
 			}
-			var byteLen int
+			m.ArbiterThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1320,29 +1722,14 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ArbiterThreshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
-			if m.Destination == nil {
-				m.Destination = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1369,16 +1756,16 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, &coin.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Milestones = append(m.Milestones, &Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutAction", wireType)
 			}
-			m.Timeout = 0
+			m.TimeoutAction = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1388,16 +1775,16 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				m.TimeoutAction |= TimeoutAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1407,23 +1794,25 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			m.TaskID = append(m.TaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskID == nil {
+				m.TaskID = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1449,7 +1838,7 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReleaseMsg) Unmarshal(dAtA []byte) error {
+func (m *Arbiter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1472,17 +1861,17 @@ func (m *ReleaseMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReleaseMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: Arbiter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReleaseMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Arbiter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1492,31 +1881,658 @@ func (m *ReleaseMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &weave.Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Milestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Milestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Milestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, &coin.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Released = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiter = append(m.Arbiter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Arbiter == nil {
+				m.Arbiter = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, &coin.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiters = append(m.Arbiters, &Arbiter{})
+			if err := m.Arbiters[len(m.Arbiters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterThreshold", wireType)
+			}
+			m.ArbiterThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArbiterThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, &Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutAction", wireType)
+			}
+			m.TimeoutAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutAction |= TimeoutAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1582,6 +2598,82 @@ func (m *ReleaseMsg) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Milestones = append(m.Milestones, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCodec
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCodec
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Milestones) == 0 {
+					m.Milestones = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCodec
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Milestones = append(m.Milestones, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -13,6 +13,14 @@ The arbiter or source (sender) can release them to the recipient.
 The recipient (destination) can return them to the sender (source).
 Upon timeout, they will be returned to the sender (source).
 
+Instead of a single arbiter, an escrow can declare a set of weighted arbiters
+and a threshold. A release is then authorized if the arbiters that signed the
+transaction have a combined weight of at least the threshold.
+
+An escrow can define milestones that split its amount into tranches. Each
+milestone is released separately and tracked in the escrow until the whole
+amount is released.
+
 
 */
 package escrow
@@ -20,13 +20,13 @@ const (
 
 // RegisterRoutes will instantiate and register
 // all handlers in this package
-func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller) {
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller, scheduler weave.Scheduler) {
 	r = migration.SchemaMigratingRegistry("escrow", r)
 	bucket := NewBucket()
 
-	r.Handle(&CreateMsg{}, CreateEscrowHandler{auth, bucket, cashctrl})
-	r.Handle(&ReleaseMsg{}, ReleaseEscrowHandler{auth, bucket, cashctrl})
-	r.Handle(&ReturnMsg{}, ReturnEscrowHandler{auth, bucket, cashctrl})
+	r.Handle(&CreateMsg{}, CreateEscrowHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ReleaseMsg{}, ReleaseEscrowHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ReturnMsg{}, ReturnEscrowHandler{auth, bucket, cashctrl, scheduler})
 	r.Handle(&UpdatePartiesMsg{}, UpdateEscrowHandler{auth, bucket})
 }
 
@@ -37,9 +37,10 @@ func RegisterQuery(qr weave.QueryRouter) {
 
 // CreateEscrowHandler will set a name for objects in this bucket
 type CreateEscrowHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.CoinMover
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.CoinMover
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = CreateEscrowHandler{}
@@ -79,13 +80,24 @@ func (h CreateEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx wea
 
 	// create an escrow object
 	escrow := &Escrow{
-		Metadata:    &weave.Metadata{},
-		Source:      source,
-		Arbiter:     msg.Arbiter,
-		Destination: msg.Destination,
-		Timeout:     msg.Timeout,
-		Memo:        msg.Memo,
-		Address:     Condition(key).Address(),
+		Metadata:         &weave.Metadata{},
+		Source:           source,
+		Arbiter:          msg.Arbiter,
+		Destination:      msg.Destination,
+		Timeout:          msg.Timeout,
+		Memo:             msg.Memo,
+		Address:          Condition(key).Address(),
+		Arbiters:         msg.Arbiters,
+		ArbiterThreshold: msg.ArbiterThreshold,
+		Milestones:       msg.Milestones,
+		TimeoutAction:    msg.TimeoutAction,
+	}
+	if escrow.TimeoutAction != TimeoutActionNone {
+		taskID, err := scheduleTimeoutAction(db, h.scheduler, key, escrow)
+		if err != nil {
+			return nil, err
+		}
+		escrow.TaskID = taskID
 	}
 	if _, err := h.bucket.Put(db, key, escrow); err != nil {
 		return nil, errors.Wrap(err, "cannot store escrow")
@@ -121,9 +133,10 @@ func (h CreateEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx we
 
 // ReleaseEscrowHandler will set a name for objects in this bucket.
 type ReleaseEscrowHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = ReleaseEscrowHandler{}
@@ -149,12 +162,25 @@ func (h ReleaseEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx we
 
 	// use amount in message, or
 	request := coin.Coins(msg.Amount)
+	if len(msg.Milestones) != 0 {
+		request = nil
+		for _, n := range msg.Milestones {
+			m := escrow.Milestones[n]
+			if request, err = request.Combine(m.Amount); err != nil {
+				return nil, errors.Wrapf(err, "milestone %d", n)
+			}
+			m.Released = true
+		}
+	}
 	if len(request) == 0 {
 		available, err := h.bank.Balance(db, escrow.Address)
 		if err != nil {
 			return nil, err
 		}
 		request = available
+		for _, m := range escrow.Milestones {
+			m.Released = true
+		}
 	}
 
 	// withdraw the money from escrow to recipient
@@ -167,9 +193,17 @@ func (h ReleaseEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx we
 		return nil, err
 	}
 	if remainingCoins.IsPositive() {
+		if len(escrow.Milestones) != 0 {
+			if _, err := h.bucket.Put(db, msg.EscrowId, escrow); err != nil {
+				return nil, errors.Wrap(err, "cannot save escrow")
+			}
+		}
 		return &weave.DeliverResult{Data: msg.EscrowId}, nil
 	}
 	// Delete escrow when empty.
+	if err := cancelTimeoutAction(db, h.scheduler, escrow); err != nil {
+		return nil, err
+	}
 	if err := h.bucket.Delete(db, msg.EscrowId); err != nil {
 		return nil, err
 	}
@@ -187,14 +221,37 @@ func (h ReleaseEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx w
 		return nil, nil, errors.Wrap(err, "cannot load escrow from the store")
 	}
 
-	// Arbiter or source must authorize this.
-	if !h.auth.HasAddress(ctx, escrow.Arbiter) && !h.auth.HasAddress(ctx, escrow.Source) {
-		return nil, nil, errors.ErrUnauthorized
+	// On timeout, the escrow itself can release its content if this
+	// was requested on creation. This happens when the timeout action is
+	// executed by the cron.
+	timeoutRelease := escrow.TimeoutAction == TimeoutActionRelease &&
+		weave.IsExpired(ctx, escrow.Timeout) &&
+		h.auth.HasAddress(ctx, escrow.Address)
+	if !timeoutRelease {
+		// Arbiter or source must authorize this.
+		if !escrow.HasArbiterApproval(ctx, h.auth) && !h.auth.HasAddress(ctx, escrow.Source) {
+			return nil, nil, errors.ErrUnauthorized
+		}
+
+		if weave.IsExpired(ctx, escrow.Timeout) {
+			err := errors.Wrapf(errors.ErrExpired, "escrow expired %v", escrow.Timeout)
+			return nil, nil, err
+		}
 	}
 
-	if weave.IsExpired(ctx, escrow.Timeout) {
-		err := errors.Wrapf(errors.ErrExpired, "escrow expired %v", escrow.Timeout)
-		return nil, nil, err
+	if len(escrow.Milestones) == 0 && len(msg.Milestones) != 0 {
+		return nil, nil, errors.Wrap(errors.ErrInput, "escrow has no milestones")
+	}
+	if len(escrow.Milestones) != 0 && len(msg.Amount) != 0 {
+		return nil, nil, errors.Wrap(errors.ErrInput, "escrow with milestones must be released by milestones")
+	}
+	for _, n := range msg.Milestones {
+		if int(n) >= len(escrow.Milestones) {
+			return nil, nil, errors.Wrapf(errors.ErrInput, "milestone %d does not exist", n)
+		}
+		if escrow.Milestones[n].Released {
+			return nil, nil, errors.Wrapf(errors.ErrState, "milestone %d already released", n)
+		}
 	}
 
 	return &msg, &escrow, nil
@@ -202,9 +259,10 @@ func (h ReleaseEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx w
 
 // ReturnEscrowHandler will set a name for objects in this bucket
 type ReturnEscrowHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = ReturnEscrowHandler{}
@@ -238,6 +296,9 @@ func (h ReturnEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx wea
 	if err := cash.MoveCoins(db, h.bank, escrow.Address, dest, available); err != nil {
 		return nil, err
 	}
+	if err := cancelTimeoutAction(db, h.scheduler, escrow); err != nil {
+		return nil, err
+	}
 	if err := h.bucket.Delete(db, key); err != nil {
 		return nil, err
 	}
@@ -337,6 +398,9 @@ func (h UpdateEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx we
 		}
 	}
 	if msg.Arbiter != nil {
+		if len(escrow.Arbiters) != 0 {
+			return nil, nil, errors.Wrap(errors.ErrState, "escrow arbiter set cannot be updated")
+		}
 		if !h.auth.HasAddress(ctx, escrow.Arbiter) {
 			return nil, nil, errors.ErrUnauthorized
 		}
@@ -344,3 +408,41 @@ func (h UpdateEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx we
 
 	return &msg, &escrow, nil
 }
+
+// scheduleTimeoutAction queues a task that executes the timeout action of the
+// escrow stored under given key. The task is authorized by the escrow itself.
+func scheduleTimeoutAction(db weave.KVStore, scheduler weave.Scheduler, key []byte, escrow *Escrow) ([]byte, error) {
+	var msg weave.Msg
+	switch escrow.TimeoutAction {
+	case TimeoutActionRelease:
+		msg = &ReleaseMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			EscrowId: key,
+		}
+	case TimeoutActionReturn:
+		msg = &ReturnMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			EscrowId: key,
+		}
+	default:
+		return nil, errors.Wrapf(errors.ErrInput, "unknown timeout action %d", escrow.TimeoutAction)
+	}
+	auth := []weave.Condition{Condition(key)}
+	taskID, err := scheduler.Schedule(db, escrow.Timeout.Time(), auth, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule timeout action")
+	}
+	return taskID, nil
+}
+
+// cancelTimeoutAction removes the scheduled timeout action of the escrow, if
+// there is one. It is safe to call it from within the scheduled task.
+func cancelTimeoutAction(db weave.KVStore, scheduler weave.Scheduler, escrow *Escrow) error {
+	if len(escrow.TaskID) == 0 {
+		return nil
+	}
+	if err := scheduler.Delete(db, escrow.TaskID); err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "cannot delete timeout action task")
+	}
+	return nil
+}
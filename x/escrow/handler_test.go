@@ -501,7 +501,7 @@ func TestHandler(t *testing.T) {
 	auth := authenticator()
 	// create handler objects and query objects
 	router := app.NewRouter()
-	RegisterRoutes(router, auth, ctrl)
+	RegisterRoutes(router, auth, ctrl, &weavetest.Cron{})
 	cash.RegisterRoutes(router, auth, ctrl)
 	qr := weave.NewQueryRouter()
 	cash.RegisterQuery(qr)
@@ -547,6 +547,206 @@ func TestHandler(t *testing.T) {
 	}
 }
 
+func TestArbiterSetAndMilestones(t *testing.T) {
+	source := weavetest.NewCondition()
+	dest := weavetest.NewCondition()
+	alice := weavetest.NewCondition()
+	bobby := weavetest.NewCondition()
+	charlie := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "escrow", "cash")
+
+	ctrl := cash.NewController(cash.NewBucket())
+	assert.Nil(t, ctrl.CoinMint(db, source.Address(), coin.NewCoin(100, 0, "IOV")))
+
+	rt := app.NewRouter()
+	RegisterRoutes(rt, authenticator(), ctrl, &weavetest.Cron{})
+
+	create := &CreateMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Source:      source.Address(),
+		Destination: dest.Address(),
+		Arbiters: []*Arbiter{
+			{Address: alice.Address(), Weight: 2},
+			{Address: bobby.Address(), Weight: 1},
+			{Address: charlie.Address(), Weight: 1},
+		},
+		ArbiterThreshold: 3,
+		Amount:           mustCombineCoins(coin.NewCoin(10, 0, "IOV")),
+		Milestones: []*Milestone{
+			{Amount: mustCombineCoins(coin.NewCoin(3, 0, "IOV")), Description: "design"},
+			{Amount: mustCombineCoins(coin.NewCoin(7, 0, "IOV")), Description: "delivery"},
+		},
+		Timeout: Timeout,
+	}
+	res, err := rt.Deliver(action{perms: []weave.Condition{source}}.ctx(), db, &weavetest.Tx{Msg: create})
+	assert.Nil(t, err)
+	escrowID := res.Data
+
+	release := func(milestones []uint32, signers ...weave.Condition) error {
+		msg := &ReleaseMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			EscrowId:   escrowID,
+			Milestones: milestones,
+		}
+		_, err := rt.Deliver(action{perms: signers}.ctx(), db, &weavetest.Tx{Msg: msg})
+		return err
+	}
+	balance := func(addr weave.Address) coin.Coins {
+		t.Helper()
+		coins, err := ctrl.Balance(db, addr)
+		assert.Nil(t, err)
+		return coins
+	}
+
+	// Arbiters must reach the threshold.
+	if err := release([]uint32{0}, alice); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	if err := release([]uint32{0}, bobby, charlie); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	if err := release([]uint32{2}, alice, bobby); !errors.ErrInput.Is(err) {
+		t.Fatalf("want unknown milestone error, got %+v", err)
+	}
+	assert.Nil(t, release([]uint32{0}, alice, charlie))
+	assert.Equal(t, true, balance(dest.Address()).Equals(mustCombineCoins(coin.NewCoin(3, 0, "IOV"))))
+
+	var e Escrow
+	assert.Nil(t, NewBucket().One(db, escrowID, &e))
+	assert.Equal(t, true, e.Milestones[0].Released)
+	assert.Equal(t, false, e.Milestones[1].Released)
+
+	// A milestone can be released only once.
+	if err := release([]uint32{0}, alice, bobby); !errors.ErrState.Is(err) {
+		t.Fatalf("want already released error, got %+v", err)
+	}
+	// Milestone escrow cannot be released by amount.
+	byAmount := &ReleaseMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		EscrowId: escrowID,
+		Amount:   mustCombineCoins(coin.NewCoin(1, 0, "IOV")),
+	}
+	if _, err := rt.Deliver(action{perms: []weave.Condition{source}}.ctx(), db, &weavetest.Tx{Msg: byAmount}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want milestone required error, got %+v", err)
+	}
+
+	// Arbiter set cannot be replaced by a single arbiter.
+	update := &UpdatePartiesMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		EscrowId: escrowID,
+		Arbiter:  alice.Address(),
+	}
+	if _, err := rt.Deliver(action{perms: []weave.Condition{alice, bobby}}.ctx(), db, &weavetest.Tx{Msg: update}); !errors.ErrState.Is(err) {
+		t.Fatalf("want arbiter set error, got %+v", err)
+	}
+
+	// Source can release the last milestone and the empty escrow is deleted.
+	assert.Nil(t, release([]uint32{1}, source))
+	assert.Equal(t, true, balance(dest.Address()).Equals(mustCombineCoins(coin.NewCoin(10, 0, "IOV"))))
+	if err := NewBucket().Has(db, escrowID); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want escrow to be deleted, got %+v", err)
+	}
+}
+
+func TestTimeoutAction(t *testing.T) {
+	source := weavetest.NewCondition()
+	dest := weavetest.NewCondition()
+	arbiter := weavetest.NewCondition()
+	amount := mustCombineCoins(coin.NewCoin(10, 0, "IOV"))
+
+	cases := map[string]struct {
+		Action TimeoutAction
+		// Resolve is executed instead of the scheduled task if set.
+		Resolve  weave.Msg
+		WantDest coin.Coins
+	}{
+		"release on timeout": {
+			Action:   TimeoutActionRelease,
+			WantDest: amount,
+		},
+		"return on timeout": {
+			Action:   TimeoutActionReturn,
+			WantDest: nil,
+		},
+		"manual release cancels the task": {
+			Action:   TimeoutActionReturn,
+			Resolve:  &ReleaseMsg{Metadata: &weave.Metadata{Schema: 1}},
+			WantDest: amount,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "escrow", "cash")
+
+			ctrl := cash.NewController(cash.NewBucket())
+			assert.Nil(t, ctrl.CoinMint(db, source.Address(), coin.NewCoin(10, 0, "IOV")))
+
+			cron := &weavetest.Cron{}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, authenticator(), ctrl, cron)
+
+			create := NewCreateMsg(source.Address(), dest.Address(), arbiter.Address(), amount, Timeout, "")
+			create.TimeoutAction = tc.Action
+			res, err := rt.Deliver(action{perms: []weave.Condition{source}}.ctx(), db, &weavetest.Tx{Msg: create})
+			assert.Nil(t, err)
+			escrowID := res.Data
+
+			var e Escrow
+			assert.Nil(t, NewBucket().One(db, escrowID, &e))
+			if len(e.TaskID) == 0 {
+				t.Fatal("timeout action not scheduled")
+			}
+
+			if tc.Resolve != nil {
+				msg := tc.Resolve.(*ReleaseMsg)
+				msg.EscrowId = escrowID
+				_, err := rt.Deliver(action{perms: []weave.Condition{arbiter}}.ctx(), db, &weavetest.Tx{Msg: msg})
+				assert.Nil(t, err)
+				if err := cron.Delete(db, e.TaskID); !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want the task to be cancelled, got %+v", err)
+				}
+			} else {
+				// Execute the scheduled task the same way the cron does.
+				var msg weave.Msg = &ReturnMsg{Metadata: &weave.Metadata{Schema: 1}, EscrowId: escrowID}
+				if tc.Action == TimeoutActionRelease {
+					msg = &ReleaseMsg{Metadata: &weave.Metadata{Schema: 1}, EscrowId: escrowID}
+				}
+				tx := &weavetest.Tx{Msg: msg}
+
+				// The escrow cannot release itself before the timeout.
+				early := action{perms: []weave.Condition{Condition(escrowID)}}.ctx()
+				if _, err := rt.Deliver(early, db, tx); err == nil {
+					t.Fatal("want an error before the timeout")
+				}
+
+				ctx := action{
+					perms:     []weave.Condition{Condition(escrowID)},
+					blockTime: Timeout.Time(),
+				}.ctx()
+				_, err := rt.Deliver(ctx, db, tx)
+				assert.Nil(t, err)
+			}
+
+			if err := NewBucket().Has(db, escrowID); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want escrow to be deleted, got %+v", err)
+			}
+			got, err := ctrl.Balance(db, dest.Address())
+			if len(tc.WantDest) == 0 {
+				if !errors.ErrNotFound.Is(err) && len(got) != 0 {
+					t.Fatalf("want no funds at destination, got %v", got)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, true, got.Equals(tc.WantDest))
+		})
+	}
+}
+
 func createAction(source, rcpt, arbiter weave.Condition, amount coin.Coins, memo string) action {
 	return action{
 		perms: []weave.Condition{source},
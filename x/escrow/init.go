@@ -17,11 +17,14 @@ type Initializer struct {
 // FromGenesis will parse initial escrow  info from genesis and save it in the database.
 func (i *Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
 	var escrows []struct {
-		Source      weave.Address  `json:"source"`
-		Arbiter     weave.Address  `json:"arbiter"`
-		Destination weave.Address  `json:"destination"`
-		Timeout     weave.UnixTime `json:"timeout"`
-		Amount      []*coin.Coin   `json:"amount"`
+		Source           weave.Address  `json:"source"`
+		Arbiter          weave.Address  `json:"arbiter"`
+		Destination      weave.Address  `json:"destination"`
+		Timeout          weave.UnixTime `json:"timeout"`
+		Amount           []*coin.Coin   `json:"amount"`
+		Arbiters         []*Arbiter     `json:"arbiters"`
+		ArbiterThreshold uint32         `json:"arbiter_threshold"`
+		Milestones       []*Milestone   `json:"milestones"`
 	}
 
 	if err := opts.ReadOptions("escrow", &escrows); err != nil {
@@ -34,12 +37,15 @@ func (i *Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams
 			return errors.Wrap(err, "cannot acquire key")
 		}
 		escrow := Escrow{
-			Metadata:    &weave.Metadata{Schema: 1},
-			Source:      e.Source,
-			Arbiter:     e.Arbiter,
-			Destination: e.Destination,
-			Timeout:     e.Timeout,
-			Address:     Condition(key).Address(),
+			Metadata:         &weave.Metadata{Schema: 1},
+			Source:           e.Source,
+			Arbiter:          e.Arbiter,
+			Destination:      e.Destination,
+			Timeout:          e.Timeout,
+			Address:          Condition(key).Address(),
+			Arbiters:         e.Arbiters,
+			ArbiterThreshold: e.ArbiterThreshold,
+			Milestones:       e.Milestones,
 		}
 		if _, err := bucket.Put(kv, key, &escrow); err != nil {
 			return errors.Wrap(err, "cannot save escrow")
@@ -6,6 +6,7 @@ import (
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x"
 )
 
 func init() {
@@ -22,8 +23,8 @@ func (e *Escrow) Validate() error {
 	if err := e.Source.Validate(); err != nil {
 		return errors.Wrap(err, "source")
 	}
-	if err := e.Arbiter.Validate(); err != nil {
-		return errors.Wrap(err, "arbiter")
+	if err := validateArbiters(e.Arbiter, e.Arbiters, e.ArbiterThreshold); err != nil {
+		return err
 	}
 	if err := e.Destination.Validate(); err != nil {
 		return errors.Wrap(err, "destination")
@@ -43,22 +44,78 @@ func (e *Escrow) Validate() error {
 	if err := e.Address.Validate(); err != nil {
 		return errors.Wrap(err, "address")
 	}
+	if err := validateMilestones(e.Milestones); err != nil {
+		return err
+	}
+	if err := validateTimeoutAction(e.TimeoutAction); err != nil {
+		return err
+	}
+	if e.TimeoutAction != TimeoutActionNone && len(e.TaskID) == 0 {
+		return errors.Wrap(errors.ErrModel, "timeout action requires a task ID")
+	}
 	return validateAddresses(e.Source, e.Destination)
 }
 
 // Copy makes a new set with the same coins
 func (e *Escrow) Copy() orm.CloneableData {
 	return &Escrow{
-		Metadata:    e.Metadata.Copy(),
-		Source:      e.Source,
-		Arbiter:     e.Arbiter,
-		Destination: e.Destination,
-		Timeout:     e.Timeout,
-		Memo:        e.Memo,
-		Address:     e.Address.Clone(),
+		Metadata:         e.Metadata.Copy(),
+		Source:           e.Source,
+		Arbiter:          e.Arbiter,
+		Destination:      e.Destination,
+		Timeout:          e.Timeout,
+		Memo:             e.Memo,
+		Address:          e.Address.Clone(),
+		Arbiters:         copyArbiters(e.Arbiters),
+		ArbiterThreshold: e.ArbiterThreshold,
+		Milestones:       copyMilestones(e.Milestones),
+		TimeoutAction:    e.TimeoutAction,
+		TaskID:           append([]byte(nil), e.TaskID...),
 	}
 }
 
+func copyArbiters(arbiters []*Arbiter) []*Arbiter {
+	if arbiters == nil {
+		return nil
+	}
+	cp := make([]*Arbiter, len(arbiters))
+	for i, a := range arbiters {
+		cp[i] = &Arbiter{Address: a.Address.Clone(), Weight: a.Weight}
+	}
+	return cp
+}
+
+func copyMilestones(milestones []*Milestone) []*Milestone {
+	if milestones == nil {
+		return nil
+	}
+	cp := make([]*Milestone, len(milestones))
+	for i, m := range milestones {
+		cp[i] = &Milestone{
+			Amount:      coin.Coins(m.Amount).Clone(),
+			Description: m.Description,
+			Released:    m.Released,
+		}
+	}
+	return cp
+}
+
+// HasArbiterApproval returns true if the escrow arbiter, or a group of
+// arbiters with combined weight reaching the arbiter threshold, is
+// authorized.
+func (e *Escrow) HasArbiterApproval(ctx weave.Context, auth x.Authenticator) bool {
+	if len(e.Arbiters) == 0 {
+		return auth.HasAddress(ctx, e.Arbiter)
+	}
+	var weight uint64
+	for _, a := range e.Arbiters {
+		if auth.HasAddress(ctx, a.Address) {
+			weight += uint64(a.Weight)
+		}
+	}
+	return weight >= uint64(e.ArbiterThreshold)
+}
+
 // AsEscrow extracts an *Escrow value or nil from the object
 // Must be called on a Bucket result that is an *Escrow,
 // will panic on bad type.
@@ -102,7 +159,7 @@ func NewBucket() orm.ModelBucket {
 		orm.WithIDSequence(escrowSeq),
 		orm.WithIndex("source", idxSource, false),
 		orm.WithIndex("destination", idxDestination, false),
-		orm.WithIndex("arbiter", idxArbiter, false),
+		orm.WithMultiKeyIndex("arbiter", idxArbiter, false),
 	)
 	return migration.NewModelBucket("escrow", b)
 }
@@ -136,10 +193,17 @@ func idxDestination(obj orm.Object) ([]byte, error) {
 	return esc.Destination, nil
 }
 
-func idxArbiter(obj orm.Object) ([]byte, error) {
+func idxArbiter(obj orm.Object) ([][]byte, error) {
 	esc, err := toEscrow(obj)
 	if err != nil {
 		return nil, err
 	}
-	return esc.Arbiter, nil
+	if len(esc.Arbiters) == 0 {
+		return [][]byte{esc.Arbiter}, nil
+	}
+	keys := make([][]byte, len(esc.Arbiters))
+	for i, a := range esc.Arbiters {
+		keys[i] = a.Address
+	}
+	return keys, nil
 }
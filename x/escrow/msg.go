@@ -16,6 +16,11 @@ func init() {
 
 const (
 	maxMemoSize int = 128
+
+	// To avoid burning CPU, these are the maximum number of arbiters and
+	// milestones of a single escrow.
+	maxArbiters   = 20
+	maxMilestones = 100
 )
 
 // NewCreateMsg is a helper to quickly build a create escrow message
@@ -49,8 +54,8 @@ func (m *CreateMsg) Validate() error {
 	if err := m.Metadata.Validate(); err != nil {
 		return errors.Wrap(err, "metadata")
 	}
-	if err := m.Arbiter.Validate(); err != nil {
-		return errors.Wrap(err, "arbiter")
+	if err := validateArbiters(m.Arbiter, m.Arbiters, m.ArbiterThreshold); err != nil {
+		return err
 	}
 	if err := m.Destination.Validate(); err != nil {
 		return errors.Wrap(err, "recipient")
@@ -70,6 +75,31 @@ func (m *CreateMsg) Validate() error {
 	if err := validateAmount(m.Amount); err != nil {
 		return err
 	}
+	if err := validateTimeoutAction(m.TimeoutAction); err != nil {
+		return err
+	}
+	if len(m.Milestones) != 0 {
+		if err := validateMilestones(m.Milestones); err != nil {
+			return err
+		}
+		var total coin.Coins
+		for i, ms := range m.Milestones {
+			if ms.Released {
+				return errors.Wrapf(errors.ErrInput, "milestone %d: must not be released", i)
+			}
+			var err error
+			if total, err = total.Combine(ms.Amount); err != nil {
+				return errors.Wrapf(err, "milestone %d", i)
+			}
+		}
+		amount, err := coin.Coins(nil).Combine(m.Amount)
+		if err != nil {
+			return errors.Wrap(err, "amount")
+		}
+		if !total.Equals(amount) {
+			return errors.Wrap(errors.ErrAmount, "milestones must sum up to the amount")
+		}
+	}
 	return nil
 }
 
@@ -88,6 +118,18 @@ func (m *ReleaseMsg) Validate() error {
 	if err != nil {
 		return err
 	}
+	if len(m.Milestones) != 0 {
+		if m.Amount != nil {
+			return errors.Wrap(errors.ErrInput, "amount and milestones cannot be used together")
+		}
+		seen := make(map[uint32]struct{}, len(m.Milestones))
+		for _, n := range m.Milestones {
+			if _, ok := seen[n]; ok {
+				return errors.Wrapf(errors.ErrDuplicate, "milestone %d", n)
+			}
+			seen[n] = struct{}{}
+		}
+	}
 	if m.Amount == nil {
 		return nil
 	}
@@ -154,6 +196,76 @@ func validateAmount(amount coin.Coins) error {
 	return amount.Validate()
 }
 
+// validateArbiters returns an error if neither a single arbiter nor a
+// weighted set of arbiters with a reachable threshold is declared.
+func validateArbiters(arbiter weave.Address, arbiters []*Arbiter, threshold uint32) error {
+	if len(arbiters) == 0 {
+		if threshold != 0 {
+			return errors.Wrap(errors.ErrInput, "arbiter threshold requires arbiters")
+		}
+		if err := arbiter.Validate(); err != nil {
+			return errors.Wrap(err, "arbiter")
+		}
+		return nil
+	}
+	if arbiter != nil {
+		return errors.Wrap(errors.ErrInput, "arbiter and arbiters cannot be used together")
+	}
+	if len(arbiters) > maxArbiters {
+		return errors.Wrapf(errors.ErrInput, "too many arbiters, max %d", maxArbiters)
+	}
+	var total uint64
+	for i, a := range arbiters {
+		if a == nil {
+			return errors.Wrapf(errors.ErrEmpty, "arbiter %d", i)
+		}
+		if err := a.Address.Validate(); err != nil {
+			return errors.Wrapf(err, "arbiter %d", i)
+		}
+		if a.Weight == 0 {
+			return errors.Wrapf(errors.ErrInput, "arbiter %d: weight must be greater than zero", i)
+		}
+		for _, b := range arbiters[:i] {
+			if b.Address.Equals(a.Address) {
+				return errors.Wrapf(errors.ErrDuplicate, "arbiter %d", i)
+			}
+		}
+		total += uint64(a.Weight)
+	}
+	if threshold == 0 {
+		return errors.Wrap(errors.ErrInput, "arbiter threshold must be greater than zero")
+	}
+	if uint64(threshold) > total {
+		return errors.Wrap(errors.ErrInput, "arbiter threshold is greater than the total weight of arbiters")
+	}
+	return nil
+}
+
+func validateMilestones(milestones []*Milestone) error {
+	if len(milestones) > maxMilestones {
+		return errors.Wrapf(errors.ErrInput, "too many milestones, max %d", maxMilestones)
+	}
+	for i, m := range milestones {
+		if m == nil {
+			return errors.Wrapf(errors.ErrEmpty, "milestone %d", i)
+		}
+		if err := validateAmount(m.Amount); err != nil {
+			return errors.Wrapf(err, "milestone %d", i)
+		}
+		if len(m.Description) > maxMemoSize {
+			return errors.Wrapf(errors.ErrInput, "milestone %d: description too long", i)
+		}
+	}
+	return nil
+}
+
+func validateTimeoutAction(a TimeoutAction) error {
+	if _, ok := TimeoutAction_name[int32(a)]; !ok {
+		return errors.Wrapf(errors.ErrInput, "unknown timeout action %d", a)
+	}
+	return nil
+}
+
 func validateEscrowID(id []byte) error {
 	if len(id) != 8 {
 		return errors.Wrapf(errors.ErrInput, "escrow id: %X", id)
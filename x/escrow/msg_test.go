@@ -118,6 +118,91 @@ func TestCreateMsg(t *testing.T) {
 			},
 			errors.ErrInput,
 		},
+		"arbiter set": {
+			&CreateMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Destination:      c.Address(),
+				Arbiters:         []*Arbiter{{Address: a.Address(), Weight: 1}, {Address: b.Address(), Weight: 2}},
+				ArbiterThreshold: 2,
+				Amount:           plus,
+				Timeout:          timeout,
+			},
+			nil,
+		},
+		"arbiter and arbiter set": {
+			&CreateMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Arbiter:          a.Address(),
+				Destination:      c.Address(),
+				Arbiters:         []*Arbiter{{Address: b.Address(), Weight: 1}},
+				ArbiterThreshold: 1,
+				Amount:           plus,
+				Timeout:          timeout,
+			},
+			errors.ErrInput,
+		},
+		"arbiter threshold too high": {
+			&CreateMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Destination:      c.Address(),
+				Arbiters:         []*Arbiter{{Address: a.Address(), Weight: 1}, {Address: b.Address(), Weight: 2}},
+				ArbiterThreshold: 4,
+				Amount:           plus,
+				Timeout:          timeout,
+			},
+			errors.ErrInput,
+		},
+		"duplicated arbiter": {
+			&CreateMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Destination:      c.Address(),
+				Arbiters:         []*Arbiter{{Address: a.Address(), Weight: 1}, {Address: a.Address(), Weight: 2}},
+				ArbiterThreshold: 1,
+				Amount:           plus,
+				Timeout:          timeout,
+			},
+			errors.ErrDuplicate,
+		},
+		"milestones": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				Milestones: []*Milestone{
+					{Amount: mustCombineCoins(coin.NewCoin(40, 0, "FOO"))},
+					{Amount: mustCombineCoins(coin.NewCoin(60, 0, "FOO"))},
+				},
+			},
+			nil,
+		},
+		"milestones not matching the amount": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				Milestones: []*Milestone{
+					{Amount: mustCombineCoins(coin.NewCoin(40, 0, "FOO"))},
+				},
+			},
+			errors.ErrAmount,
+		},
+		"released milestone": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				Milestones: []*Milestone{
+					{Amount: plus, Released: true},
+				},
+			},
+			errors.ErrInput,
+		},
 	}
 
 	for name, tc := range cases {
@@ -196,6 +281,31 @@ func TestReleaseMsg(t *testing.T) {
 			},
 			errors.ErrCurrency,
 		},
+		"milestones": {
+			&ReleaseMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				EscrowId:   escrow,
+				Milestones: []uint32{0, 2},
+			},
+			nil,
+		},
+		"duplicated milestone": {
+			&ReleaseMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				EscrowId:   escrow,
+				Milestones: []uint32{1, 1},
+			},
+			errors.ErrDuplicate,
+		},
+		"milestones and amount": {
+			&ReleaseMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				EscrowId:   escrow,
+				Amount:     plus,
+				Milestones: []uint32{0},
+			},
+			errors.ErrInput,
+		},
 	}
 
 	for name, tc := range cases {
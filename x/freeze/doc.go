@@ -0,0 +1,14 @@
+/*
+Package freeze implements freezing of accounts for a single currency.
+
+Regulated token issuers may be required to stop an account from using their
+token. The owner of a currency, as declared by the x/currency extension, can
+freeze any account using FreezeMsg and lift the freeze using UnfreezeMsg.
+
+A frozen account can neither send nor receive coins of the frozen currency.
+Other currencies held by the account are not affected. Freezing is enforced
+by a transfer hook that must be passed to the x/cash controller:
+
+	ctrl := cash.NewController(cash.NewBucket(), freeze.NewTransferHook())
+*/
+package freeze
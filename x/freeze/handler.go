@@ -0,0 +1,172 @@
+package freeze
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/currency"
+)
+
+const (
+	freezeCost   int64 = 100
+	unfreezeCost int64 = 0
+)
+
+// RegisterRoutes will instantiate and register all handlers in this package.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
+	r = migration.SchemaMigratingRegistry(packageName, r)
+	freezes := NewFreezeBucket()
+	tokens := currency.NewTokenInfoBucket()
+	r.Handle(&FreezeMsg{}, FreezeHandler{auth, freezes, tokens})
+	r.Handle(&UnfreezeMsg{}, UnfreezeHandler{auth, freezes, tokens})
+}
+
+// RegisterQuery will register the freeze bucket as "/freezes".
+func RegisterQuery(qr weave.QueryRouter) {
+	NewFreezeBucket().Register("freezes", qr)
+}
+
+// FreezeHandler freezes an account for a single currency.
+type FreezeHandler struct {
+	auth    x.Authenticator
+	freezes orm.ModelBucket
+	tokens  *currency.TokenInfoBucket
+}
+
+var _ weave.Handler = FreezeHandler{}
+
+func (h FreezeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: freezeCost}, nil
+}
+
+func (h FreezeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	freeze := &Freeze{
+		Metadata: &weave.Metadata{Schema: 1},
+		Ticker:   msg.Ticker,
+		Address:  msg.Address,
+	}
+	if _, err := h.freezes.Put(db, FreezeKey(msg.Ticker, msg.Address), freeze); err != nil {
+		return nil, errors.Wrap(err, "cannot store freeze")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h FreezeHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*FreezeMsg, error) {
+	var msg FreezeMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if err := requireCurrencyOwner(ctx, db, h.auth, h.tokens, msg.Ticker); err != nil {
+		return nil, err
+	}
+	switch frozen, err := IsFrozen(db, h.freezes, msg.Ticker, msg.Address); {
+	case err != nil:
+		return nil, err
+	case frozen:
+		return nil, errors.Wrapf(errors.ErrDuplicate, "account already frozen for %s", msg.Ticker)
+	}
+	return &msg, nil
+}
+
+// UnfreezeHandler removes the freeze of an account for a single currency.
+type UnfreezeHandler struct {
+	auth    x.Authenticator
+	freezes orm.ModelBucket
+	tokens  *currency.TokenInfoBucket
+}
+
+var _ weave.Handler = UnfreezeHandler{}
+
+func (h UnfreezeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: unfreezeCost}, nil
+}
+
+func (h UnfreezeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.freezes.Delete(db, FreezeKey(msg.Ticker, msg.Address)); err != nil {
+		return nil, errors.Wrap(err, "cannot delete freeze")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h UnfreezeHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UnfreezeMsg, error) {
+	var msg UnfreezeMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if err := requireCurrencyOwner(ctx, db, h.auth, h.tokens, msg.Ticker); err != nil {
+		return nil, err
+	}
+	switch frozen, err := IsFrozen(db, h.freezes, msg.Ticker, msg.Address); {
+	case err != nil:
+		return nil, err
+	case !frozen:
+		return nil, errors.Wrapf(errors.ErrNotFound, "account not frozen for %s", msg.Ticker)
+	}
+	return &msg, nil
+}
+
+// requireCurrencyOwner returns an error if the owner of the currency did not
+// sign the transaction. A currency without an owner cannot freeze accounts.
+func requireCurrencyOwner(ctx weave.Context, db weave.KVStore, auth x.Authenticator, tokens *currency.TokenInfoBucket, ticker string) error {
+	obj, err := tokens.Get(db, ticker)
+	if err != nil {
+		return errors.Wrap(err, "cannot load token info")
+	}
+	info := currency.AsTokenInfo(obj)
+	if info == nil {
+		return errors.Wrapf(errors.ErrNotFound, "currency %s", ticker)
+	}
+	if len(info.Owner) == 0 {
+		return errors.Wrapf(errors.ErrUnauthorized, "currency %s has no owner", ticker)
+	}
+	if !auth.HasAddress(ctx, info.Owner) {
+		return errors.Wrap(errors.ErrUnauthorized, "currency owner signature required")
+	}
+	return nil
+}
+
+// TransferHook rejects transfers of a currency from or to an account that is
+// frozen for that currency.
+type TransferHook struct {
+	freezes orm.ModelBucket
+}
+
+var _ cash.TransferHook = TransferHook{}
+
+// NewTransferHook returns a hook that must be passed to the cash controller
+// in order to enforce freezes.
+func NewTransferHook() TransferHook {
+	return TransferHook{freezes: NewFreezeBucket()}
+}
+
+// BeforeTransfer returns an error if either the source or the destination
+// account is frozen for the transferred currency.
+func (h TransferHook) BeforeTransfer(db weave.KVStore, src, dest weave.Address, amount coin.Coin) error {
+	for _, addr := range []weave.Address{src, dest} {
+		switch frozen, err := IsFrozen(db, h.freezes, amount.Ticker, addr); {
+		case err != nil:
+			return err
+		case frozen:
+			return errors.Wrapf(errors.ErrState, "account %s is frozen for %s", addr, amount.Ticker)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package freeze
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/currency"
+)
+
+func TestFreezeAccount(t *testing.T) {
+	owner := weavetest.NewCondition()
+	alice := weavetest.NewCondition()
+	bobby := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName, "cash", "currency")
+
+	tokens := currency.NewTokenInfoBucket()
+	iov := currency.NewTokenInfo("IOV", "Main token", nil)
+	currency.AsTokenInfo(iov).Owner = owner.Address()
+	assert.Nil(t, tokens.Save(db, iov))
+	assert.Nil(t, tokens.Save(db, currency.NewTokenInfo("ETH", "Ownerless token", nil)))
+
+	ctrl := cash.NewController(cash.NewBucket(), NewTransferHook())
+	assert.Nil(t, ctrl.CoinMint(db, alice.Address(), coin.NewCoin(10, 0, "IOV")))
+	assert.Nil(t, ctrl.CoinMint(db, alice.Address(), coin.NewCoin(10, 0, "ETH")))
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth)
+	ctx := context.Background()
+
+	freeze := &weavetest.Tx{Msg: &FreezeMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Ticker:   "IOV",
+		Address:  alice.Address(),
+	}}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, alice), db, freeze); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want owner signature error, got %+v", err)
+	}
+	if _, err := rt.Check(auth.SetConditions(ctx, owner), db, freeze); err != nil {
+		t.Fatalf("cannot check freeze: %s", err)
+	}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, owner), db, freeze); err != nil {
+		t.Fatalf("cannot freeze: %s", err)
+	}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, owner), db, freeze); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want already frozen error, got %+v", err)
+	}
+
+	// Currency without an owner cannot be frozen.
+	freezeETH := &weavetest.Tx{Msg: &FreezeMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Ticker:   "ETH",
+		Address:  alice.Address(),
+	}}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, owner), db, freezeETH); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want no owner error, got %+v", err)
+	}
+
+	// Frozen account can neither send nor receive the frozen currency.
+	if err := ctrl.MoveCoins(db, alice.Address(), bobby.Address(), coin.NewCoin(1, 0, "IOV")); !errors.ErrState.Is(err) {
+		t.Fatalf("want frozen error, got %+v", err)
+	}
+	assert.Nil(t, ctrl.CoinMint(db, bobby.Address(), coin.NewCoin(1, 0, "IOV")))
+	if err := ctrl.MoveCoins(db, bobby.Address(), alice.Address(), coin.NewCoin(1, 0, "IOV")); !errors.ErrState.Is(err) {
+		t.Fatalf("want frozen error, got %+v", err)
+	}
+	// Other currencies are not affected.
+	assert.Nil(t, ctrl.MoveCoins(db, alice.Address(), bobby.Address(), coin.NewCoin(1, 0, "ETH")))
+
+	unfreeze := &weavetest.Tx{Msg: &UnfreezeMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Ticker:   "IOV",
+		Address:  alice.Address(),
+	}}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, alice), db, unfreeze); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want owner signature error, got %+v", err)
+	}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, owner), db, unfreeze); err != nil {
+		t.Fatalf("cannot unfreeze: %s", err)
+	}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, owner), db, unfreeze); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not frozen error, got %+v", err)
+	}
+	assert.Nil(t, ctrl.MoveCoins(db, alice.Address(), bobby.Address(), coin.NewCoin(1, 0, "IOV")))
+}
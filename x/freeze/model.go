@@ -0,0 +1,66 @@
+package freeze
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+)
+
+func init() {
+	migration.MustRegister(1, &Freeze{}, migration.NoModification)
+}
+
+var _ orm.CloneableData = (*Freeze)(nil)
+
+// Validate ensures the freeze is valid.
+func (f *Freeze) Validate() error {
+	if err := f.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if !coin.IsCC(f.Ticker) {
+		return errors.Wrapf(errors.ErrCurrency, "invalid ticker: %s", f.Ticker)
+	}
+	if err := f.Address.Validate(); err != nil {
+		return errors.Wrap(err, "address")
+	}
+	return nil
+}
+
+// Copy makes a new freeze.
+func (f *Freeze) Copy() orm.CloneableData {
+	return &Freeze{
+		Metadata: f.Metadata.Copy(),
+		Ticker:   f.Ticker,
+		Address:  f.Address.Clone(),
+	}
+}
+
+// NewFreezeBucket returns a bucket for storing freezes. Freeze is stored
+// under the key created by FreezeKey.
+func NewFreezeBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("freeze", &Freeze{})
+	return migration.NewModelBucket(packageName, b)
+}
+
+// FreezeKey returns the key under which the freeze of given account for
+// given currency is stored.
+func FreezeKey(ticker string, addr weave.Address) []byte {
+	key := make([]byte, 0, len(ticker)+1+len(addr))
+	key = append(key, ticker...)
+	key = append(key, '/')
+	return append(key, addr...)
+}
+
+// IsFrozen returns true if given account is frozen for given currency.
+func IsFrozen(db weave.KVStore, bucket orm.ModelBucket, ticker string, addr weave.Address) (bool, error) {
+	switch err := bucket.Has(db, FreezeKey(ticker, addr)); {
+	case err == nil:
+		return true, nil
+	case errors.ErrNotFound.Is(err):
+		return false, nil
+	default:
+		return false, errors.Wrap(err, "cannot load freeze")
+	}
+}
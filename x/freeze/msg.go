@@ -0,0 +1,55 @@
+package freeze
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &FreezeMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UnfreezeMsg{}, migration.NoModification)
+}
+
+const packageName = "freeze"
+
+var _ weave.Msg = (*FreezeMsg)(nil)
+
+// Path returns the routing path for this message.
+func (FreezeMsg) Path() string {
+	return "freeze/freeze"
+}
+
+// Validate ensures the message is valid.
+func (m *FreezeMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	return validateAccount(m.Ticker, m.Address)
+}
+
+var _ weave.Msg = (*UnfreezeMsg)(nil)
+
+// Path returns the routing path for this message.
+func (UnfreezeMsg) Path() string {
+	return "freeze/unfreeze"
+}
+
+// Validate ensures the message is valid.
+func (m *UnfreezeMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	return validateAccount(m.Ticker, m.Address)
+}
+
+func validateAccount(ticker string, addr weave.Address) error {
+	if !coin.IsCC(ticker) {
+		return errors.Wrapf(errors.ErrCurrency, "invalid ticker: %s", ticker)
+	}
+	if err := addr.Validate(); err != nil {
+		return errors.Wrap(err, "address")
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package freeze
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestValidateMsg(t *testing.T) {
+	alice := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid freeze message": {
+			Msg: &FreezeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "IOV",
+				Address:  alice,
+			},
+			WantErr: nil,
+		},
+		"freeze missing metadata": {
+			Msg: &FreezeMsg{
+				Ticker:  "IOV",
+				Address: alice,
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"freeze invalid ticker": {
+			Msg: &FreezeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "iov",
+				Address:  alice,
+			},
+			WantErr: errors.ErrCurrency,
+		},
+		"freeze missing address": {
+			Msg: &FreezeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "IOV",
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"valid unfreeze message": {
+			Msg: &UnfreezeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "IOV",
+				Address:  alice,
+			},
+			WantErr: nil,
+		},
+		"unfreeze invalid address": {
+			Msg: &UnfreezeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "IOV",
+				Address:  []byte("xyz"),
+			},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
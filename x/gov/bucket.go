@@ -256,3 +256,162 @@ func (b *VoteBucket) GetVote(db weave.KVStore, proposalID []byte, addr weave.Add
 	}
 	return v, nil
 }
+
+const indexNameVote = "votes"
+
+// VoteRecordBucket is the persistence bucket for the vote history.
+type VoteRecordBucket struct {
+	orm.IDGenBucket
+}
+
+// NewVoteRecordBucket returns a bucket for managing the vote history. Records
+// are stored in the order in which the votes were cast.
+func NewVoteRecordBucket() *VoteRecordBucket {
+	b := migration.NewBucket(packageName, "voterecord", orm.NewSimpleObj(nil, &VoteRecord{})).
+		WithIndex(indexNameProposal, indexRecordProposal, false).
+		WithIndex(indexNameElector, indexRecordElector, false).
+		WithIndex(indexNameVote, indexRecordVote, false)
+	return &VoteRecordBucket{
+		IDGenBucket: orm.WithSeqIDGenerator(b, "id"),
+	}
+}
+
+func asVoteRecord(obj orm.Object) (*VoteRecord, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	r, ok := obj.Value().(*VoteRecord)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "Can only take index of VoteRecord")
+	}
+	return r, nil
+}
+
+func indexRecordProposal(obj orm.Object) ([]byte, error) {
+	r, err := asVoteRecord(obj)
+	if err != nil {
+		return nil, err
+	}
+	return r.ProposalID, nil
+}
+
+func indexRecordElector(obj orm.Object) ([]byte, error) {
+	r, err := asVoteRecord(obj)
+	if err != nil {
+		return nil, err
+	}
+	return r.Elector.Address, nil
+}
+
+// indexRecordVote allows to query the history of a single vote. The key is
+// the same as the key of the vote.
+func indexRecordVote(obj orm.Object) ([]byte, error) {
+	r, err := asVoteRecord(obj)
+	if err != nil {
+		return nil, err
+	}
+	return compositeKey(r.ProposalID, r.Elector.Address), nil
+}
+
+// VoteHistory returns all records of the votes cast by the elector for the
+// given proposal, starting with the oldest one.
+func (b *VoteRecordBucket) VoteHistory(db weave.KVStore, proposalID []byte, addr weave.Address) ([]*VoteRecord, error) {
+	objs, err := b.GetIndexed(db, indexNameVote, compositeKey(proposalID, addr))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load vote records")
+	}
+	records := make([]*VoteRecord, 0, len(objs))
+	for _, obj := range objs {
+		r, err := asVoteRecord(obj)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+// VetoBucket is the persistence bucket for vetoes of proposals pending
+// execution.
+type VetoBucket struct {
+	orm.Bucket
+}
+
+// NewVetoBucket returns a bucket for managing vetoes.
+func NewVetoBucket() *VetoBucket {
+	b := migration.NewBucket(packageName, "veto", orm.NewSimpleObj(nil, &Veto{})).
+		WithIndex(indexNameProposal, indexProposal, false)
+	return &VetoBucket{
+		Bucket: b,
+	}
+}
+
+// Build creates the orm object without storing it.
+func (b *VetoBucket) Build(db weave.KVStore, proposalID []byte, veto Veto) orm.Object {
+	return orm.NewSimpleObj(compositeKey(proposalID, veto.Elector.Address), &veto)
+}
+
+// HasVetoed checks the bucket if any veto matching elector address and
+// proposal id was stored.
+func (b *VetoBucket) HasVetoed(db weave.KVStore, proposalID []byte, addr weave.Address) (bool, error) {
+	obj, err := b.Get(db, compositeKey(proposalID, addr))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to load veto")
+	}
+	return obj != nil && obj.Value() != nil, nil
+}
+
+const indexNameDelegatee = "delegatee"
+
+// DelegationBucket is the persistence bucket for vote delegations.
+type DelegationBucket struct {
+	orm.Bucket
+}
+
+// NewDelegationBucket returns a bucket for managing vote delegations.
+func NewDelegationBucket() *DelegationBucket {
+	b := migration.NewBucket(packageName, "delegation", orm.NewSimpleObj(nil, &Delegation{})).
+		WithIndex(indexNameDelegatee, indexDelegatee, false)
+	return &DelegationBucket{
+		Bucket: b,
+	}
+}
+
+func indexDelegatee(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	d, ok := obj.Value().(*Delegation)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "Can only take index of Delegation")
+	}
+	return delegationKey(d.ElectorateID, d.Delegatee), nil
+}
+
+// Build creates the orm object without storing it.
+func (b *DelegationBucket) Build(db weave.KVStore, d Delegation) orm.Object {
+	return orm.NewSimpleObj(delegationKey(d.ElectorateID, d.Delegator), &d)
+}
+
+// delegationKey returns the key of a delegation. The electorate ID is
+// used as the prefix so that all delegations of an electorate can be iterated.
+func delegationKey(electorateID []byte, delegator weave.Address) []byte {
+	return append(append([]byte{}, electorateID...), delegator...)
+}
+
+// GetDelegation loads the delegation of the given delegator for an electorate.
+// Returns `errors.ErrNotFound` when not exists.
+func (b *DelegationBucket) GetDelegation(db weave.KVStore, electorateID []byte, delegator weave.Address) (*Delegation, error) {
+	obj, err := b.Get(db, delegationKey(electorateID, delegator))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load delegation")
+	}
+	if obj == nil || obj.Value() == nil {
+		return nil, errors.Wrap(errors.ErrNotFound, "unknown delegation")
+	}
+	d, ok := obj.Value().(*Delegation)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrModel, "invalid type: %T", obj.Value())
+	}
+	return d, nil
+}
@@ -9,6 +9,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_iov_one_weave "github.com/iov-one/weave"
 	weave "github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	orm "github.com/iov-one/weave/orm"
 	io "io"
 	math "math"
@@ -104,6 +105,9 @@ const (
 	Proposal_Accepted Proposal_Result = 2
 	// Final result of the tally
 	Proposal_Rejected Proposal_Result = 3
+	// Final result of an accepted proposal that was vetoed by the electorate
+	// before the execution
+	Proposal_Vetoed Proposal_Result = 4
 )
 
 var Proposal_Result_name = map[int32]string{
@@ -111,6 +115,7 @@ var Proposal_Result_name = map[int32]string{
 	1: "PROPOSAL_RESULT_UNDEFINED",
 	2: "PROPOSAL_RESULT_ACCEPTED",
 	3: "PROPOSAL_RESULT_REJECTED",
+	4: "PROPOSAL_RESULT_VETOED",
 }
 
 var Proposal_Result_value = map[string]int32{
@@ -118,6 +123,7 @@ var Proposal_Result_value = map[string]int32{
 	"PROPOSAL_RESULT_UNDEFINED": 1,
 	"PROPOSAL_RESULT_ACCEPTED":  2,
 	"PROPOSAL_RESULT_REJECTED":  3,
+	"PROPOSAL_RESULT_VETOED":    4,
 }
 
 func (x Proposal_Result) String() string {
@@ -139,6 +145,8 @@ const (
 	Proposal_Success Proposal_ExecutorResult = 2
 	// The executor returned an error and proposed action didn't update state
 	Proposal_Failure Proposal_ExecutorResult = 3
+	// The proposal was accepted and the execution is scheduled after the execution delay
+	Proposal_Pending Proposal_ExecutorResult = 4
 )
 
 var Proposal_ExecutorResult_name = map[int32]string{
@@ -146,6 +154,7 @@ var Proposal_ExecutorResult_name = map[int32]string{
 	1: "PROPOSAL_EXECUTOR_RESULT_NOT_RUN",
 	2: "PROPOSAL_EXECUTOR_RESULT_SUCCESS",
 	3: "PROPOSAL_EXECUTOR_RESULT_FAILURE",
+	4: "PROPOSAL_EXECUTOR_RESULT_PENDING",
 }
 
 var Proposal_ExecutorResult_value = map[string]int32{
@@ -153,6 +162,7 @@ var Proposal_ExecutorResult_value = map[string]int32{
 	"PROPOSAL_EXECUTOR_RESULT_NOT_RUN": 1,
 	"PROPOSAL_EXECUTOR_RESULT_SUCCESS": 2,
 	"PROPOSAL_EXECUTOR_RESULT_FAILURE": 3,
+	"PROPOSAL_EXECUTOR_RESULT_PENDING": 4,
 }
 
 func (x Proposal_ExecutorResult) String() string {
@@ -177,6 +187,12 @@ type Electorate struct {
 	Electors []Elector `protobuf:"bytes,5,rep,name=electors,proto3" json:"electors"`
 	// TotalElectorateWeight is the sum of all electors weights.
 	TotalElectorateWeight uint64 `protobuf:"varint,6,opt,name=total_electorate_weight,json=totalElectorateWeight,proto3" json:"total_electorate_weight,omitempty"`
+	// WeightTicker when set makes this electorate token weighted. Electors and
+	// their weights are not maintained manually but derived from a snapshot of
+	// the cash balances of the given ticker taken when a proposal is created.
+	// Weight is the amount of whole tokens held. If the biggest holder has more
+	// tokens than the max weight, all weights are scaled down proportionally.
+	WeightTicker string `protobuf:"bytes,7,opt,name=weight_ticker,json=weightTicker,proto3" json:"weight_ticker,omitempty"`
 }
 
 func (m *Electorate) Reset()         { *m = Electorate{} }
@@ -254,6 +270,13 @@ func (m *Electorate) GetTotalElectorateWeight() uint64 {
 	return 0
 }
 
+func (m *Electorate) GetWeightTicker() string {
+	if m != nil {
+		return m.WeightTicker
+	}
+	return ""
+}
+
 // Elector clubs together a address with a weight. The greater the weight
 // the greater the power of a participant.
 type Elector struct {
@@ -341,6 +364,19 @@ type ElectionRule struct {
 	Quorum *Fraction `protobuf:"bytes,8,opt,name=quorum,proto3" json:"quorum,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,9,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Proposal deposit is the amount of coins that must be deposited by the
+	// author when a new proposal is created. The deposit is returned when the
+	// proposal is accepted or the quorum was reached. Otherwise it is sent to
+	// the deposit collector. Zero value disables the deposit.
+	ProposalDeposit *coin.Coin `protobuf:"bytes,10,opt,name=proposal_deposit,json=proposalDeposit,proto3" json:"proposal_deposit,omitempty"`
+	// Deposit collector is the address that receives deposits of rejected
+	// proposals. If not set, the deposit of a rejected proposal is burned.
+	DepositCollector github_com_iov_one_weave.Address `protobuf:"bytes,11,opt,name=deposit_collector,json=depositCollector,proto3,casttype=github.com/iov-one/weave.Address" json:"deposit_collector,omitempty"`
+	// Execution delay is the duration in seconds between the acceptance of a
+	// proposal and the execution of its options. During this time the
+	// electorate can veto the proposal. Zero value executes an accepted
+	// proposal immediately.
+	ExecutionDelay github_com_iov_one_weave.UnixDuration `protobuf:"varint,12,opt,name=execution_delay,json=executionDelay,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"execution_delay,omitempty"`
 }
 
 func (m *ElectionRule) Reset()         { *m = ElectionRule{} }
@@ -439,6 +475,27 @@ func (m *ElectionRule) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *ElectionRule) GetProposalDeposit() *coin.Coin {
+	if m != nil {
+		return m.ProposalDeposit
+	}
+	return nil
+}
+
+func (m *ElectionRule) GetDepositCollector() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.DepositCollector
+	}
+	return nil
+}
+
+func (m *ElectionRule) GetExecutionDelay() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
 // The Fraction type represents a numerator and denominator to enable higher precision thresholds in
 // the election rules. For example:
 // numerator: 1, denominator: 2 => > 50%
@@ -536,6 +593,19 @@ type Proposal struct {
 	// Tally task ID holds the ID of the asynchronous task that is scheduled to
 	// create the tally once the voting period is over.
 	TallyTaskID []byte `protobuf:"bytes,15,opt,name=tally_task_id,json=tallyTaskId,proto3" json:"tally_task_id,omitempty"`
+	// Deposit is the amount of coins that the author deposited when creating
+	// this proposal. It is held until the tally is done or the proposal is
+	// withdrawn.
+	Deposit *coin.Coin `protobuf:"bytes,16,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// Unix timestamp of the block after which an accepted proposal that is
+	// pending execution is executed. Vetoes are accepted until then.
+	ExecutionTime github_com_iov_one_weave.UnixTime `protobuf:"varint,17,opt,name=execution_time,json=executionTime,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"execution_time,omitempty"`
+	// Execution task ID holds the ID of the asynchronous task that is
+	// scheduled to execute an accepted proposal after the execution delay.
+	ExecutionTaskID []byte `protobuf:"bytes,18,opt,name=execution_task_id,json=executionTaskId,proto3" json:"execution_task_id,omitempty"`
+	// Total veto is the sum of the weights of all electors that vetoed the
+	// proposal while it was pending execution.
+	TotalVeto uint64 `protobuf:"varint,19,opt,name=total_veto,json=totalVeto,proto3" json:"total_veto,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -676,6 +746,34 @@ func (m *Proposal) GetTallyTaskID() []byte {
 	return nil
 }
 
+func (m *Proposal) GetDeposit() *coin.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Proposal) GetExecutionTime() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return 0
+}
+
+func (m *Proposal) GetExecutionTaskID() []byte {
+	if m != nil {
+		return m.ExecutionTaskID
+	}
+	return nil
+}
+
+func (m *Proposal) GetTotalVeto() uint64 {
+	if m != nil {
+		return m.TotalVeto
+	}
+	return 0
+}
+
 // Resolution contains TextResolution and an electorate reference.
 type Resolution struct {
 	Metadata      *weave.Metadata    `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -901,6 +999,230 @@ func (m *Vote) GetVoted() VoteOption {
 	return VoteOption_Invalid
 }
 
+// VoteRecord is an entry of the vote history. A new record is created every
+// time an elector votes or changes their vote so that the evolution of each
+// vote can be audited.
+type VoteRecord struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ProposalID is the ID of the proposal that the vote was cast for.
+	ProposalID []byte `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// Elector is who voted
+	Elector Elector `protobuf:"bytes,3,opt,name=elector,proto3" json:"elector"`
+	// VoteOption is what they voted
+	Voted VoteOption `protobuf:"varint,4,opt,name=voted,proto3,enum=gov.VoteOption" json:"voted,omitempty"`
+	// Unix timestamp of the block in which the vote was cast.
+	VotedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=voted_at,json=votedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"voted_at,omitempty"`
+	// Height of the block in which the vote was cast.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *VoteRecord) Reset()         { *m = VoteRecord{} }
+func (m *VoteRecord) String() string { return proto.CompactTextString(m) }
+func (*VoteRecord) ProtoMessage()    {}
+func (*VoteRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{8}
+}
+func (m *VoteRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteRecord.Merge(m, src)
+}
+func (m *VoteRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteRecord proto.InternalMessageInfo
+
+func (m *VoteRecord) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *VoteRecord) GetProposalID() []byte {
+	if m != nil {
+		return m.ProposalID
+	}
+	return nil
+}
+
+func (m *VoteRecord) GetElector() Elector {
+	if m != nil {
+		return m.Elector
+	}
+	return Elector{}
+}
+
+func (m *VoteRecord) GetVoted() VoteOption {
+	if m != nil {
+		return m.Voted
+	}
+	return VoteOption_Invalid
+}
+
+func (m *VoteRecord) GetVotedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.VotedAt
+	}
+	return 0
+}
+
+func (m *VoteRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Veto is a record of an elector that vetoed an accepted proposal while it was
+// pending execution.
+// The proposalID and address is stored within the key.
+type Veto struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Elector is who vetoed
+	Elector Elector `protobuf:"bytes,2,opt,name=elector,proto3" json:"elector"`
+}
+
+func (m *Veto) Reset()         { *m = Veto{} }
+func (m *Veto) String() string { return proto.CompactTextString(m) }
+func (*Veto) ProtoMessage()    {}
+func (*Veto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{9}
+}
+func (m *Veto) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Veto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Veto.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Veto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Veto.Merge(m, src)
+}
+func (m *Veto) XXX_Size() int {
+	return m.Size()
+}
+func (m *Veto) XXX_DiscardUnknown() {
+	xxx_messageInfo_Veto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Veto proto.InternalMessageInfo
+
+func (m *Veto) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Veto) GetElector() Elector {
+	if m != nil {
+		return m.Elector
+	}
+	return Elector{}
+}
+
+// Delegation assigns the voting power of an elector to another elector of
+// the same electorate. Delegations are resolved during the tally: when the
+// delegator did not vote, their weight is counted for the option selected by
+// the first elector in the delegation chain that voted directly.
+// The electorate ID and the delegator address are stored within the key.
+type Delegation struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ElectorateID references the electorate this delegation is valid for.
+	ElectorateID []byte `protobuf:"bytes,2,opt,name=electorate_id,json=electorateId,proto3" json:"electorate_id,omitempty"`
+	// Delegator is the address of the elector that hands over the voting power.
+	Delegator github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=delegator,proto3,casttype=github.com/iov-one/weave.Address" json:"delegator,omitempty"`
+	// Delegatee is the address of the elector that receives the voting power.
+	Delegatee github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=delegatee,proto3,casttype=github.com/iov-one/weave.Address" json:"delegatee,omitempty"`
+}
+
+func (m *Delegation) Reset()         { *m = Delegation{} }
+func (m *Delegation) String() string { return proto.CompactTextString(m) }
+func (*Delegation) ProtoMessage()    {}
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{10}
+}
+func (m *Delegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Delegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegation.Merge(m, src)
+}
+func (m *Delegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Delegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delegation proto.InternalMessageInfo
+
+func (m *Delegation) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Delegation) GetElectorateID() []byte {
+	if m != nil {
+		return m.ElectorateID
+	}
+	return nil
+}
+
+func (m *Delegation) GetDelegator() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
+func (m *Delegation) GetDelegatee() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Delegatee
+	}
+	return nil
+}
+
 // CreateProposalMsg creates a new governance proposal.
 // Most fields control the whole election process.
 // raw_option contains an transaction to be executed by the governance vote in case of success
@@ -926,7 +1248,7 @@ func (m *CreateProposalMsg) Reset()         { *m = CreateProposalMsg{} }
 func (m *CreateProposalMsg) String() string { return proto.CompactTextString(m) }
 func (*CreateProposalMsg) ProtoMessage()    {}
 func (*CreateProposalMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{8}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{11}
 }
 func (m *CreateProposalMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1015,7 +1337,7 @@ func (m *DeleteProposalMsg) Reset()         { *m = DeleteProposalMsg{} }
 func (m *DeleteProposalMsg) String() string { return proto.CompactTextString(m) }
 func (*DeleteProposalMsg) ProtoMessage()    {}
 func (*DeleteProposalMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{9}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{12}
 }
 func (m *DeleteProposalMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1074,7 +1396,7 @@ func (m *VoteMsg) Reset()         { *m = VoteMsg{} }
 func (m *VoteMsg) String() string { return proto.CompactTextString(m) }
 func (*VoteMsg) ProtoMessage()    {}
 func (*VoteMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{10}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{13}
 }
 func (m *VoteMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1465,7 @@ func (m *TallyMsg) Reset()         { *m = TallyMsg{} }
 func (m *TallyMsg) String() string { return proto.CompactTextString(m) }
 func (*TallyMsg) ProtoMessage()    {}
 func (*TallyMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{11}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{14}
 }
 func (m *TallyMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1186,26 +1508,31 @@ func (m *TallyMsg) GetProposalID() []byte {
 	return nil
 }
 
-// TextResolutionMsg is only intended to be dispatched internally from election
-// results. It adds a resolution to the list of "approved" resolutions,
-// with a reference to the electorate that approved it
-type CreateTextResolutionMsg struct {
-	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Resolution string          `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+// VetoMsg is the way to object to the execution of an accepted proposal that
+// is pending execution. If the total veto weight exceeds the threshold of the
+// election rule the proposal is not executed.
+type VetoMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// The unique id of the proposal.
+	ProposalID []byte `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// Elector address is an optional field. When not set the main signer will
+	// be used as default. The elector address must be included in the
+	// electorate of the proposal.
+	Elector github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=elector,proto3,casttype=github.com/iov-one/weave.Address" json:"elector,omitempty"`
 }
 
-func (m *CreateTextResolutionMsg) Reset()         { *m = CreateTextResolutionMsg{} }
-func (m *CreateTextResolutionMsg) String() string { return proto.CompactTextString(m) }
-func (*CreateTextResolutionMsg) ProtoMessage()    {}
-func (*CreateTextResolutionMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{12}
+func (m *VetoMsg) Reset()         { *m = VetoMsg{} }
+func (m *VetoMsg) String() string { return proto.CompactTextString(m) }
+func (*VetoMsg) ProtoMessage()    {}
+func (*VetoMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{15}
 }
-func (m *CreateTextResolutionMsg) XXX_Unmarshal(b []byte) error {
+func (m *VetoMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateTextResolutionMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VetoMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateTextResolutionMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VetoMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1215,53 +1542,60 @@ func (m *CreateTextResolutionMsg) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *CreateTextResolutionMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTextResolutionMsg.Merge(m, src)
+func (m *VetoMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VetoMsg.Merge(m, src)
 }
-func (m *CreateTextResolutionMsg) XXX_Size() int {
+func (m *VetoMsg) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateTextResolutionMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTextResolutionMsg.DiscardUnknown(m)
+func (m *VetoMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_VetoMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTextResolutionMsg proto.InternalMessageInfo
+var xxx_messageInfo_VetoMsg proto.InternalMessageInfo
 
-func (m *CreateTextResolutionMsg) GetMetadata() *weave.Metadata {
+func (m *VetoMsg) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *CreateTextResolutionMsg) GetResolution() string {
+func (m *VetoMsg) GetProposalID() []byte {
 	if m != nil {
-		return m.Resolution
+		return m.ProposalID
 	}
-	return ""
+	return nil
 }
 
-type UpdateElectorateMsg struct {
+func (m *VetoMsg) GetElector() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Elector
+	}
+	return nil
+}
+
+// ExecuteProposalMsg executes the options of an accepted proposal once the
+// execution delay is over. It is scheduled by the tally and can be executed
+// by the cron only.
+type ExecuteProposalMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// ElectorateID is the reference to the electorate that defines the group of possible voters.
-	ElectorateID []byte `protobuf:"bytes,2,opt,name=electorate_id,json=electorateId,proto3" json:"electorate_id,omitempty"`
-	// DiffElectors contains the changes that should be applied. Adding an address should have a positive weight, removing
-	// with weight=0.
-	DiffElectors []Elector `protobuf:"bytes,3,rep,name=diff_electors,json=diffElectors,proto3" json:"diff_electors"`
+	// ProposalID is UUID of the proposal to execute.
+	ProposalID []byte `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *UpdateElectorateMsg) Reset()         { *m = UpdateElectorateMsg{} }
-func (m *UpdateElectorateMsg) String() string { return proto.CompactTextString(m) }
-func (*UpdateElectorateMsg) ProtoMessage()    {}
-func (*UpdateElectorateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{13}
+func (m *ExecuteProposalMsg) Reset()         { *m = ExecuteProposalMsg{} }
+func (m *ExecuteProposalMsg) String() string { return proto.CompactTextString(m) }
+func (*ExecuteProposalMsg) ProtoMessage()    {}
+func (*ExecuteProposalMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{16}
 }
-func (m *UpdateElectorateMsg) XXX_Unmarshal(b []byte) error {
+func (m *ExecuteProposalMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateElectorateMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExecuteProposalMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateElectorateMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExecuteProposalMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1271,37 +1605,148 @@ func (m *UpdateElectorateMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *UpdateElectorateMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateElectorateMsg.Merge(m, src)
+func (m *ExecuteProposalMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteProposalMsg.Merge(m, src)
 }
-func (m *UpdateElectorateMsg) XXX_Size() int {
+func (m *ExecuteProposalMsg) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateElectorateMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateElectorateMsg.DiscardUnknown(m)
+func (m *ExecuteProposalMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteProposalMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateElectorateMsg proto.InternalMessageInfo
+var xxx_messageInfo_ExecuteProposalMsg proto.InternalMessageInfo
 
-func (m *UpdateElectorateMsg) GetMetadata() *weave.Metadata {
+func (m *ExecuteProposalMsg) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *UpdateElectorateMsg) GetElectorateID() []byte {
+func (m *ExecuteProposalMsg) GetProposalID() []byte {
 	if m != nil {
-		return m.ElectorateID
+		return m.ProposalID
 	}
 	return nil
 }
 
-func (m *UpdateElectorateMsg) GetDiffElectors() []Elector {
-	if m != nil {
-		return m.DiffElectors
-	}
-	return nil
+// TextResolutionMsg is only intended to be dispatched internally from election
+// results. It adds a resolution to the list of "approved" resolutions,
+// with a reference to the electorate that approved it
+type CreateTextResolutionMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Resolution string          `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+}
+
+func (m *CreateTextResolutionMsg) Reset()         { *m = CreateTextResolutionMsg{} }
+func (m *CreateTextResolutionMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateTextResolutionMsg) ProtoMessage()    {}
+func (*CreateTextResolutionMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{17}
+}
+func (m *CreateTextResolutionMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateTextResolutionMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateTextResolutionMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateTextResolutionMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTextResolutionMsg.Merge(m, src)
+}
+func (m *CreateTextResolutionMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateTextResolutionMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTextResolutionMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTextResolutionMsg proto.InternalMessageInfo
+
+func (m *CreateTextResolutionMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateTextResolutionMsg) GetResolution() string {
+	if m != nil {
+		return m.Resolution
+	}
+	return ""
+}
+
+type UpdateElectorateMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ElectorateID is the reference to the electorate that defines the group of possible voters.
+	ElectorateID []byte `protobuf:"bytes,2,opt,name=electorate_id,json=electorateId,proto3" json:"electorate_id,omitempty"`
+	// DiffElectors contains the changes that should be applied. Adding an address should have a positive weight, removing
+	// with weight=0.
+	DiffElectors []Elector `protobuf:"bytes,3,rep,name=diff_electors,json=diffElectors,proto3" json:"diff_electors"`
+}
+
+func (m *UpdateElectorateMsg) Reset()         { *m = UpdateElectorateMsg{} }
+func (m *UpdateElectorateMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateElectorateMsg) ProtoMessage()    {}
+func (*UpdateElectorateMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{18}
+}
+func (m *UpdateElectorateMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateElectorateMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateElectorateMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateElectorateMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateElectorateMsg.Merge(m, src)
+}
+func (m *UpdateElectorateMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateElectorateMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateElectorateMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateElectorateMsg proto.InternalMessageInfo
+
+func (m *UpdateElectorateMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateElectorateMsg) GetElectorateID() []byte {
+	if m != nil {
+		return m.ElectorateID
+	}
+	return nil
+}
+
+func (m *UpdateElectorateMsg) GetDiffElectors() []Elector {
+	if m != nil {
+		return m.DiffElectors
+	}
+	return nil
 }
 
 type UpdateElectionRuleMsg struct {
@@ -1324,13 +1769,23 @@ type UpdateElectionRuleMsg struct {
 	// The valid range for the threshold value is `0.5` to `1` (inclusive) which
 	// allows any value between half and all of the eligible voters.
 	Quorum *Fraction `protobuf:"bytes,5,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Proposal deposit is the amount of coins that must be deposited by the
+	// author when a new proposal is created. Zero value disables the deposit.
+	ProposalDeposit *coin.Coin `protobuf:"bytes,6,opt,name=proposal_deposit,json=proposalDeposit,proto3" json:"proposal_deposit,omitempty"`
+	// Deposit collector is the address that receives deposits of rejected
+	// proposals. If not set, the deposit of a rejected proposal is burned.
+	DepositCollector github_com_iov_one_weave.Address `protobuf:"bytes,7,opt,name=deposit_collector,json=depositCollector,proto3,casttype=github.com/iov-one/weave.Address" json:"deposit_collector,omitempty"`
+	// Execution delay is the duration in seconds between the acceptance of a
+	// proposal and the execution of its options. Zero value executes an
+	// accepted proposal immediately.
+	ExecutionDelay github_com_iov_one_weave.UnixDuration `protobuf:"varint,8,opt,name=execution_delay,json=executionDelay,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"execution_delay,omitempty"`
 }
 
 func (m *UpdateElectionRuleMsg) Reset()         { *m = UpdateElectionRuleMsg{} }
 func (m *UpdateElectionRuleMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdateElectionRuleMsg) ProtoMessage()    {}
 func (*UpdateElectionRuleMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{14}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{19}
 }
 func (m *UpdateElectionRuleMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1394,6 +1849,167 @@ func (m *UpdateElectionRuleMsg) GetQuorum() *Fraction {
 	return nil
 }
 
+func (m *UpdateElectionRuleMsg) GetProposalDeposit() *coin.Coin {
+	if m != nil {
+		return m.ProposalDeposit
+	}
+	return nil
+}
+
+func (m *UpdateElectionRuleMsg) GetDepositCollector() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.DepositCollector
+	}
+	return nil
+}
+
+func (m *UpdateElectionRuleMsg) GetExecutionDelay() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+// DelegateMsg assigns the voting power of an elector to another elector of the
+// same electorate. An existing delegation for the electorate is replaced.
+type DelegateMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ElectorateID is the reference to the electorate the delegation is valid for.
+	ElectorateID []byte `protobuf:"bytes,2,opt,name=electorate_id,json=electorateId,proto3" json:"electorate_id,omitempty"`
+	// Delegator is an optional field. When not set the main signer will be used
+	// as default. The delegator must be included in the electorate.
+	Delegator github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=delegator,proto3,casttype=github.com/iov-one/weave.Address" json:"delegator,omitempty"`
+	// Delegatee is the address receiving the voting power. The delegatee must be
+	// included in the electorate.
+	Delegatee github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=delegatee,proto3,casttype=github.com/iov-one/weave.Address" json:"delegatee,omitempty"`
+}
+
+func (m *DelegateMsg) Reset()         { *m = DelegateMsg{} }
+func (m *DelegateMsg) String() string { return proto.CompactTextString(m) }
+func (*DelegateMsg) ProtoMessage()    {}
+func (*DelegateMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{20}
+}
+func (m *DelegateMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateMsg.Merge(m, src)
+}
+func (m *DelegateMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateMsg proto.InternalMessageInfo
+
+func (m *DelegateMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DelegateMsg) GetElectorateID() []byte {
+	if m != nil {
+		return m.ElectorateID
+	}
+	return nil
+}
+
+func (m *DelegateMsg) GetDelegator() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
+func (m *DelegateMsg) GetDelegatee() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Delegatee
+	}
+	return nil
+}
+
+// RevokeDelegationMsg removes an existing delegation so that the voting power
+// of an elector is no longer counted for another elector.
+type RevokeDelegationMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ElectorateID is the reference to the electorate the delegation is valid for.
+	ElectorateID []byte `protobuf:"bytes,2,opt,name=electorate_id,json=electorateId,proto3" json:"electorate_id,omitempty"`
+	// Delegator is an optional field. When not set the main signer will be used
+	// as default.
+	Delegator github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=delegator,proto3,casttype=github.com/iov-one/weave.Address" json:"delegator,omitempty"`
+}
+
+func (m *RevokeDelegationMsg) Reset()         { *m = RevokeDelegationMsg{} }
+func (m *RevokeDelegationMsg) String() string { return proto.CompactTextString(m) }
+func (*RevokeDelegationMsg) ProtoMessage()    {}
+func (*RevokeDelegationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{21}
+}
+func (m *RevokeDelegationMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeDelegationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeDelegationMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokeDelegationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeDelegationMsg.Merge(m, src)
+}
+func (m *RevokeDelegationMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeDelegationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeDelegationMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeDelegationMsg proto.InternalMessageInfo
+
+func (m *RevokeDelegationMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RevokeDelegationMsg) GetElectorateID() []byte {
+	if m != nil {
+		return m.ElectorateID
+	}
+	return nil
+}
+
+func (m *RevokeDelegationMsg) GetDelegator() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("gov.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("gov.Proposal_Status", Proposal_Status_name, Proposal_Status_value)
@@ -1407,116 +2023,145 @@ func init() {
 	proto.RegisterType((*Resolution)(nil), "gov.Resolution")
 	proto.RegisterType((*TallyResult)(nil), "gov.TallyResult")
 	proto.RegisterType((*Vote)(nil), "gov.Vote")
+	proto.RegisterType((*VoteRecord)(nil), "gov.VoteRecord")
+	proto.RegisterType((*Veto)(nil), "gov.Veto")
+	proto.RegisterType((*Delegation)(nil), "gov.Delegation")
 	proto.RegisterType((*CreateProposalMsg)(nil), "gov.CreateProposalMsg")
 	proto.RegisterType((*DeleteProposalMsg)(nil), "gov.DeleteProposalMsg")
 	proto.RegisterType((*VoteMsg)(nil), "gov.VoteMsg")
 	proto.RegisterType((*TallyMsg)(nil), "gov.TallyMsg")
+	proto.RegisterType((*VetoMsg)(nil), "gov.VetoMsg")
+	proto.RegisterType((*ExecuteProposalMsg)(nil), "gov.ExecuteProposalMsg")
 	proto.RegisterType((*CreateTextResolutionMsg)(nil), "gov.CreateTextResolutionMsg")
 	proto.RegisterType((*UpdateElectorateMsg)(nil), "gov.UpdateElectorateMsg")
 	proto.RegisterType((*UpdateElectionRuleMsg)(nil), "gov.UpdateElectionRuleMsg")
+	proto.RegisterType((*DelegateMsg)(nil), "gov.DelegateMsg")
+	proto.RegisterType((*RevokeDelegationMsg)(nil), "gov.RevokeDelegationMsg")
 }
 
 func init() { proto.RegisterFile("x/gov/codec.proto", fileDescriptor_24f6e3c5f1b82a85) }
 
 var fileDescriptor_24f6e3c5f1b82a85 = []byte{
-	// 1547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6e, 0xe3, 0xc8,
-	0x11, 0x36, 0x25, 0x59, 0x3f, 0xa5, 0x5f, 0xf7, 0xcc, 0xee, 0x68, 0xb5, 0x13, 0x8b, 0x61, 0x66,
-	0x02, 0x67, 0x33, 0x91, 0xb3, 0x5e, 0x6c, 0x02, 0x04, 0x8b, 0x20, 0xfa, 0xe1, 0x20, 0x5c, 0x78,
-	0x24, 0xa7, 0x49, 0xd9, 0xd9, 0x13, 0x41, 0x8b, 0x6d, 0x99, 0x59, 0x89, 0xed, 0x25, 0x9b, 0xb2,
-	0xf7, 0x98, 0x5b, 0x60, 0x20, 0x40, 0x90, 0xbb, 0x1f, 0x20, 0x48, 0x4e, 0x79, 0x8a, 0x39, 0x04,
-	0xc1, 0x1c, 0x93, 0x8b, 0x10, 0x78, 0xde, 0x62, 0x90, 0x43, 0xc0, 0x6e, 0x4a, 0xa2, 0x6d, 0x8d,
-	0x62, 0x25, 0xbb, 0xc0, 0xdc, 0xc4, 0xea, 0xaf, 0xaa, 0xab, 0xab, 0xbe, 0xae, 0xaa, 0x16, 0x6c,
-	0x5d, 0xec, 0x0e, 0xe9, 0x64, 0x77, 0x40, 0x6d, 0x32, 0x68, 0x9c, 0x79, 0x94, 0x51, 0x94, 0x1c,
-	0xd2, 0x49, 0x2d, 0x1f, 0x93, 0xd4, 0x1e, 0x0e, 0xe9, 0x90, 0xf2, 0x9f, 0xbb, 0xe1, 0xaf, 0x48,
-	0x5a, 0xa6, 0xde, 0x38, 0xae, 0xa8, 0xfc, 0x3e, 0x01, 0xa0, 0x8e, 0xc8, 0x80, 0x51, 0xcf, 0x62,
-	0x04, 0xfd, 0x10, 0xb2, 0x63, 0xc2, 0x2c, 0xdb, 0x62, 0x56, 0x55, 0x92, 0xa5, 0x9d, 0xfc, 0x5e,
-	0xb9, 0x71, 0x4e, 0xac, 0x09, 0x69, 0xbc, 0x88, 0xc4, 0x78, 0x0e, 0x40, 0x55, 0xc8, 0x4c, 0x88,
-	0xe7, 0x3b, 0xd4, 0xad, 0x26, 0x64, 0x69, 0xa7, 0x88, 0x67, 0x9f, 0xe8, 0x67, 0xb0, 0x69, 0xd9,
-	0x63, 0xc7, 0xad, 0x26, 0x65, 0x69, 0xa7, 0xd0, 0x7a, 0xf2, 0x66, 0x5a, 0x97, 0x87, 0x0e, 0x3b,
-	0x0d, 0x8e, 0x1b, 0x03, 0x3a, 0xde, 0x75, 0xe8, 0xe4, 0x47, 0xd4, 0x25, 0xbb, 0xc2, 0x72, 0xd3,
-	0xb6, 0x3d, 0xe2, 0xfb, 0x58, 0xa8, 0xa0, 0x87, 0xb0, 0xc9, 0x1c, 0x36, 0x22, 0xd5, 0x94, 0x2c,
-	0xed, 0xe4, 0xb0, 0xf8, 0x40, 0x0d, 0xc8, 0x12, 0xe1, 0xa6, 0x5f, 0xdd, 0x94, 0x93, 0x3b, 0xf9,
-	0xbd, 0x42, 0x63, 0x48, 0x27, 0x8d, 0xc8, 0xf7, 0x56, 0xea, 0xe5, 0xb4, 0xbe, 0x81, 0xe7, 0x18,
-	0xf4, 0x13, 0x78, 0xc4, 0x28, 0xb3, 0x46, 0x26, 0x99, 0x1f, 0xce, 0x3c, 0x27, 0xce, 0xf0, 0x94,
-	0x55, 0xd3, 0xb2, 0xb4, 0x93, 0xc2, 0xef, 0xf1, 0xe5, 0xc5, 0xd1, 0x8f, 0xf8, 0xa2, 0x62, 0x41,
-	0x26, 0x92, 0xa1, 0x9f, 0x43, 0xc6, 0x12, 0xae, 0xf1, 0x50, 0xdc, 0xf7, 0x18, 0x33, 0x25, 0xf4,
-	0x3e, 0xa4, 0xa3, 0x1d, 0x45, 0x74, 0xa2, 0x2f, 0xe5, 0x65, 0x12, 0x0a, 0x7c, 0x0f, 0x87, 0xba,
-	0x38, 0x18, 0xbd, 0x13, 0x41, 0xff, 0x14, 0x8a, 0xb1, 0x40, 0x39, 0x36, 0x0f, 0x7e, 0xa1, 0x55,
-	0xb9, 0x9e, 0xd6, 0x0b, 0x8b, 0x18, 0x69, 0x1d, 0x5c, 0x58, 0xc0, 0x34, 0x7b, 0x91, 0xab, 0xcd,
-	0x78, 0xae, 0xba, 0x50, 0x9c, 0x50, 0xe6, 0xb8, 0x43, 0xf3, 0x8c, 0x78, 0x0e, 0xb5, 0x79, 0xc4,
-	0x8b, 0xad, 0x1f, 0xbc, 0x99, 0xd6, 0x9f, 0xbe, 0xd5, 0xa1, 0xbe, 0xeb, 0x5c, 0x74, 0x02, 0xcf,
-	0xe2, 0x51, 0x29, 0x08, 0xfd, 0x03, 0xae, 0x8e, 0x3e, 0x86, 0x1c, 0x3b, 0xf5, 0x88, 0x7f, 0x4a,
-	0x47, 0x76, 0x35, 0xc3, 0x03, 0x54, 0xe4, 0xc9, 0x7f, 0xee, 0x59, 0x3c, 0x8a, 0x51, 0xf6, 0x17,
-	0x28, 0xf4, 0x14, 0xd2, 0x5f, 0x05, 0xd4, 0x0b, 0xc6, 0xd5, 0xec, 0x12, 0x3c, 0x8e, 0x16, 0xe3,
-	0x29, 0xce, 0xfd, 0x0f, 0x29, 0x56, 0x3e, 0x87, 0xec, 0xcc, 0x26, 0x7a, 0x0c, 0x39, 0x37, 0x18,
-	0x13, 0xcf, 0x62, 0xd4, 0xe3, 0x69, 0x2c, 0xe2, 0x85, 0x00, 0xc9, 0x90, 0xb7, 0x89, 0x4b, 0xc7,
-	0x8e, 0xcb, 0xd7, 0x45, 0xea, 0xe2, 0x22, 0xe5, 0xb7, 0x79, 0xc8, 0x1e, 0x78, 0xf4, 0x8c, 0xfa,
-	0xd6, 0x68, 0x3d, 0x4a, 0xcc, 0xb3, 0x90, 0x88, 0x67, 0xe1, 0x3b, 0x00, 0x9e, 0x75, 0x6e, 0xd2,
-	0xb3, 0xd0, 0x3b, 0xc1, 0x09, 0x9c, 0xf3, 0xac, 0xf3, 0x1e, 0x17, 0x08, 0x87, 0xfc, 0x81, 0xe7,
-	0x88, 0x75, 0x71, 0xd9, 0xe2, 0x22, 0xa4, 0xc2, 0x16, 0x89, 0x68, 0x6a, 0x7a, 0xc1, 0x88, 0x98,
-	0x1e, 0x39, 0xe1, 0x89, 0xce, 0xef, 0x3d, 0x68, 0x50, 0x6f, 0xdc, 0x38, 0x14, 0xc4, 0x23, 0xb6,
-	0xd6, 0xc1, 0xe4, 0x24, 0x4a, 0x42, 0x99, 0xc4, 0xa8, 0x8d, 0xc9, 0x09, 0xfa, 0x05, 0x94, 0x62,
-	0xd4, 0x0a, 0x6d, 0xa4, 0xff, 0x9b, 0x8d, 0x18, 0x17, 0x43, 0x0b, 0xbf, 0x82, 0xad, 0x88, 0x4f,
-	0x3e, 0xb3, 0x3c, 0x66, 0x32, 0x67, 0x4c, 0x38, 0x0f, 0x92, 0xad, 0xa7, 0x6f, 0xa6, 0xf5, 0xef,
-	0xae, 0xe4, 0x94, 0xe1, 0x8c, 0x09, 0x2e, 0x0b, 0x7d, 0x3d, 0x54, 0x0f, 0x05, 0xe8, 0x05, 0x44,
-	0x22, 0x93, 0xb8, 0xb6, 0x30, 0x98, 0x5d, 0xc7, 0x60, 0x44, 0x70, 0xd5, 0xb5, 0xb9, 0xb9, 0x2e,
-	0x94, 0xfd, 0xe0, 0x78, 0xec, 0xf8, 0xe1, 0x59, 0x84, 0xb9, 0xdc, 0x3a, 0xe6, 0x4a, 0x0b, 0x6d,
-	0x6e, 0xef, 0x33, 0x48, 0x5b, 0x01, 0x3b, 0xa5, 0x5e, 0x15, 0xd6, 0xa0, 0x65, 0xa4, 0x83, 0x3e,
-	0x05, 0x98, 0x50, 0x46, 0xc2, 0x68, 0x31, 0x52, 0xcd, 0xf3, 0x68, 0x57, 0xf8, 0x05, 0x30, 0xac,
-	0xd1, 0xe8, 0x6b, 0x4c, 0xfc, 0x60, 0xc4, 0x66, 0x77, 0x26, 0x44, 0xea, 0x21, 0x10, 0x3d, 0x83,
-	0x74, 0xa8, 0x11, 0xf8, 0xd5, 0x82, 0x2c, 0xed, 0x94, 0xf6, 0x1e, 0x72, 0x95, 0x19, 0x25, 0x1b,
-	0x3a, 0x5f, 0xc3, 0x11, 0x26, 0x44, 0x7b, 0xdc, 0x50, 0xb5, 0xb8, 0x0c, 0x2d, 0x36, 0xc1, 0x11,
-	0x06, 0xa9, 0x50, 0x26, 0x17, 0x64, 0x10, 0x30, 0xea, 0x99, 0x91, 0x5a, 0x89, 0xab, 0x3d, 0xbe,
-	0xa9, 0xa6, 0x46, 0xa0, 0x48, 0xbd, 0x44, 0x6e, 0x7c, 0xa3, 0x4f, 0xa0, 0xc8, 0xc2, 0x23, 0x98,
-	0xcc, 0xf2, 0xbf, 0x0c, 0xcb, 0x54, 0x99, 0x87, 0xa7, 0x7c, 0x3d, 0xad, 0xe7, 0xf9, 0xd9, 0x0c,
-	0xcb, 0xff, 0x52, 0xeb, 0xe0, 0x3c, 0x9b, 0x7f, 0xd8, 0xca, 0x9f, 0x24, 0x48, 0x0b, 0xe7, 0xd1,
-	0x87, 0xf0, 0xe8, 0x00, 0xf7, 0x0e, 0x7a, 0x7a, 0x73, 0xdf, 0xd4, 0x8d, 0xa6, 0xd1, 0xd7, 0x4d,
-	0xad, 0x7b, 0xd8, 0xdc, 0xd7, 0x3a, 0x95, 0x0d, 0xf4, 0x0c, 0x3e, 0xb8, 0xbd, 0xa8, 0xf7, 0x5b,
-	0x2f, 0x34, 0xc3, 0x50, 0x3b, 0x15, 0xa9, 0x56, 0xbc, 0xbc, 0x92, 0x73, 0x7a, 0x98, 0x27, 0xc6,
-	0x88, 0x8d, 0xbe, 0x0f, 0xef, 0xdf, 0x46, 0xb7, 0xf7, 0x7b, 0xba, 0xda, 0xa9, 0x24, 0x6a, 0x70,
-	0x79, 0x25, 0xa7, 0xdb, 0x23, 0xea, 0x13, 0x7b, 0x99, 0xd5, 0x23, 0xcd, 0xf8, 0x65, 0x07, 0x37,
-	0x8f, 0xba, 0x95, 0xa4, 0xb0, 0x7a, 0xe4, 0xb0, 0x53, 0xdb, 0xb3, 0xce, 0x5d, 0xe5, 0xcf, 0x12,
-	0xa4, 0xa3, 0xb3, 0xc6, 0x7d, 0xc5, 0xaa, 0xde, 0xdf, 0x37, 0xde, 0xe2, 0x6b, 0xb4, 0xd8, 0xef,
-	0x76, 0xd4, 0xe7, 0x5a, 0x77, 0xe1, 0x6b, 0xdf, 0xb5, 0xc9, 0x89, 0xe3, 0x12, 0x1b, 0x7d, 0x04,
-	0xd5, 0xdb, 0xe8, 0x66, 0xbb, 0xad, 0x1e, 0x18, 0xdc, 0xdb, 0xc2, 0xe5, 0x95, 0x9c, 0x6d, 0x0e,
-	0x06, 0xe4, 0x8c, 0x2d, 0xc7, 0x62, 0xf5, 0x73, 0xb5, 0x1d, 0x62, 0x93, 0x02, 0x8b, 0xc9, 0x6f,
-	0xc8, 0x80, 0x11, 0x5b, 0xf9, 0xbb, 0x04, 0xa5, 0x9b, 0x19, 0x43, 0x4f, 0x40, 0x9e, 0xab, 0xab,
-	0xbf, 0x56, 0xdb, 0x7d, 0xa3, 0x87, 0xef, 0xba, 0xff, 0xe3, 0x15, 0xa8, 0x6e, 0xcf, 0x30, 0x71,
-	0xbf, 0x5b, 0x91, 0x44, 0x18, 0xbb, 0x94, 0xe1, 0xc0, 0x45, 0x1f, 0xaf, 0xd0, 0xd0, 0xfb, 0xed,
-	0xb6, 0xaa, 0xeb, 0x95, 0x44, 0x2d, 0x7f, 0x79, 0x25, 0x67, 0xf4, 0x60, 0x30, 0x08, 0xfb, 0xef,
-	0x2a, 0x95, 0xe7, 0x4d, 0x6d, 0xbf, 0x8f, 0xd5, 0x4a, 0x52, 0xa8, 0x3c, 0xb7, 0x9c, 0x51, 0xe0,
-	0x11, 0xe5, 0x6f, 0x12, 0x00, 0x26, 0x3e, 0x1d, 0x05, 0xbc, 0x02, 0xae, 0x55, 0x85, 0x77, 0x21,
-	0x7f, 0x16, 0xd1, 0x38, 0x64, 0x66, 0x82, 0x33, 0xb3, 0x74, 0x3d, 0xad, 0xc3, 0x8c, 0xdd, 0x5a,
-	0x07, 0xc3, 0x0c, 0xa2, 0xd9, 0x4b, 0x0a, 0x63, 0x72, 0xcd, 0xc2, 0xb8, 0x0d, 0xe0, 0xcd, 0xbd,
-	0x8d, 0x4a, 0x78, 0x4c, 0xa2, 0xfc, 0x5b, 0x82, 0x7c, 0xec, 0xca, 0xa3, 0x0f, 0x21, 0x27, 0x86,
-	0xa2, 0xaf, 0x89, 0x98, 0x69, 0x52, 0x38, 0xcb, 0x05, 0x5f, 0x10, 0x1f, 0x7d, 0x00, 0xe2, 0xb7,
-	0xe9, 0x52, 0xee, 0x7c, 0x0a, 0x67, 0xf8, 0x77, 0x97, 0xa2, 0xef, 0x41, 0x51, 0x2c, 0x59, 0xc7,
-	0x3e, 0xb3, 0xa2, 0x09, 0x23, 0x85, 0x0b, 0x5c, 0xd8, 0x14, 0xb2, 0x55, 0x13, 0x57, 0x6a, 0xc5,
-	0xc4, 0x15, 0x6b, 0xd5, 0x9b, 0xab, 0x5a, 0xf5, 0x8d, 0x21, 0x20, 0x7d, 0x9f, 0x21, 0x40, 0xf9,
-	0x9d, 0x04, 0xa9, 0x43, 0xba, 0xee, 0x54, 0xfb, 0x0c, 0x32, 0xd1, 0x09, 0x78, 0x18, 0x96, 0x0f,
-	0x9a, 0x33, 0x08, 0x7a, 0x0a, 0x9b, 0x61, 0x05, 0xb5, 0x79, 0x48, 0x4a, 0x7b, 0x65, 0x8e, 0x0d,
-	0x37, 0x15, 0x6d, 0x16, 0x8b, 0x55, 0xe5, 0x9f, 0x09, 0xd8, 0x6a, 0x7b, 0xc4, 0x62, 0x64, 0x46,
-	0x86, 0x17, 0xfe, 0xf0, 0x9d, 0xe8, 0xf2, 0x9f, 0x41, 0xe5, 0x66, 0x97, 0x77, 0x6c, 0x9e, 0x88,
-	0x42, 0x0b, 0x5d, 0x4f, 0xeb, 0xa5, 0xf8, 0xa0, 0xaa, 0x75, 0x70, 0x29, 0xde, 0xdd, 0x35, 0x1b,
-	0x75, 0x00, 0x62, 0x3d, 0x39, 0xbd, 0x4e, 0xcf, 0xcb, 0xf9, 0xf3, 0x6e, 0xbc, 0x68, 0x77, 0x99,
-	0xf5, 0xdb, 0x9d, 0xf2, 0x15, 0x6c, 0x75, 0xc8, 0x88, 0xfc, 0x1f, 0xa1, 0x5d, 0xf7, 0xea, 0x2a,
-	0xaf, 0x24, 0xc8, 0x84, 0x49, 0xfe, 0xd6, 0x77, 0x0a, 0x87, 0xfa, 0x90, 0x41, 0xde, 0x7a, 0x43,
-	0x3d, 0x57, 0x09, 0x3d, 0xf3, 0x79, 0xbe, 0x88, 0x98, 0xe7, 0x97, 0xd0, 0x73, 0x0e, 0x50, 0x4e,
-	0x21, 0xcb, 0x4b, 0xc5, 0xb7, 0x1f, 0xbc, 0x13, 0x78, 0x24, 0xae, 0x82, 0x41, 0x2e, 0xd8, 0xa2,
-	0xda, 0xae, 0xbd, 0xf1, 0xcd, 0xea, 0x97, 0xb8, 0x53, 0xfd, 0xfe, 0x2a, 0xc1, 0x83, 0xfe, 0x99,
-	0x6d, 0x31, 0xb2, 0xa8, 0x39, 0x6b, 0x6f, 0x72, 0xe7, 0x61, 0x94, 0xb8, 0xd7, 0xc3, 0xe8, 0xa7,
-	0x50, 0xb4, 0x9d, 0x93, 0x13, 0x73, 0xfe, 0x66, 0x4d, 0xbe, 0xf5, 0xcd, 0x5a, 0x08, 0x81, 0x91,
-	0xc8, 0x57, 0xfe, 0x92, 0x80, 0xf7, 0x62, 0x4e, 0x47, 0x37, 0x6d, 0x6d, 0xb7, 0x97, 0xdd, 0xea,
-	0xc4, 0xbd, 0x6f, 0xf5, 0x9d, 0x07, 0x5c, 0xf2, 0x1b, 0x7c, 0xc0, 0xa5, 0xd6, 0x7c, 0xc0, 0xad,
-	0xea, 0x0a, 0x1f, 0xfd, 0x51, 0x02, 0x58, 0xd0, 0x19, 0x3d, 0x81, 0x07, 0x87, 0x3d, 0x43, 0x35,
-	0x7b, 0x07, 0x86, 0xd6, 0xeb, 0x2e, 0x06, 0x0e, 0xd1, 0xe5, 0x35, 0x77, 0x62, 0x8d, 0x1c, 0x1b,
-	0x3d, 0x86, 0x72, 0x1c, 0xf5, 0x85, 0xaa, 0x57, 0xa4, 0x5a, 0xe6, 0xf2, 0x4a, 0x4e, 0x86, 0x7d,
-	0xb0, 0x06, 0xa5, 0xf8, 0x6a, 0xb7, 0x57, 0x49, 0xd4, 0xd2, 0x97, 0x57, 0x72, 0xa2, 0x4b, 0x6f,
-	0xdb, 0x6f, 0xb6, 0x74, 0xa3, 0xa9, 0x75, 0x67, 0x53, 0x44, 0xd4, 0x09, 0x5b, 0xd5, 0x97, 0xd7,
-	0xdb, 0xd2, 0xab, 0xeb, 0x6d, 0xe9, 0x5f, 0xd7, 0xdb, 0xd2, 0x1f, 0x5e, 0x6f, 0x6f, 0xbc, 0x7a,
-	0xbd, 0xbd, 0xf1, 0x8f, 0xd7, 0xdb, 0x1b, 0xc7, 0x69, 0xfe, 0xa7, 0xcb, 0x27, 0xff, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0x57, 0xd4, 0x04, 0x1d, 0xc2, 0x11, 0x00, 0x00,
+	// 1893 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0x14, 0x3f, 0x1e, 0x3f, 0x35, 0x72, 0xe2, 0x8d, 0xe2, 0x4a, 0xec, 0xd6, 0x2e,
+	0xd4, 0xd4, 0xa5, 0x1a, 0x05, 0x6e, 0x81, 0x22, 0x48, 0xc3, 0x8f, 0x75, 0xcb, 0x40, 0x26, 0x95,
+	0xe1, 0x52, 0x6e, 0x4e, 0x8b, 0x35, 0x77, 0x44, 0x6d, 0x4d, 0xee, 0x28, 0xbb, 0x43, 0xca, 0x3e,
+	0xf6, 0x56, 0xe8, 0x54, 0xf4, 0xd6, 0x83, 0xae, 0x2d, 0x7a, 0x2b, 0x72, 0xef, 0x3d, 0x87, 0x1e,
+	0x0c, 0xf4, 0xd2, 0x5e, 0x84, 0x42, 0xfe, 0x27, 0x5a, 0xa3, 0x87, 0x62, 0x66, 0x96, 0xdc, 0xd5,
+	0x87, 0x59, 0xad, 0x13, 0x05, 0xce, 0x8d, 0xfb, 0xe6, 0xf7, 0xde, 0xbc, 0x79, 0x5f, 0xf3, 0xde,
+	0x10, 0x96, 0x9f, 0x6e, 0x0e, 0xe9, 0x74, 0x73, 0x40, 0x6d, 0x32, 0xa8, 0x1d, 0x78, 0x94, 0x51,
+	0x94, 0x1c, 0xd2, 0xe9, 0x6a, 0x3e, 0x42, 0x59, 0xad, 0x0c, 0xa8, 0xe3, 0x46, 0x31, 0xab, 0x37,
+	0x87, 0x74, 0x48, 0xc5, 0xcf, 0x4d, 0xfe, 0x2b, 0xa0, 0x96, 0xa9, 0x37, 0x8e, 0xc2, 0xb4, 0x2f,
+	0x12, 0x00, 0xfa, 0x88, 0x0c, 0x18, 0xf5, 0x2c, 0x46, 0xd0, 0x0f, 0x21, 0x3b, 0x26, 0xcc, 0xb2,
+	0x2d, 0x66, 0xa9, 0x4a, 0x55, 0xd9, 0xc8, 0x6f, 0x95, 0x6b, 0x87, 0xc4, 0x9a, 0x92, 0xda, 0xc3,
+	0x80, 0x8c, 0xe7, 0x00, 0xa4, 0x42, 0x66, 0x4a, 0x3c, 0xdf, 0xa1, 0xae, 0x9a, 0xa8, 0x2a, 0x1b,
+	0x45, 0x3c, 0xfb, 0x44, 0x3f, 0x83, 0x25, 0xcb, 0x1e, 0x3b, 0xae, 0x9a, 0xac, 0x2a, 0x1b, 0x85,
+	0xc6, 0x9d, 0x97, 0x27, 0xeb, 0xd5, 0xa1, 0xc3, 0xf6, 0x27, 0x8f, 0x6b, 0x03, 0x3a, 0xde, 0x74,
+	0xe8, 0xf4, 0x47, 0xd4, 0x25, 0x9b, 0x52, 0x72, 0xdd, 0xb6, 0x3d, 0xe2, 0xfb, 0x58, 0xb2, 0xa0,
+	0x9b, 0xb0, 0xc4, 0x1c, 0x36, 0x22, 0x6a, 0xaa, 0xaa, 0x6c, 0xe4, 0xb0, 0xfc, 0x40, 0x35, 0xc8,
+	0x12, 0xa9, 0xa6, 0xaf, 0x2e, 0x55, 0x93, 0x1b, 0xf9, 0xad, 0x42, 0x6d, 0x48, 0xa7, 0xb5, 0x40,
+	0xf7, 0x46, 0xea, 0xcb, 0x93, 0xf5, 0x1b, 0x78, 0x8e, 0x41, 0x3f, 0x81, 0x5b, 0x8c, 0x32, 0x6b,
+	0x64, 0x92, 0xf9, 0xe1, 0xcc, 0x43, 0xe2, 0x0c, 0xf7, 0x99, 0x9a, 0xae, 0x2a, 0x1b, 0x29, 0xfc,
+	0x96, 0x58, 0x0e, 0x8f, 0xfe, 0x48, 0x2c, 0xa2, 0xef, 0x41, 0x51, 0xc2, 0x4c, 0xe6, 0x0c, 0x9e,
+	0x10, 0x4f, 0xcd, 0x08, 0x2d, 0x0a, 0x92, 0x68, 0x08, 0x9a, 0x66, 0x41, 0x26, 0x60, 0x44, 0x1f,
+	0x41, 0xc6, 0x92, 0xfa, 0xab, 0x4a, 0x8c, 0xb3, 0xce, 0x98, 0xd0, 0xdb, 0x90, 0x0e, 0xd4, 0x92,
+	0x26, 0x0c, 0xbe, 0xb4, 0x3f, 0x2e, 0x41, 0x41, 0xec, 0xe1, 0x50, 0x17, 0x4f, 0x46, 0x6f, 0x84,
+	0x67, 0xee, 0x43, 0x31, 0x62, 0x4d, 0xc7, 0x16, 0x1e, 0x2a, 0x34, 0x2a, 0xa7, 0x27, 0xeb, 0x85,
+	0xd0, 0x90, 0xed, 0x16, 0x2e, 0x84, 0xb0, 0xb6, 0x1d, 0x3a, 0x74, 0x29, 0xea, 0xd0, 0x0e, 0x14,
+	0xa7, 0x94, 0x39, 0xee, 0xd0, 0x3c, 0x20, 0x9e, 0x43, 0x6d, 0xe1, 0x96, 0x62, 0xe3, 0x07, 0x2f,
+	0x4f, 0xd6, 0xef, 0xbe, 0x52, 0xa1, 0xbe, 0xeb, 0x3c, 0x6d, 0x4d, 0x3c, 0x4b, 0x58, 0xa5, 0x20,
+	0xf9, 0x77, 0x04, 0x3b, 0x7a, 0x1f, 0x72, 0x6c, 0xdf, 0x23, 0xfe, 0x3e, 0x1d, 0xd9, 0xc2, 0x69,
+	0xf9, 0xad, 0xa2, 0x88, 0x90, 0x07, 0x9e, 0x25, 0xac, 0x18, 0x84, 0x48, 0x88, 0x42, 0x77, 0x21,
+	0xfd, 0xf9, 0x84, 0x7a, 0x93, 0xb1, 0x9a, 0xbd, 0x04, 0x8f, 0x83, 0xc5, 0xa8, 0x8b, 0x73, 0xaf,
+	0xe3, 0xe2, 0xfb, 0x50, 0x39, 0xf0, 0xe8, 0x01, 0xf5, 0xad, 0x91, 0x69, 0x93, 0x03, 0xea, 0x3b,
+	0x4c, 0x05, 0xb1, 0x21, 0xd4, 0x78, 0xda, 0xd6, 0x9a, 0xd4, 0x71, 0x71, 0x79, 0x86, 0x69, 0x49,
+	0x08, 0xfa, 0x14, 0x96, 0x03, 0xb4, 0x39, 0xa0, 0x23, 0x69, 0x50, 0x35, 0x1f, 0x43, 0x81, 0x4a,
+	0xc0, 0xde, 0x9c, 0x71, 0x23, 0x0c, 0x65, 0xf2, 0x94, 0x0c, 0x26, 0xfc, 0x78, 0xa6, 0x4d, 0x46,
+	0xd6, 0x33, 0xb5, 0x10, 0xd7, 0xea, 0xa5, 0xb9, 0x84, 0x16, 0x17, 0xa0, 0x7d, 0x02, 0xd9, 0x99,
+	0xc5, 0xd0, 0x6d, 0xc8, 0xb9, 0x93, 0x31, 0xf1, 0x2c, 0xae, 0xaa, 0x22, 0x02, 0x2f, 0x24, 0xa0,
+	0x2a, 0xe4, 0x6d, 0xe2, 0xd2, 0xb1, 0xe3, 0x8a, 0x75, 0x19, 0x98, 0x51, 0x92, 0xf6, 0x97, 0x22,
+	0x64, 0x77, 0x02, 0x33, 0xc4, 0x0b, 0xf8, 0x79, 0x8c, 0x25, 0xa2, 0x31, 0xf6, 0x1d, 0x00, 0xcf,
+	0x3a, 0x34, 0xe9, 0x01, 0xd7, 0x4e, 0x46, 0x3c, 0xce, 0x79, 0xd6, 0x61, 0x57, 0x10, 0xa4, 0x42,
+	0xfe, 0xc0, 0x73, 0xe4, 0xba, 0xac, 0x37, 0x51, 0x12, 0xd2, 0x61, 0x99, 0x04, 0x49, 0x68, 0x7a,
+	0x93, 0x11, 0x31, 0x3d, 0xb2, 0x27, 0xc2, 0x38, 0xbf, 0xb5, 0x52, 0xa3, 0xde, 0xb8, 0xb6, 0x2b,
+	0xd3, 0x8a, 0xd8, 0xed, 0x16, 0x26, 0x7b, 0x41, 0x88, 0x95, 0x49, 0x24, 0x71, 0x31, 0xd9, 0x43,
+	0x1f, 0x43, 0x29, 0x92, 0x38, 0x5c, 0x46, 0xfa, 0xff, 0xc9, 0x88, 0x64, 0x1a, 0x97, 0xf0, 0x29,
+	0x2c, 0x07, 0xd9, 0xe2, 0x33, 0xcb, 0xe3, 0xc5, 0x69, 0x4c, 0x44, 0x94, 0x27, 0x1b, 0x77, 0x5f,
+	0x9e, 0xac, 0x7f, 0x77, 0xa1, 0xef, 0x0c, 0x67, 0x4c, 0x70, 0x59, 0xf2, 0xf7, 0x38, 0x3b, 0x27,
+	0xa0, 0x87, 0x10, 0x90, 0x4c, 0xe2, 0xda, 0x52, 0x60, 0x36, 0x8e, 0xc0, 0x20, 0x7d, 0x75, 0xd7,
+	0x16, 0xe2, 0x3a, 0x50, 0xf6, 0x27, 0x8f, 0xc7, 0x8e, 0xcf, 0xcf, 0x22, 0xc5, 0xe5, 0xe2, 0x88,
+	0x2b, 0x85, 0xdc, 0x42, 0xde, 0x87, 0x90, 0xb6, 0x26, 0x6c, 0x9f, 0x7a, 0x2a, 0xc4, 0x88, 0xf9,
+	0x80, 0x07, 0xdd, 0x07, 0x98, 0x52, 0x46, 0xb8, 0xb5, 0x18, 0x11, 0x59, 0x93, 0xdf, 0xaa, 0x88,
+	0xf4, 0x36, 0xac, 0xd1, 0xe8, 0x19, 0x26, 0xfe, 0x64, 0xc4, 0x66, 0x15, 0x81, 0x23, 0x7b, 0x1c,
+	0x88, 0xee, 0x41, 0x9a, 0x73, 0x4c, 0x7c, 0x91, 0x17, 0xa5, 0xad, 0x9b, 0x82, 0x65, 0x16, 0x92,
+	0xb5, 0x9e, 0x58, 0xc3, 0x01, 0x86, 0xa3, 0x3d, 0x21, 0x48, 0x2d, 0x5e, 0x86, 0x96, 0x9b, 0xe0,
+	0x00, 0x83, 0xf4, 0x59, 0xf2, 0x51, 0xcf, 0x0c, 0xd8, 0x4a, 0x82, 0xed, 0xf6, 0x59, 0x36, 0x3d,
+	0x00, 0x05, 0xec, 0x25, 0x72, 0xe6, 0x1b, 0x7d, 0x00, 0x45, 0xc6, 0x8f, 0x60, 0x32, 0xcb, 0x7f,
+	0xc2, 0x8b, 0x70, 0x59, 0x98, 0xa7, 0x7c, 0x7a, 0xb2, 0x9e, 0x17, 0x67, 0x33, 0x2c, 0xff, 0x49,
+	0xbb, 0x85, 0xf3, 0x6c, 0xfe, 0x61, 0xa3, 0x3b, 0x90, 0x99, 0x55, 0x9e, 0xca, 0x85, 0xca, 0x33,
+	0x5b, 0x42, 0xdb, 0x10, 0x26, 0xb7, 0xf4, 0xe0, 0x72, 0xac, 0x80, 0x98, 0x33, 0x0b, 0x07, 0xfe,
+	0x1c, 0x96, 0x23, 0xd2, 0x02, 0x65, 0x91, 0x50, 0x76, 0xe5, 0xf4, 0x64, 0xbd, 0xac, 0xcf, 0xd1,
+	0x52, 0xe1, 0x32, 0x39, 0x43, 0xb0, 0x79, 0xf6, 0xca, 0x2b, 0x7c, 0x4a, 0x18, 0x55, 0x57, 0xc4,
+	0xad, 0x9d, 0x13, 0x94, 0x5d, 0xc2, 0xa8, 0xf6, 0x67, 0x05, 0xd2, 0xd2, 0x21, 0xe8, 0x5d, 0xb8,
+	0xb5, 0x83, 0xbb, 0x3b, 0xdd, 0x5e, 0x7d, 0xdb, 0xec, 0x19, 0x75, 0xa3, 0xdf, 0x33, 0xdb, 0x9d,
+	0xdd, 0xfa, 0x76, 0xbb, 0x55, 0xb9, 0x81, 0xee, 0xc1, 0x3b, 0xe7, 0x17, 0x7b, 0xfd, 0xc6, 0xc3,
+	0xb6, 0x61, 0xe8, 0xad, 0x8a, 0xb2, 0x5a, 0x3c, 0x3a, 0xae, 0xe6, 0x7a, 0x3c, 0xf6, 0x18, 0x23,
+	0x36, 0xfa, 0x3e, 0xbc, 0x7d, 0x1e, 0xdd, 0xdc, 0xee, 0xf6, 0xf4, 0x56, 0x25, 0xb1, 0x0a, 0x47,
+	0xc7, 0xd5, 0x74, 0x73, 0x44, 0x7d, 0x62, 0x5f, 0x26, 0xf5, 0x51, 0xdb, 0xf8, 0x65, 0x0b, 0xd7,
+	0x1f, 0x75, 0x2a, 0x49, 0x29, 0xf5, 0x91, 0xc3, 0xf6, 0x6d, 0xcf, 0x3a, 0x74, 0xb5, 0xbf, 0x2b,
+	0x90, 0x0e, 0xfc, 0x17, 0xd5, 0x15, 0xeb, 0xbd, 0xfe, 0xb6, 0xf1, 0x0a, 0x5d, 0x83, 0xc5, 0x7e,
+	0xa7, 0xa5, 0x3f, 0x68, 0x77, 0x42, 0x5d, 0xfb, 0xae, 0x4d, 0xf6, 0x1c, 0x97, 0xd8, 0xe8, 0x3d,
+	0x50, 0xcf, 0xa3, 0xeb, 0xcd, 0xa6, 0xbe, 0x63, 0x08, 0x6d, 0x0b, 0x47, 0xc7, 0xd5, 0x6c, 0x7d,
+	0x30, 0x20, 0x07, 0xec, 0x72, 0x2c, 0xd6, 0x3f, 0xd1, 0x9b, 0x1c, 0x9b, 0x94, 0x58, 0x4c, 0x7e,
+	0x4d, 0x06, 0xe7, 0x6d, 0x10, 0x60, 0x77, 0x75, 0xa3, 0xab, 0xb7, 0x2a, 0x29, 0x69, 0x03, 0x6e,
+	0x7f, 0x62, 0x6b, 0xbf, 0x49, 0x40, 0xe9, 0x6c, 0xb4, 0xa2, 0x3b, 0x50, 0x9d, 0xb3, 0xea, 0xbf,
+	0xd2, 0x9b, 0x7d, 0xa3, 0x8b, 0x2f, 0x1e, 0xf3, 0xc7, 0x0b, 0x50, 0x9d, 0xae, 0x61, 0xe2, 0x7e,
+	0xa7, 0xa2, 0xc8, 0xad, 0x3a, 0x94, 0xe1, 0x89, 0x8b, 0xde, 0x5f, 0xc0, 0xd1, 0xeb, 0x37, 0x9b,
+	0x7a, 0xaf, 0x57, 0x49, 0xac, 0xe6, 0x8f, 0x8e, 0xab, 0x99, 0xde, 0x64, 0x30, 0xe0, 0xd7, 0xee,
+	0x22, 0x96, 0x07, 0xf5, 0xf6, 0x76, 0x1f, 0xeb, 0x95, 0xa4, 0x64, 0x79, 0x60, 0x39, 0xa3, 0x89,
+	0x47, 0x16, 0xb2, 0xec, 0xe8, 0x9d, 0x56, 0xbb, 0xf3, 0x8b, 0x4a, 0x4a, 0xb2, 0xec, 0x10, 0xd7,
+	0x76, 0xdc, 0xa1, 0xf6, 0x37, 0x05, 0x00, 0x13, 0x9f, 0x8e, 0x44, 0xe4, 0xc6, 0xbb, 0xb4, 0x36,
+	0x21, 0x3f, 0x6f, 0x0c, 0x1c, 0x5b, 0x5c, 0x5d, 0x85, 0x46, 0xe9, 0xf4, 0x64, 0x1d, 0x66, 0xc5,
+	0xa0, 0xdd, 0xc2, 0x30, 0x83, 0xb4, 0xed, 0x4b, 0xee, 0x91, 0x64, 0xcc, 0x7b, 0x64, 0x0d, 0xc0,
+	0x9b, 0x6b, 0x1b, 0xdc, 0x78, 0x11, 0x8a, 0xf6, 0x5f, 0x05, 0xf2, 0x91, 0x0a, 0x89, 0xde, 0x05,
+	0x99, 0x71, 0xe6, 0x33, 0x22, 0x1b, 0xdc, 0x14, 0xce, 0x0a, 0xc2, 0x67, 0xc4, 0x47, 0xef, 0x80,
+	0xfc, 0x6d, 0xba, 0x54, 0x28, 0x9f, 0xc2, 0x19, 0xf1, 0xdd, 0xa1, 0xbc, 0x8d, 0x96, 0x4b, 0xd6,
+	0x63, 0x9f, 0x59, 0x41, 0xbb, 0x99, 0xc2, 0x05, 0x41, 0xac, 0x4b, 0xda, 0xa2, 0x1e, 0x3d, 0xb5,
+	0xa8, 0x47, 0x0f, 0xfb, 0xb6, 0xa5, 0x45, 0x7d, 0xdb, 0x99, 0x8e, 0x30, 0x7d, 0x95, 0x8e, 0x50,
+	0xfb, 0xad, 0x02, 0xa9, 0x5d, 0x1a, 0x77, 0x0e, 0xba, 0x07, 0x99, 0xe0, 0x04, 0xc2, 0x0c, 0x97,
+	0x8f, 0x26, 0x33, 0x08, 0xba, 0x0b, 0x4b, 0xfc, 0xc2, 0xb1, 0x85, 0x49, 0x4a, 0x5b, 0x65, 0x81,
+	0xe5, 0x9b, 0xca, 0xae, 0x04, 0xcb, 0x55, 0xed, 0x0f, 0x09, 0x00, 0x4e, 0xc5, 0x64, 0x40, 0x3d,
+	0xfb, 0x9a, 0x03, 0x2b, 0x72, 0x82, 0x64, 0x8c, 0x13, 0xa4, 0x16, 0x9d, 0x00, 0x7d, 0x0c, 0x59,
+	0xf1, 0xc3, 0xb4, 0x98, 0xba, 0x14, 0xe7, 0x22, 0xc9, 0x08, 0xb6, 0x3a, 0xe3, 0xc3, 0xd1, 0x7e,
+	0x38, 0xb3, 0x25, 0x71, 0xf0, 0xa5, 0x59, 0x90, 0xe2, 0x25, 0xe8, 0x1a, 0xbd, 0xa4, 0xfd, 0x5b,
+	0x01, 0x68, 0x91, 0x11, 0x19, 0x5a, 0xf1, 0xf3, 0xfa, 0xc2, 0x9c, 0x94, 0xb8, 0xd2, 0x9c, 0xd4,
+	0x80, 0x9c, 0x2d, 0x77, 0x0c, 0xdc, 0x70, 0xd5, 0xa6, 0x27, 0x64, 0x8b, 0xc8, 0x20, 0x44, 0x4d,
+	0xbd, 0x86, 0x0c, 0x42, 0xb4, 0x7f, 0x26, 0x60, 0xb9, 0xe9, 0x11, 0x8b, 0x91, 0x59, 0xb4, 0x3c,
+	0xf4, 0x87, 0x6f, 0x44, 0x3b, 0xfe, 0x21, 0x54, 0xce, 0xb6, 0xe3, 0x8e, 0x2d, 0x22, 0xab, 0xd0,
+	0x40, 0xa7, 0x27, 0xeb, 0xa5, 0xe8, 0xbc, 0xdc, 0x6e, 0xe1, 0x52, 0xb4, 0x0d, 0x6f, 0xdb, 0xa8,
+	0x05, 0x10, 0x69, 0x9e, 0xd3, 0x71, 0x22, 0x32, 0xe7, 0xcf, 0xdb, 0xe6, 0xb0, 0x2f, 0xcd, 0xc4,
+	0xef, 0x4b, 0xb5, 0xcf, 0x61, 0x99, 0x47, 0xd5, 0x57, 0x30, 0x6d, 0xdc, 0xdc, 0xd6, 0x9e, 0x2b,
+	0x90, 0xe1, 0xc9, 0x79, 0xed, 0x3b, 0xf1, 0xb7, 0x05, 0x9e, 0xb9, 0xf1, 0x82, 0x57, 0xb2, 0x70,
+	0xcd, 0x7c, 0xe1, 0xaf, 0x57, 0x97, 0x95, 0x39, 0x40, 0xdb, 0x87, 0xac, 0xb8, 0xa4, 0xae, 0xdf,
+	0x78, 0x7f, 0xe2, 0xc6, 0x23, 0x8c, 0x5e, 0xbf, 0xf1, 0x3e, 0x3a, 0x5b, 0x82, 0xaf, 0xfc, 0xca,
+	0x30, 0x2b, 0x58, 0x1e, 0x20, 0xd9, 0x8b, 0x7d, 0x83, 0xa1, 0xb5, 0x07, 0xb7, 0x64, 0xa1, 0x30,
+	0xc8, 0x53, 0x16, 0x76, 0x41, 0xb1, 0x37, 0x3e, 0xdb, 0x95, 0x24, 0x2e, 0x74, 0x25, 0x5f, 0x28,
+	0xb0, 0xd2, 0x3f, 0xb0, 0x2d, 0x46, 0xc2, 0xf2, 0x19, 0x7b, 0x93, 0xd7, 0xac, 0xca, 0x3f, 0x85,
+	0xa2, 0xed, 0xec, 0xed, 0x99, 0xf3, 0xd7, 0xc7, 0xe4, 0x2b, 0x5f, 0x1f, 0x0b, 0x1c, 0x18, 0x90,
+	0x7c, 0xed, 0x28, 0x05, 0x6f, 0x45, 0x94, 0x0e, 0xea, 0x50, 0x6c, 0xb5, 0x2f, 0xab, 0x79, 0x89,
+	0x2b, 0xd7, 0xbc, 0x0b, 0xaf, 0x6c, 0xc9, 0xaf, 0xf1, 0x95, 0x2d, 0x15, 0xf3, 0x95, 0x6d, 0x61,
+	0xb7, 0x76, 0xd9, 0x2b, 0x59, 0xfa, 0x35, 0x5f, 0xc9, 0x32, 0x5f, 0xf7, 0x2b, 0x59, 0xf6, 0xab,
+	0xbe, 0x92, 0xfd, 0x47, 0x81, 0x7c, 0xd0, 0x4e, 0x7c, 0x63, 0x91, 0xfb, 0xa6, 0xf4, 0x13, 0x7f,
+	0x55, 0x60, 0x05, 0x93, 0x29, 0x7d, 0x42, 0xc2, 0x86, 0xea, 0x5b, 0x64, 0x83, 0xf7, 0x7e, 0xaf,
+	0x00, 0x84, 0xd7, 0x10, 0xba, 0x03, 0x2b, 0xbb, 0x5d, 0x43, 0x37, 0xbb, 0x3b, 0x46, 0xbb, 0xdb,
+	0x09, 0xa7, 0x5a, 0x39, 0x17, 0xb6, 0xdd, 0xa9, 0x35, 0x72, 0x6c, 0x74, 0x1b, 0xca, 0x51, 0xd4,
+	0x67, 0x7a, 0xaf, 0xa2, 0xac, 0x66, 0x8e, 0x8e, 0xab, 0x49, 0x3e, 0x39, 0xad, 0x42, 0x29, 0xba,
+	0xda, 0xe9, 0x56, 0x12, 0xab, 0xe9, 0xa3, 0xe3, 0x6a, 0xa2, 0x43, 0xcf, 0xcb, 0xaf, 0x37, 0x7a,
+	0x46, 0xbd, 0xdd, 0x99, 0x8d, 0xaa, 0xc1, 0xec, 0xd4, 0x50, 0xbf, 0x3c, 0x5d, 0x53, 0x9e, 0x9f,
+	0xae, 0x29, 0xff, 0x3a, 0x5d, 0x53, 0x7e, 0xf7, 0x62, 0xed, 0xc6, 0xf3, 0x17, 0x6b, 0x37, 0xfe,
+	0xf1, 0x62, 0xed, 0xc6, 0xe3, 0xb4, 0xf8, 0x63, 0xe7, 0x83, 0xff, 0x0d, 0x00, 0x31, 0x11, 0xc6,
+	0x7b, 0x38, 0x1a, 0x00, 0x00,
 }
 
 func (m *Electorate) Marshal() (dAtA []byte, err error) {
@@ -1578,6 +2223,12 @@ func (m *Electorate) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TotalElectorateWeight))
 	}
+	if len(m.WeightTicker) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.WeightTicker)))
+		i += copy(dAtA[i:], m.WeightTicker)
+	}
 	return i, nil
 }
 
@@ -1687,6 +2338,27 @@ func (m *ElectionRule) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
 		i += copy(dAtA[i:], m.Address)
 	}
+	if m.ProposalDeposit != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ProposalDeposit.Size()))
+		n5, err := m.ProposalDeposit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.DepositCollector) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.DepositCollector)))
+		i += copy(dAtA[i:], m.DepositCollector)
+	}
+	if m.ExecutionDelay != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecutionDelay))
+	}
 	return i, nil
 }
 
@@ -1737,11 +2409,11 @@ func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	if len(m.Title) > 0 {
 		dAtA[i] = 0x12
@@ -1764,19 +2436,19 @@ func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.ElectionRuleRef.Size()))
-	n6, err := m.ElectionRuleRef.MarshalTo(dAtA[i:])
+	n7, err := m.ElectionRuleRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n7
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.ElectorateRef.Size()))
-	n7, err := m.ElectorateRef.MarshalTo(dAtA[i:])
+	n8, err := m.ElectorateRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n8
 	if m.VotingStartTime != 0 {
 		dAtA[i] = 0x38
 		i++
@@ -1801,11 +2473,11 @@ func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x5a
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.VoteState.Size()))
-	n8, err := m.VoteState.MarshalTo(dAtA[i:])
+	n9, err := m.VoteState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	if m.Status != 0 {
 		dAtA[i] = 0x60
 		i++
@@ -1827,6 +2499,40 @@ func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.TallyTaskID)))
 		i += copy(dAtA[i:], m.TallyTaskID)
 	}
+	if m.Deposit != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Deposit.Size()))
+		n10, err := m.Deposit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.ExecutionTime != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecutionTime))
+	}
+	if len(m.ExecutionTaskID) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ExecutionTaskID)))
+		i += copy(dAtA[i:], m.ExecutionTaskID)
+	}
+	if m.TotalVeto != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TotalVeto))
+	}
 	return i, nil
 }
 
@@ -1849,11 +2555,11 @@ func (m *Resolution) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+		n11, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n11
 	}
 	if len(m.ProposalID) > 0 {
 		dAtA[i] = 0x12
@@ -1864,11 +2570,11 @@ func (m *Resolution) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.ElectorateRef.Size()))
-	n10, err := m.ElectorateRef.MarshalTo(dAtA[i:])
+	n12, err := m.ElectorateRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n12
 	if len(m.Resolution) > 0 {
 		dAtA[i] = 0x22
 		i++
@@ -1917,20 +2623,20 @@ func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Quorum.Size()))
-		n11, err := m.Quorum.MarshalTo(dAtA[i:])
+		n13, err := m.Quorum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n13
 	}
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.Threshold.Size()))
-	n12, err := m.Threshold.MarshalTo(dAtA[i:])
+	n14, err := m.Threshold.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n14
 	return i, nil
 }
 
@@ -1953,20 +2659,20 @@ func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n13, err := m.Metadata.MarshalTo(dAtA[i:])
+		n15, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n15
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.Elector.Size()))
-	n14, err := m.Elector.MarshalTo(dAtA[i:])
+	n16, err := m.Elector.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n16
 	if m.Voted != 0 {
 		dAtA[i] = 0x18
 		i++
@@ -1975,6 +2681,145 @@ func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *VoteRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n17, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.ProposalID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
+		i += copy(dAtA[i:], m.ProposalID)
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Elector.Size()))
+	n18, err := m.Elector.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n18
+	if m.Voted != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Voted))
+	}
+	if m.VotedAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.VotedAt))
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Height))
+	}
+	return i, nil
+}
+
+func (m *Veto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Veto) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n19, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Elector.Size()))
+	n20, err := m.Elector.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n20
+	return i, nil
+}
+
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n21, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if len(m.ElectorateID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectorateID)))
+		i += copy(dAtA[i:], m.ElectorateID)
+	}
+	if len(m.Delegator) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Delegator)))
+		i += copy(dAtA[i:], m.Delegator)
+	}
+	if len(m.Delegatee) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Delegatee)))
+		i += copy(dAtA[i:], m.Delegatee)
+	}
+	return i, nil
+}
+
 func (m *CreateProposalMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1994,11 +2839,11 @@ func (m *CreateProposalMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n15, err := m.Metadata.MarshalTo(dAtA[i:])
+		n22, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n22
 	}
 	if len(m.Title) > 0 {
 		dAtA[i] = 0x12
@@ -2057,11 +2902,11 @@ func (m *DeleteProposalMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n16, err := m.Metadata.MarshalTo(dAtA[i:])
+		n23, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n23
 	}
 	if len(m.ProposalID) > 0 {
 		dAtA[i] = 0x12
@@ -2091,11 +2936,11 @@ func (m *VoteMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n17, err := m.Metadata.MarshalTo(dAtA[i:])
+		n24, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n24
 	}
 	if len(m.ProposalID) > 0 {
 		dAtA[i] = 0x12
@@ -2136,11 +2981,85 @@ func (m *TallyMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n18, err := m.Metadata.MarshalTo(dAtA[i:])
+		n25, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if len(m.ProposalID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
+		i += copy(dAtA[i:], m.ProposalID)
+	}
+	return i, nil
+}
+
+func (m *VetoMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VetoMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n26, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if len(m.ProposalID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
+		i += copy(dAtA[i:], m.ProposalID)
+	}
+	if len(m.Elector) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Elector)))
+		i += copy(dAtA[i:], m.Elector)
+	}
+	return i, nil
+}
+
+func (m *ExecuteProposalMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n27, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n27
 	}
 	if len(m.ProposalID) > 0 {
 		dAtA[i] = 0x12
@@ -2170,11 +3089,11 @@ func (m *CreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n19, err := m.Metadata.MarshalTo(dAtA[i:])
+		n28, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n28
 	}
 	if len(m.Resolution) > 0 {
 		dAtA[i] = 0x12
@@ -2204,11 +3123,11 @@ func (m *UpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n20, err := m.Metadata.MarshalTo(dAtA[i:])
+		n29, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n29
 	}
 	if len(m.ElectorateID) > 0 {
 		dAtA[i] = 0x12
@@ -2250,11 +3169,11 @@ func (m *UpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n21, err := m.Metadata.MarshalTo(dAtA[i:])
+		n30, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n30
 	}
 	if len(m.ElectionRuleID) > 0 {
 		dAtA[i] = 0x12
@@ -2270,63 +3189,174 @@ func (m *UpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.Threshold.Size()))
-	n22, err := m.Threshold.MarshalTo(dAtA[i:])
+	n31, err := m.Threshold.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n31
 	if m.Quorum != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Quorum.Size()))
-		n23, err := m.Quorum.MarshalTo(dAtA[i:])
+		n32, err := m.Quorum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n32
+	}
+	if m.ProposalDeposit != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ProposalDeposit.Size()))
+		n33, err := m.ProposalDeposit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if len(m.DepositCollector) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.DepositCollector)))
+		i += copy(dAtA[i:], m.DepositCollector)
+	}
+	if m.ExecutionDelay != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecutionDelay))
 	}
 	return i, nil
 }
 
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DelegateMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Electorate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DelegateMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n34, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
 	}
-	if m.Version != 0 {
-		n += 1 + sovCodec(uint64(m.Version))
+	if len(m.ElectorateID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectorateID)))
+		i += copy(dAtA[i:], m.ElectorateID)
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Delegator) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Delegator)))
+		i += copy(dAtA[i:], m.Delegator)
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Delegatee) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Delegatee)))
+		i += copy(dAtA[i:], m.Delegatee)
 	}
-	if len(m.Electors) > 0 {
-		for _, e := range m.Electors {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
-		}
+	return i, nil
+}
+
+func (m *RevokeDelegationMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeDelegationMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n35, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	if len(m.ElectorateID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectorateID)))
+		i += copy(dAtA[i:], m.ElectorateID)
+	}
+	if len(m.Delegator) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Delegator)))
+		i += copy(dAtA[i:], m.Delegator)
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Electorate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovCodec(uint64(m.Version))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Electors) > 0 {
+		for _, e := range m.Electors {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
 	}
 	if m.TotalElectorateWeight != 0 {
 		n += 1 + sovCodec(uint64(m.TotalElectorateWeight))
 	}
+	l = len(m.WeightTicker)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -2384,6 +3414,17 @@ func (m *ElectionRule) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.ProposalDeposit != nil {
+		l = m.ProposalDeposit.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.DepositCollector)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ExecutionDelay != 0 {
+		n += 1 + sovCodec(uint64(m.ExecutionDelay))
+	}
 	return n
 }
 
@@ -2456,6 +3497,20 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	if m.ExecutionTime != 0 {
+		n += 2 + sovCodec(uint64(m.ExecutionTime))
+	}
+	l = len(m.ExecutionTaskID)
+	if l > 0 {
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	if m.TotalVeto != 0 {
+		n += 2 + sovCodec(uint64(m.TotalVeto))
+	}
 	return n
 }
 
@@ -2527,6 +3582,74 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *VoteRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ProposalID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Elector.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.Voted != 0 {
+		n += 1 + sovCodec(uint64(m.Voted))
+	}
+	if m.VotedAt != 0 {
+		n += 1 + sovCodec(uint64(m.VotedAt))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCodec(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Veto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Elector.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ElectorateID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func (m *CreateProposalMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2621,6 +3744,44 @@ func (m *TallyMsg) Size() (n int) {
 	return n
 }
 
+func (m *VetoMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ProposalID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Elector)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecuteProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ProposalID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func (m *CreateTextResolutionMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2684,6 +3845,63 @@ func (m *UpdateElectionRuleMsg) Size() (n int) {
 		l = m.Quorum.Size()
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.ProposalDeposit != nil {
+		l = m.ProposalDeposit.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.DepositCollector)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ExecutionDelay != 0 {
+		n += 1 + sovCodec(uint64(m.ExecutionDelay))
+	}
+	return n
+}
+
+func (m *DelegateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ElectorateID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *RevokeDelegationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ElectorateID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -2903,6 +4121,38 @@ func (m *Electorate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightTicker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightTicker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -3339,6 +4589,95 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalDeposit == nil {
+				m.ProposalDeposit = &coin.Coin{}
+			}
+			if err := m.ProposalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCollector", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCollector = append(m.DepositCollector[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositCollector == nil {
+				m.DepositCollector = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			m.ExecutionDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionDelay |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -3898,6 +5237,114 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				m.TallyTaskID = []byte{}
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &coin.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			m.ExecutionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionTaskID = append(m.ExecutionTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExecutionTaskID == nil {
+				m.ExecutionTaskID = []byte{}
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVeto", wireType)
+			}
+			m.TotalVeto = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVeto |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -4073,18 +5520,1280 @@ func (m *Resolution) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalYes", wireType)
+			}
+			m.TotalYes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalYes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNo", wireType)
+			}
+			m.TotalNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalNo |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAbstain", wireType)
+			}
+			m.TotalAbstain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAbstain |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalElectorateWeight", wireType)
+			}
+			m.TotalElectorateWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalElectorateWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quorum == nil {
+				m.Quorum = &Fraction{}
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Elector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
+			}
+			m.Voted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Voted |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Elector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
+			}
+			m.Voted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Voted |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedAt", wireType)
+			}
+			m.VotedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Veto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Veto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Veto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Elector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ElectorateID = append(m.ElectorateID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectorateID == nil {
+				m.ElectorateID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = append(m.Delegatee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegatee == nil {
+				m.Delegatee = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateProposalMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawOption", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawOption = append(m.RawOption[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawOption == nil {
+				m.RawOption = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionRuleID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ElectionRuleID = append(m.ElectionRuleID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectionRuleID == nil {
+				m.ElectionRuleID = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = append(m.Author[:0], dAtA[iNdEx:postIndex]...)
+			if m.Author == nil {
+				m.Author = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteProposalMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteProposalMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resolution = string(dAtA[iNdEx:postIndex])
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4110,7 +6819,7 @@ func (m *Resolution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
+func (m *VoteMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4133,17 +6842,17 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalYes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.TotalYes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4153,16 +6862,33 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalYes |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalNo", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
-			m.TotalNo = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4172,54 +6898,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalNo |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAbstain", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
 			}
-			m.TotalAbstain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalAbstain |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalElectorateWeight", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TotalElectorateWeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalElectorateWeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
 			}
-		case 5:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4229,33 +6932,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Quorum == nil {
-				m.Quorum = &Fraction{}
-			}
-			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selected", wireType)
 			}
-			var msglen int
+			m.Selected = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4265,25 +6966,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Selected |= VoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -4308,7 +6995,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *TallyMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4331,10 +7018,10 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4375,9 +7062,9 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Elector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4387,44 +7074,26 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Elector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
-			}
-			m.Voted = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Voted |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -4449,7 +7118,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
+func (m *VetoMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4472,10 +7141,10 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateProposalMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: VetoMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VetoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4516,39 +7185,7 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawOption", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4575,46 +7212,14 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawOption = append(m.RawOption[:0], dAtA[iNdEx:postIndex]...)
-			if m.RawOption == nil {
-				m.RawOption = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElectionRuleID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Elector", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4641,16 +7246,69 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ElectionRuleID = append(m.ElectionRuleID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ElectionRuleID == nil {
-				m.ElectionRuleID = []byte{}
+			m.Elector = append(m.Elector[:0], dAtA[iNdEx:postIndex]...)
+			if m.Elector == nil {
+				m.Elector = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.StartTime = 0
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteProposalMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteProposalMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4660,14 +7318,31 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4694,9 +7369,9 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = append(m.Author[:0], dAtA[iNdEx:postIndex]...)
-			if m.Author == nil {
-				m.Author = []byte{}
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4723,7 +7398,7 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteProposalMsg) Unmarshal(dAtA []byte) error {
+func (m *CreateTextResolutionMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4746,10 +7421,10 @@ func (m *DeleteProposalMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteProposalMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateTextResolutionMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateTextResolutionMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4790,9 +7465,9 @@ func (m *DeleteProposalMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4802,25 +7477,23 @@ func (m *DeleteProposalMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposalID == nil {
-				m.ProposalID = []byte{}
-			}
+			m.Resolution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4846,7 +7519,7 @@ func (m *DeleteProposalMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoteMsg) Unmarshal(dAtA []byte) error {
+func (m *UpdateElectorateMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4869,10 +7542,10 @@ func (m *VoteMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoteMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateElectorateMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoteMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateElectorateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4913,7 +7586,7 @@ func (m *VoteMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4940,16 +7613,16 @@ func (m *VoteMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposalID == nil {
-				m.ProposalID = []byte{}
+			m.ElectorateID = append(m.ElectorateID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectorateID == nil {
+				m.ElectorateID = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiffElectors", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4959,45 +7632,26 @@ func (m *VoteMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Voter == nil {
-				m.Voter = []byte{}
+			m.DiffElectors = append(m.DiffElectors, Elector{})
+			if err := m.DiffElectors[len(m.DiffElectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selected", wireType)
-			}
-			m.Selected = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Selected |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -5022,7 +7676,7 @@ func (m *VoteMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyMsg) Unmarshal(dAtA []byte) error {
+func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5041,19 +7695,108 @@ func (m *TallyMsg) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyMsg: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateElectionRuleMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateElectionRuleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionRuleID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ElectionRuleID = append(m.ElectionRuleID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectionRuleID == nil {
+				m.ElectionRuleID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			m.VotingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPeriod |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5080,18 +7823,15 @@ func (m *TallyMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &weave.Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -5101,82 +7841,31 @@ func (m *TallyMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposalID == nil {
-				m.ProposalID = []byte{}
+			if m.Quorum == nil {
+				m.Quorum = &Fraction{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateTextResolutionMsg) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTextResolutionMsg: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTextResolutionMsg: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5203,18 +7892,18 @@ func (m *CreateTextResolutionMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &weave.Metadata{}
+			if m.ProposalDeposit == nil {
+				m.ProposalDeposit = &coin.Coin{}
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProposalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCollector", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -5224,24 +7913,45 @@ func (m *CreateTextResolutionMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resolution = string(dAtA[iNdEx:postIndex])
+			m.DepositCollector = append(m.DepositCollector[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositCollector == nil {
+				m.DepositCollector = []byte{}
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			m.ExecutionDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionDelay |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -5266,7 +7976,7 @@ func (m *CreateTextResolutionMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateElectorateMsg) Unmarshal(dAtA []byte) error {
+func (m *DelegateMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5289,10 +7999,10 @@ func (m *UpdateElectorateMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateElectorateMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegateMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateElectorateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5367,9 +8077,9 @@ func (m *UpdateElectorateMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiffElectors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -5379,24 +8089,58 @@ func (m *UpdateElectorateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DiffElectors = append(m.DiffElectors, Elector{})
-			if err := m.DiffElectors[len(m.DiffElectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = append(m.Delegatee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegatee == nil {
+				m.Delegatee = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5423,7 +8167,7 @@ func (m *UpdateElectorateMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
+func (m *RevokeDelegationMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5446,10 +8190,10 @@ func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateElectionRuleMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeDelegationMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateElectionRuleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeDelegationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5490,7 +8234,7 @@ func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElectionRuleID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5517,68 +8261,16 @@ func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ElectionRuleID = append(m.ElectionRuleID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ElectionRuleID == nil {
-				m.ElectionRuleID = []byte{}
+			m.ElectorateID = append(m.ElectorateID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectorateID == nil {
+				m.ElectorateID = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
-			}
-			m.VotingPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VotingPeriod |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -5588,26 +8280,24 @@ func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Quorum == nil {
-				m.Quorum = &Fraction{}
-			}
-			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
 			}
 			iNdEx = postIndex
 		default:
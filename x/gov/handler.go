@@ -2,9 +2,12 @@ package gov
 
 import (
 	"fmt"
+	"math/bits"
+	"sort"
 	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
@@ -18,8 +21,19 @@ const (
 	updateElectorateCost   = 0
 	updateElectionRuleCost = 0
 	textResolutionCost     = 0
+	delegateCost           = 0
+	revokeDelegationCost   = 0
+	vetoCost               = 0
+
+	// snapshotHolderCost is charged for every token holder read when
+	// the electors of a token weighted electorate are computed.
+	snapshotHolderCost = 10
 )
 
+// maxDelegationDepth is the maximum number of delegations that are followed
+// when the voting power of an elector is resolved during the tally.
+const maxDelegationDepth = 8
+
 const packageName = "gov"
 
 // RegisterQuery registers governance buckets for querying.
@@ -28,6 +42,20 @@ func RegisterQuery(qr weave.QueryRouter) {
 	NewElectorateBucket().Register("electorates", qr)
 	NewProposalBucket().Register("proposals", qr)
 	NewVoteBucket().Register("votes", qr)
+	NewVoteRecordBucket().Register("voterecords", qr)
+	NewDelegationBucket().Register("delegations", qr)
+	NewVetoBucket().Register("vetoes", qr)
+}
+
+// CashController allows to manage coins stored by the accounts without the
+// need to directly access the bucket.
+// Required functionality is implemented by the x/cash extension.
+type CashController interface {
+	MoveCoins(weave.KVStore, weave.Address, weave.Address, coin.Coin) error
+	CoinBurn(weave.KVStore, weave.Address, coin.Coin) error
+	// Holders calls given function for all accounts holding a positive
+	// amount of coins with given ticker.
+	Holders(weave.ReadOnlyKVStore, string, func(weave.Address, coin.Coin) error) error
 }
 
 // RegisterRoutes registers handlers for governance message processing.
@@ -37,13 +65,17 @@ func RegisterRoutes(
 	decoder OptionDecoder,
 	executor Executor,
 	scheduler weave.Scheduler,
+	ctrl CashController,
 ) {
 	r = migration.SchemaMigratingRegistry(packageName, r)
 	r.Handle(&VoteMsg{}, newVoteHandler(auth))
-	r.Handle(&CreateProposalMsg{}, newCreateProposalHandler(auth, decoder, scheduler))
-	r.Handle(&DeleteProposalMsg{}, newDeleteProposalHandler(auth, scheduler))
+	r.Handle(&CreateProposalMsg{}, newCreateProposalHandler(auth, decoder, scheduler, ctrl))
+	r.Handle(&DeleteProposalMsg{}, newDeleteProposalHandler(auth, scheduler, ctrl))
 	r.Handle(&UpdateElectorateMsg{}, newUpdateElectorateHandler(auth))
 	r.Handle(&UpdateElectionRuleMsg{}, newUpdateElectionRuleHandler(auth))
+	r.Handle(&DelegateMsg{}, newDelegateHandler(auth))
+	r.Handle(&RevokeDelegationMsg{}, newRevokeDelegationHandler(auth))
+	r.Handle(&VetoMsg{}, newVetoHandler(auth, scheduler, ctrl))
 	// We do NOT register the TextResultionHandler here... this is only for the proposal Executor
 }
 
@@ -52,8 +84,17 @@ func RegisterCronRoutes(
 	auth x.Authenticator,
 	decoder OptionDecoder,
 	executor Executor,
+	scheduler weave.Scheduler,
+	ctrl CashController,
 ) {
-	r.Handle(&TallyMsg{}, newTallyHandler(auth, decoder, executor))
+	r.Handle(&TallyMsg{}, newTallyHandler(auth, decoder, executor, scheduler, ctrl))
+	r.Handle(&ExecuteProposalMsg{}, newExecuteProposalHandler(auth, decoder, executor, ctrl))
+}
+
+// DepositAddress returns the address that holds the deposit of the proposal
+// with given ID until the proposal is closed, executed or withdrawn.
+func DepositAddress(proposalID []byte) weave.Address {
+	return weave.NewCondition(packageName, "deposit", proposalID).Address()
 }
 
 // RegisterBasicProposalRouters register the routes we accept for executing governance decisions.
@@ -65,18 +106,20 @@ func RegisterBasicProposalRouters(r weave.Registry, auth x.Authenticator) {
 }
 
 type VoteHandler struct {
-	auth       x.Authenticator
-	elecBucket *ElectorateBucket
-	propBucket *ProposalBucket
-	voteBucket *VoteBucket
+	auth         x.Authenticator
+	elecBucket   *ElectorateBucket
+	propBucket   *ProposalBucket
+	voteBucket   *VoteBucket
+	recordBucket *VoteRecordBucket
 }
 
 func newVoteHandler(auth x.Authenticator) *VoteHandler {
 	return &VoteHandler{
-		auth:       auth,
-		elecBucket: NewElectorateBucket(),
-		propBucket: NewProposalBucket(),
-		voteBucket: NewVoteBucket(),
+		auth:         auth,
+		elecBucket:   NewElectorateBucket(),
+		propBucket:   NewProposalBucket(),
+		voteBucket:   NewVoteBucket(),
+		recordBucket: NewVoteRecordBucket(),
 	}
 }
 
@@ -110,6 +153,25 @@ func (h VoteHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (
 	if err = h.voteBucket.Save(db, h.voteBucket.Build(db, voteMsg.ProposalID, *vote)); err != nil {
 		return nil, errors.Wrap(err, "failed to store vote")
 	}
+
+	// Every vote is archived, so that it is possible to see how the vote
+	// of an elector changed over time.
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+	height, _ := weave.GetHeight(ctx)
+	record := &VoteRecord{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ProposalID: voteMsg.ProposalID,
+		Elector:    vote.Elector,
+		Voted:      vote.Voted,
+		VotedAt:    weave.AsUnixTime(blockTime),
+		Height:     height,
+	}
+	if _, err := h.recordBucket.Create(db, record); err != nil {
+		return nil, errors.Wrap(err, "failed to store vote record")
+	}
 	if err := h.propBucket.Update(db, voteMsg.ProposalID, proposal); err != nil {
 		return nil, err
 	}
@@ -167,20 +229,30 @@ func (h VoteHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx)
 }
 
 type TallyHandler struct {
-	auth       x.Authenticator
-	propBucket *ProposalBucket
-	elecBucket *ElectorateBucket
-	decoder    OptionDecoder
-	executor   Executor
+	auth        x.Authenticator
+	propBucket  *ProposalBucket
+	elecBucket  *ElectorateBucket
+	rulesBucket *ElectionRulesBucket
+	voteBucket  *VoteBucket
+	delgBucket  *DelegationBucket
+	decoder     OptionDecoder
+	executor    Executor
+	scheduler   weave.Scheduler
+	ctrl        CashController
 }
 
-func newTallyHandler(auth x.Authenticator, decoder OptionDecoder, executor Executor) *TallyHandler {
+func newTallyHandler(auth x.Authenticator, decoder OptionDecoder, executor Executor, scheduler weave.Scheduler, ctrl CashController) *TallyHandler {
 	return &TallyHandler{
-		auth:       auth,
-		propBucket: NewProposalBucket(),
-		elecBucket: NewElectorateBucket(),
-		decoder:    decoder,
-		executor:   executor,
+		auth:        auth,
+		propBucket:  NewProposalBucket(),
+		elecBucket:  NewElectorateBucket(),
+		rulesBucket: NewElectionRulesBucket(),
+		voteBucket:  NewVoteBucket(),
+		delgBucket:  NewDelegationBucket(),
+		decoder:     decoder,
+		executor:    executor,
+		scheduler:   scheduler,
+		ctrl:        ctrl,
 	}
 }
 
@@ -198,6 +270,18 @@ func (h TallyHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx)
 		return nil, errors.Wrap(errors.ErrState, "missing base proposal information")
 	}
 
+	obj, err := h.rulesBucket.GetVersion(db, common.ElectionRuleRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load election rule")
+	}
+	rule, err := asElectionRule(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.countDelegatedVotes(db, msg.ProposalID, common); err != nil {
+		return nil, errors.Wrap(err, "delegated votes")
+	}
 	if err := common.Tally(); err != nil {
 		return nil, err
 	}
@@ -211,48 +295,196 @@ func (h TallyHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx)
 	}()
 
 	if common.Result != Proposal_Accepted {
+		// Reaching the quorum proves that the proposal was not spam.
+		if common.VoteState.QuorumReached() {
+			err = returnDeposit(db, h.ctrl, msg.ProposalID, common)
+		} else {
+			err = forfeitDeposit(db, h.ctrl, msg.ProposalID, common, rule)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "deposit")
+		}
 		return &weave.DeliverResult{Log: "Proposal not accepted"}, nil
 	}
 
-	// we only execute the store options upon success
-	// if this fails... we should still return no error, so the tally update works
-	// we just return the info from the executor in logs (tags?)
-	opts, err := h.decoder(proposal.RawOption)
+	// An accepted proposal is executed after the execution delay if one is
+	// configured. Until then the electorate can veto it and the deposit is
+	// held.
+	if rule.ExecutionDelay > 0 {
+		blockTime, err := weave.BlockTime(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "block time")
+		}
+		runAt := blockTime.Add(rule.ExecutionDelay.Duration())
+		executeMsg := &ExecuteProposalMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ProposalID: msg.ProposalID,
+		}
+		// Execute message requires no authentication.
+		taskID, err := h.scheduler.Schedule(db, runAt, nil, executeMsg)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot schedule execution task")
+		}
+		proposal.ExecutorResult = Proposal_Pending
+		proposal.ExecutionTime = weave.AsUnixTime(runAt)
+		proposal.ExecutionTaskID = taskID
+		return &weave.DeliverResult{Log: "Proposal accepted: execution pending"}, nil
+	}
+
+	if err := returnDeposit(db, h.ctrl, msg.ProposalID, common); err != nil {
+		return nil, errors.Wrap(err, "deposit")
+	}
+	return executeProposal(ctx, db, h.decoder, h.executor, msg.ProposalID, proposal), nil
+}
+
+// executeProposal runs the options of an accepted proposal and updates its
+// executor result.
+// A failure of the execution does not fail the transaction, so that the
+// proposal state can be updated. Information about the failure is returned
+// in the log instead.
+func executeProposal(
+	ctx weave.Context,
+	db weave.KVStore,
+	decoder OptionDecoder,
+	executor Executor,
+	proposalID []byte,
+	proposal *Proposal,
+) *weave.DeliverResult {
+	opts, err := decoder(proposal.RawOption)
 	if err != nil {
 		proposal.ExecutorResult = Proposal_Failure
-		return &weave.DeliverResult{Log: "Proposal accepted: error: cannot parse raw options"}, nil
+		return &weave.DeliverResult{Log: "Proposal accepted: error: cannot parse raw options"}
 	}
 	if err := opts.Validate(); err != nil {
-		return &weave.DeliverResult{Log: "Proposal accepted: error: options invalid"}, nil
+		proposal.ExecutorResult = Proposal_Failure
+		return &weave.DeliverResult{Log: "Proposal accepted: error: options invalid"}
 	}
 
 	// we add the vote ctx here, to authenticate results in the executor
 	// ensure that the gov.Authenticator is used in those Handlers
 	// we also add the proposal with id that was passed that can be accessed via CtxProposal()
-	voteCtx := withProposal(withElectionSuccess(ctx, common.ElectionRuleRef.ID), proposal, msg.ProposalID)
+	voteCtx := withProposal(withElectionSuccess(ctx, proposal.ElectionRuleRef.ID), proposal, proposalID)
 	cstore, ok := db.(weave.CacheableKVStore)
 	if !ok {
 		proposal.ExecutorResult = Proposal_Failure
-		return &weave.DeliverResult{Log: "Proposal accepted: error: need cachable kvstore"}, nil
+		return &weave.DeliverResult{Log: "Proposal accepted: error: need cachable kvstore"}
 	}
 	subDB := cstore.CacheWrap()
 
-	res, err := h.executor(voteCtx, subDB, opts)
+	res, err := executor(voteCtx, subDB, opts)
 	if err != nil {
 		subDB.Discard()
 		log := fmt.Sprintf("Proposal accepted: execution error: %v", err)
 		proposal.ExecutorResult = Proposal_Failure
-		return &weave.DeliverResult{Log: log}, nil
+		return &weave.DeliverResult{Log: log}
 	}
 	if err := subDB.Write(); err != nil {
 		log := fmt.Sprintf("Proposal accepted: commit error: %v", err)
 		proposal.ExecutorResult = Proposal_Failure
-		return &weave.DeliverResult{Log: log}, nil
+		return &weave.DeliverResult{Log: log}
 	}
 
 	proposal.ExecutorResult = Proposal_Success
 	res.Log = "Proposal accepted: execution success"
-	return res, nil
+	return res
+}
+
+// returnDeposit moves the deposit of the proposal back to its author.
+func returnDeposit(db weave.KVStore, ctrl CashController, proposalID []byte, proposal *Proposal) error {
+	if coin.IsEmpty(proposal.Deposit) {
+		return nil
+	}
+	if err := ctrl.MoveCoins(db, DepositAddress(proposalID), proposal.Author, *proposal.Deposit); err != nil {
+		return errors.Wrap(err, "cannot return deposit")
+	}
+	return nil
+}
+
+// forfeitDeposit moves the deposit of the proposal to the deposit collector
+// of the election rule. If no collector is set, the deposit is burned.
+func forfeitDeposit(db weave.KVStore, ctrl CashController, proposalID []byte, proposal *Proposal, rule *ElectionRule) error {
+	if coin.IsEmpty(proposal.Deposit) {
+		return nil
+	}
+	holder := DepositAddress(proposalID)
+	if rule.DepositCollector != nil {
+		if err := ctrl.MoveCoins(db, holder, rule.DepositCollector, *proposal.Deposit); err != nil {
+			return errors.Wrap(err, "cannot collect deposit")
+		}
+		return nil
+	}
+	if err := ctrl.CoinBurn(db, holder, *proposal.Deposit); err != nil {
+		return errors.Wrap(err, "cannot burn deposit")
+	}
+	return nil
+}
+
+// countDelegatedVotes adds the weight of all electors that did not vote but
+// delegated their voting power to the vote state of the proposal.
+// Electors are processed in the order of their addresses so the result is
+// deterministic.
+func (h TallyHandler) countDelegatedVotes(db weave.KVStore, proposalID []byte, proposal *Proposal) error {
+	obj, err := h.elecBucket.GetVersion(db, proposal.ElectorateRef)
+	if err != nil {
+		return errors.Wrap(err, "failed to load electorate")
+	}
+	elect, err := asElectorate(obj)
+	if err != nil {
+		return errors.Wrap(err, "electorate")
+	}
+	for _, e := range elect.Electors {
+		voted, err := h.voteBucket.HasVoted(db, proposalID, e.Address)
+		if err != nil {
+			return err
+		}
+		if voted {
+			// A direct vote always overrides a delegation.
+			continue
+		}
+		option, err := h.resolveDelegation(db, proposalID, proposal.ElectorateRef.ID, e.Address)
+		if err != nil {
+			return err
+		}
+		if option == VoteOption_Invalid {
+			continue
+		}
+		vote := Vote{Elector: e, Voted: option}
+		if err := proposal.CountVote(vote); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveDelegation follows the delegation chain starting with given elector
+// and returns the option voted by the first delegatee that voted directly.
+// Invalid option is returned if the chain ends without a vote, contains a cycle
+// or exceeds the maximum delegation depth.
+func (h TallyHandler) resolveDelegation(db weave.KVStore, proposalID, electorateID []byte, elector weave.Address) (VoteOption, error) {
+	visited := map[string]struct{}{string(elector): {}}
+	current := elector
+	for i := 0; i < maxDelegationDepth; i++ {
+		d, err := h.delgBucket.GetDelegation(db, electorateID, current)
+		switch {
+		case errors.ErrNotFound.Is(err):
+			return VoteOption_Invalid, nil
+		case err != nil:
+			return VoteOption_Invalid, err
+		}
+		if _, ok := visited[string(d.Delegatee)]; ok {
+			return VoteOption_Invalid, nil
+		}
+		visited[string(d.Delegatee)] = struct{}{}
+
+		switch vote, err := h.voteBucket.GetVote(db, proposalID, d.Delegatee); {
+		case err == nil:
+			return vote.Voted, nil
+		case !errors.ErrNotFound.Is(err):
+			return VoteOption_Invalid, err
+		}
+		current = d.Delegatee
+	}
+	return VoteOption_Invalid, nil
 }
 
 func (h TallyHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*TallyMsg, *Proposal, error) {
@@ -277,6 +509,66 @@ func (h TallyHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx)
 	return &msg, proposal, nil
 }
 
+type ExecuteProposalHandler struct {
+	auth       x.Authenticator
+	propBucket *ProposalBucket
+	decoder    OptionDecoder
+	executor   Executor
+	ctrl       CashController
+}
+
+func newExecuteProposalHandler(auth x.Authenticator, decoder OptionDecoder, executor Executor, ctrl CashController) *ExecuteProposalHandler {
+	return &ExecuteProposalHandler{
+		auth:       auth,
+		propBucket: NewProposalBucket(),
+		decoder:    decoder,
+		executor:   executor,
+		ctrl:       ctrl,
+	}
+}
+
+func (h ExecuteProposalHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	return nil, errors.Wrap(errors.ErrHuman, "execute proposal handler is to be executed by cron only")
+}
+
+func (h ExecuteProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (resOut *weave.DeliverResult, errOut error) {
+	msg, proposal, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// store the proposal when done processing it, via whatever path
+	defer func() {
+		if err := h.propBucket.Update(db, msg.ProposalID, proposal); err != nil {
+			resOut = nil
+			errOut = err
+		}
+	}()
+
+	if err := returnDeposit(db, h.ctrl, msg.ProposalID, proposal); err != nil {
+		return nil, errors.Wrap(err, "deposit")
+	}
+	return executeProposal(ctx, db, h.decoder, h.executor, msg.ProposalID, proposal), nil
+}
+
+func (h ExecuteProposalHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ExecuteProposalMsg, *Proposal, error) {
+	var msg ExecuteProposalMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	proposal, err := h.propBucket.GetProposal(db, msg.ProposalID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to load proposal")
+	}
+	if proposal.Result != Proposal_Accepted || proposal.ExecutorResult != Proposal_Pending {
+		return nil, nil, errors.Wrap(errors.ErrState, "proposal is not pending execution")
+	}
+	if weave.InTheFuture(ctx, proposal.ExecutionTime.Time()) {
+		return nil, nil, errors.Wrap(errors.ErrState, "execution before proposal execution time")
+	}
+	return &msg, proposal, nil
+}
+
 type CreateProposalHandler struct {
 	auth        x.Authenticator
 	decoder     OptionDecoder
@@ -284,9 +576,10 @@ type CreateProposalHandler struct {
 	propBucket  *ProposalBucket
 	rulesBucket *ElectionRulesBucket
 	scheduler   weave.Scheduler
+	ctrl        CashController
 }
 
-func newCreateProposalHandler(auth x.Authenticator, decoder OptionDecoder, scheduler weave.Scheduler) *CreateProposalHandler {
+func newCreateProposalHandler(auth x.Authenticator, decoder OptionDecoder, scheduler weave.Scheduler, ctrl CashController) *CreateProposalHandler {
 	return &CreateProposalHandler{
 		auth:        auth,
 		decoder:     decoder,
@@ -294,19 +587,20 @@ func newCreateProposalHandler(auth x.Authenticator, decoder OptionDecoder, sched
 		propBucket:  NewProposalBucket(),
 		rulesBucket: NewElectionRulesBucket(),
 		scheduler:   scheduler,
+		ctrl:        ctrl,
 	}
 }
 
 func (h CreateProposalHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
+	_, _, _, holders, err := h.validate(ctx, db, tx)
+	if err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: proposalCost}, nil
-
+	return &weave.CheckResult{GasAllocated: proposalCost + int64(holders)*snapshotHolderCost}, nil
 }
 
 func (h CreateProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	msg, rule, electorate, err := h.validate(ctx, db, tx)
+	msg, rule, electorate, _, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -315,6 +609,17 @@ func (h CreateProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 		return nil, errors.Wrap(err, "block time")
 	}
 
+	// Electors of a token weighted electorate are stored as a new
+	// electorate version, so that the snapshot is used for the whole
+	// lifetime of the proposal.
+	if electorate.IsTokenWeighted() {
+		ref, err := h.elecBucket.Update(db, rule.ElectorateID, electorate)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot store electorate snapshot")
+		}
+		electorate.Version = ref.Version
+	}
+
 	votingEnd := msg.StartTime.Add(rule.VotingPeriod.Duration())
 	proposal := &Proposal{
 		Metadata:        &weave.Metadata{Schema: 1},
@@ -354,6 +659,14 @@ func (h CreateProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 	// Update the proposal with the task ID. We need the task ID in order
 	// to check the task state and if needed to delete the scheduled job.
 	proposal.TallyTaskID = taskID
+
+	// The deposit is held by the proposal until it is closed or withdrawn.
+	if !coin.IsEmpty(rule.ProposalDeposit) {
+		if err := h.ctrl.MoveCoins(db, msg.Author, DepositAddress(obj.Key()), *rule.ProposalDeposit); err != nil {
+			return nil, errors.Wrap(err, "cannot deposit")
+		}
+		proposal.Deposit = rule.ProposalDeposit.Clone()
+	}
 	if err := h.propBucket.Update(db, obj.Key(), proposal); err != nil {
 		return nil, errors.Wrap(err, "failed to update proposal")
 	}
@@ -361,35 +674,45 @@ func (h CreateProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 	return &weave.DeliverResult{Data: obj.Key()}, nil
 }
 
-func (h CreateProposalHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*CreateProposalMsg, *ElectionRule, *Electorate, error) {
+func (h CreateProposalHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*CreateProposalMsg, *ElectionRule, *Electorate, int, error) {
+	// Returned int is the number of token holders read to compute the
+	// electors of a token weighted electorate.
 	var msg CreateProposalMsg
 	if err := weave.LoadMsg(tx, &msg); err != nil {
-		return nil, nil, nil, errors.Wrap(err, "load msg")
+		return nil, nil, nil, 0, errors.Wrap(err, "load msg")
 	}
 
 	if !weave.InTheFuture(ctx, msg.StartTime.Time()) {
-		return nil, nil, nil, errors.Wrap(errors.ErrInput, "start time must be in the future")
+		return nil, nil, nil, 0, errors.Wrap(errors.ErrInput, "start time must be in the future")
 	}
 	if weave.InTheFuture(ctx, msg.StartTime.Time().Add(-maxFutureStart)) {
-		return nil, nil, nil, errors.Wrapf(errors.ErrInput, "start time cam not be more than %s h in the future", maxFutureStart)
+		return nil, nil, nil, 0, errors.Wrapf(errors.ErrInput, "start time cam not be more than %s h in the future", maxFutureStart)
 	}
 
 	_, rObj, err := h.rulesBucket.GetLatestVersion(db, msg.ElectionRuleID)
 	if err != nil {
-		return nil, nil, nil, errors.Wrap(err, "failed to load election rule")
+		return nil, nil, nil, 0, errors.Wrap(err, "failed to load election rule")
 	}
 	rule, err := asElectionRule(rObj)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, 0, err
 	}
 
 	_, obj, err := h.elecBucket.GetLatestVersion(db, rule.ElectorateID)
 	if err != nil {
-		return nil, nil, nil, errors.Wrap(err, "failed to load electorate")
+		return nil, nil, nil, 0, errors.Wrap(err, "failed to load electorate")
 	}
 	elect, err := asElectorate(obj)
 	if err != nil {
-		return nil, nil, nil, errors.Wrap(err, "electorate")
+		return nil, nil, nil, 0, errors.Wrap(err, "electorate")
+	}
+	var holders int
+	if elect.IsTokenWeighted() {
+		electors, total, n, err := snapshotElectors(db, h.ctrl, elect.WeightTicker)
+		if err != nil {
+			return nil, nil, nil, 0, errors.Wrap(err, "electorate snapshot")
+		}
+		elect.Electors, elect.TotalElectorateWeight, holders = electors, total, n
 	}
 
 	// A proposal can be created only by an entity that belongs to the
@@ -403,13 +726,13 @@ func (h CreateProposalHandler) validate(ctx weave.Context, db weave.KVStore, tx
 		}
 	}
 	if !authorized {
-		return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "proposal creation must be signed by at least one of the electors")
+		return nil, nil, nil, 0, errors.Wrap(errors.ErrUnauthorized, "proposal creation must be signed by at least one of the electors")
 	}
 
 	author := msg.Author
 	if author != nil {
 		if !h.auth.HasAddress(ctx, author) {
-			return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "author's signature required")
+			return nil, nil, nil, 0, errors.Wrap(errors.ErrUnauthorized, "author's signature required")
 		}
 	} else {
 		author = x.MainSigner(ctx, h.auth).Address()
@@ -418,26 +741,94 @@ func (h CreateProposalHandler) validate(ctx weave.Context, db weave.KVStore, tx
 
 	opts, err := h.decoder(msg.RawOption)
 	if err != nil {
-		return nil, nil, nil, errors.Wrap(errors.ErrInput, "cannot parse raw options")
+		return nil, nil, nil, 0, errors.Wrap(errors.ErrInput, "cannot parse raw options")
 	}
 	if err := opts.Validate(); err != nil {
-		return nil, nil, nil, errors.Wrap(err, "options invalid")
+		return nil, nil, nil, 0, errors.Wrap(err, "options invalid")
+	}
+
+	return &msg, rule, elect, holders, nil
+}
+
+// snapshotElectors returns the electors of a token weighted electorate
+// together with their total weight and the number of token holders read.
+// Each account holding at least one whole token of the given ticker is an
+// elector. If there are more holders than an electorate can contain, only the
+// biggest holders are included.
+//
+// The weight of an elector is the amount of whole tokens held. If the biggest
+// holder has more tokens than the max weight, all weights are scaled down
+// proportionally so that the biggest holder has the max weight. Holders whose
+// scaled weight is below one are not electors.
+//
+// Only wallets holding the ticker are read. The cost is charged per holder.
+func snapshotElectors(db weave.ReadOnlyKVStore, ctrl CashController, ticker string) ([]Elector, uint64, int, error) {
+	type holder struct {
+		address weave.Address
+		whole   int64
+	}
+	var (
+		scanned int
+		whole   []holder
+	)
+	err := ctrl.Holders(db, ticker, func(addr weave.Address, amount coin.Coin) error {
+		scanned++
+		// Fractional amounts are ignored.
+		if amount.Whole >= 1 {
+			whole = append(whole, holder{address: addr, whole: amount.Whole})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, 0, errors.Wrap(err, "cannot list holders")
+	}
+	if len(whole) == 0 {
+		return nil, 0, scanned, errors.Wrapf(errors.ErrState, "no %s holders", ticker)
+	}
+	sort.SliceStable(whole, func(i, j int) bool {
+		return whole[i].whole > whole[j].whole
+	})
+	if len(whole) > maxElectors {
+		whole = whole[:maxElectors]
+	}
+
+	biggest := uint64(whole[0].whole)
+	electors := make([]Elector, 0, len(whole))
+	for _, h := range whole {
+		weight := uint64(h.whole)
+		if biggest > maxWeight {
+			// weight * maxWeight / biggest, computed without an
+			// overflow. The result is never greater than max weight.
+			hi, lo := bits.Mul64(weight, maxWeight)
+			weight, _ = bits.Div64(hi, lo, biggest)
+		}
+		if weight == 0 {
+			continue
+		}
+		electors = append(electors, Elector{Address: h.address, Weight: uint32(weight)})
 	}
+	sortByAddress(electors)
 
-	return &msg, rule, elect, nil
+	var total uint64
+	for _, e := range electors {
+		total += uint64(e.Weight)
+	}
+	return electors, total, scanned, nil
 }
 
 type DeleteProposalHandler struct {
 	auth       x.Authenticator
 	propBucket *ProposalBucket
 	scheduler  weave.Scheduler
+	ctrl       CashController
 }
 
-func newDeleteProposalHandler(auth x.Authenticator, scheduler weave.Scheduler) *DeleteProposalHandler {
+func newDeleteProposalHandler(auth x.Authenticator, scheduler weave.Scheduler, ctrl CashController) *DeleteProposalHandler {
 	return &DeleteProposalHandler{
 		auth:       auth,
 		propBucket: NewProposalBucket(),
 		scheduler:  scheduler,
+		ctrl:       ctrl,
 	}
 }
 
@@ -493,6 +884,11 @@ func (h DeleteProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 		return nil, errors.Wrap(err, "cannot delete scheduled tally task")
 	}
 
+	// A withdrawn proposal was never voted on, so the deposit is returned.
+	if err := returnDeposit(db, h.ctrl, msg.ProposalID, prop); err != nil {
+		return nil, errors.Wrap(err, "deposit")
+	}
+
 	return &weave.DeliverResult{}, nil
 }
 
@@ -552,6 +948,9 @@ func (h UpdateElectorateHandler) validate(ctx weave.Context, db weave.KVStore, t
 	if !h.auth.HasAddress(ctx, elect.Admin) {
 		return nil, nil, errors.ErrUnauthorized
 	}
+	if elect.IsTokenWeighted() {
+		return nil, nil, errors.Wrap(errors.ErrState, "electors of a token weighted electorate are derived from balances")
+	}
 	if err := newMerger(elect.Electors).merge(msg.DiffElectors); err != nil {
 		return nil, nil, err
 	}
@@ -588,6 +987,9 @@ func (h UpdateElectionRuleHandler) Deliver(ctx weave.Context, db weave.KVStore,
 	rule.Threshold = msg.Threshold
 	rule.VotingPeriod = msg.VotingPeriod
 	rule.Quorum = msg.Quorum
+	rule.ProposalDeposit = msg.ProposalDeposit
+	rule.DepositCollector = msg.DepositCollector
+	rule.ExecutionDelay = msg.ExecutionDelay
 	if _, err := h.ruleBucket.Update(db, msg.ElectionRuleID, rule); err != nil {
 		return nil, errors.Wrap(err, "failed to store update")
 	}
@@ -666,3 +1068,244 @@ func (h createTextResolutionHandler) validate(ctx weave.Context, db weave.KVStor
 	// No auth, this can only be executed by gov proposal, and that info is stored alongside the resolution
 	return &msg, nil
 }
+
+type DelegateHandler struct {
+	auth       x.Authenticator
+	elecBucket *ElectorateBucket
+	delgBucket *DelegationBucket
+}
+
+func newDelegateHandler(auth x.Authenticator) *DelegateHandler {
+	return &DelegateHandler{
+		auth:       auth,
+		elecBucket: NewElectorateBucket(),
+		delgBucket: NewDelegationBucket(),
+	}
+}
+
+func (h DelegateHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: delegateCost}, nil
+}
+
+func (h DelegateHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	delegation, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.delgBucket.Save(db, h.delgBucket.Build(db, *delegation)); err != nil {
+		return nil, errors.Wrap(err, "failed to store delegation")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h DelegateHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*Delegation, error) {
+	var msg DelegateMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	delegator := msg.Delegator
+	if delegator == nil {
+		delegator = x.MainSigner(ctx, h.auth).Address()
+	}
+	if !h.auth.HasAddress(ctx, delegator) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "delegator must sign msg")
+	}
+	_, obj, err := h.elecBucket.GetLatestVersion(db, msg.ElectorateID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load electorate")
+	}
+	elect, err := asElectorate(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "electorate")
+	}
+	if _, ok := elect.Elector(delegator); !ok {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "delegator not in participants list")
+	}
+	if _, ok := elect.Elector(msg.Delegatee); !ok {
+		return nil, errors.Wrap(errors.ErrInput, "delegatee not in participants list")
+	}
+	delegation := &Delegation{
+		Metadata:     &weave.Metadata{Schema: 1},
+		ElectorateID: msg.ElectorateID,
+		Delegator:    delegator,
+		Delegatee:    msg.Delegatee,
+	}
+	if err := delegation.Validate(); err != nil {
+		return nil, err
+	}
+	return delegation, nil
+}
+
+type RevokeDelegationHandler struct {
+	auth       x.Authenticator
+	delgBucket *DelegationBucket
+}
+
+func newRevokeDelegationHandler(auth x.Authenticator) *RevokeDelegationHandler {
+	return &RevokeDelegationHandler{
+		auth:       auth,
+		delgBucket: NewDelegationBucket(),
+	}
+}
+
+func (h RevokeDelegationHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: revokeDelegationCost}, nil
+}
+
+func (h RevokeDelegationHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	delegation, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.delgBucket.Delete(db, delegationKey(delegation.ElectorateID, delegation.Delegator)); err != nil {
+		return nil, errors.Wrap(err, "failed to delete delegation")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h RevokeDelegationHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*Delegation, error) {
+	var msg RevokeDelegationMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	delegator := msg.Delegator
+	if delegator == nil {
+		delegator = x.MainSigner(ctx, h.auth).Address()
+	}
+	if !h.auth.HasAddress(ctx, delegator) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "delegator must sign msg")
+	}
+	delegation, err := h.delgBucket.GetDelegation(db, msg.ElectorateID, delegator)
+	if err != nil {
+		return nil, err
+	}
+	return delegation, nil
+}
+
+type VetoHandler struct {
+	auth        x.Authenticator
+	elecBucket  *ElectorateBucket
+	propBucket  *ProposalBucket
+	rulesBucket *ElectionRulesBucket
+	vetoBucket  *VetoBucket
+	scheduler   weave.Scheduler
+	ctrl        CashController
+}
+
+func newVetoHandler(auth x.Authenticator, scheduler weave.Scheduler, ctrl CashController) *VetoHandler {
+	return &VetoHandler{
+		auth:        auth,
+		elecBucket:  NewElectorateBucket(),
+		propBucket:  NewProposalBucket(),
+		rulesBucket: NewElectionRulesBucket(),
+		vetoBucket:  NewVetoBucket(),
+		scheduler:   scheduler,
+		ctrl:        ctrl,
+	}
+}
+
+func (h VetoHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: vetoCost}, nil
+}
+
+func (h VetoHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, proposal, veto, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.vetoBucket.Save(db, h.vetoBucket.Build(db, msg.ProposalID, *veto)); err != nil {
+		return nil, errors.Wrap(err, "failed to store veto")
+	}
+	proposal.TotalVeto += uint64(veto.Elector.Weight)
+
+	if proposal.VetoThresholdReached() {
+		proposal.Result = Proposal_Vetoed
+		proposal.ExecutorResult = Proposal_NotRun
+
+		switch err := h.scheduler.Delete(db, proposal.ExecutionTaskID); {
+		case err == nil:
+			// All good.
+		case errors.ErrNotFound.Is(err):
+			// This is unexpected but not critical. We want the task to not exist
+			// and this is true.
+		default:
+			return nil, errors.Wrap(err, "cannot delete scheduled execution task")
+		}
+
+		obj, err := h.rulesBucket.GetVersion(db, proposal.ElectionRuleRef)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load election rule")
+		}
+		rule, err := asElectionRule(obj)
+		if err != nil {
+			return nil, err
+		}
+		if err := forfeitDeposit(db, h.ctrl, msg.ProposalID, proposal, rule); err != nil {
+			return nil, errors.Wrap(err, "deposit")
+		}
+	}
+
+	if err := h.propBucket.Update(db, msg.ProposalID, proposal); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h VetoHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*VetoMsg, *Proposal, *Veto, error) {
+	var msg VetoMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+	proposal, err := h.propBucket.GetProposal(db, msg.ProposalID)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to load proposal")
+	}
+	if proposal.Result != Proposal_Accepted || proposal.ExecutorResult != Proposal_Pending {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "proposal is not pending execution")
+	}
+	if !weave.InTheFuture(ctx, proposal.ExecutionTime.Time()) {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "veto after proposal execution time")
+	}
+
+	vetoer := msg.Elector
+	if vetoer == nil {
+		vetoer = x.MainSigner(ctx, h.auth).Address()
+	}
+	obj, err := h.elecBucket.GetVersion(db, proposal.ElectorateRef)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to load electorate")
+	}
+	elect, err := asElectorate(obj)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "electorate")
+	}
+	elector, ok := elect.Elector(vetoer)
+	if !ok {
+		return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "not in participants list")
+	}
+	if !h.auth.HasAddress(ctx, vetoer) {
+		return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "elector must sign msg")
+	}
+	switch vetoed, err := h.vetoBucket.HasVetoed(db, msg.ProposalID, vetoer); {
+	case err != nil:
+		return nil, nil, nil, err
+	case vetoed:
+		return nil, nil, nil, errors.Wrap(errors.ErrDuplicate, "already vetoed")
+	}
+
+	veto := &Veto{
+		Metadata: &weave.Metadata{Schema: 1},
+		Elector:  *elector,
+	}
+	return &msg, proposal, veto, nil
+}
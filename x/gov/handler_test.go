@@ -10,12 +10,14 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
 )
 
 var (
@@ -352,7 +354,7 @@ func TestCreateTextProposal(t *testing.T) {
 			rt := app.NewRouter()
 			cron := &weavetest.Cron{}
 			// We don't run the executor here, so we can safely pass in nil.
-			RegisterRoutes(rt, auth, decodeProposalOptions, nil, cron)
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, cron, nil)
 
 			db := store.MemStore()
 			migration.MustInitPkg(db, packageName)
@@ -468,7 +470,7 @@ func TestDeleteProposal(t *testing.T) {
 				Signer: spec.SignedBy,
 			}
 			rt := app.NewRouter()
-			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{})
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
 
 			// given
 			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
@@ -775,7 +777,7 @@ func TestVote(t *testing.T) {
 				Signer: spec.SignedBy,
 			}
 			rt := app.NewRouter()
-			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{})
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
 
 			// given
 			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
@@ -1168,6 +1170,20 @@ func TestTally(t *testing.T) {
 			WantDeliverErr:    nil,
 			WantDeliverLog:    "Proposal accepted: execution error:",
 		},
+		"Fails to execute options that do not validate": {
+			Mods: func(ctx weave.Context, p *Proposal) {
+				p.RawOption, _ = generateInvalidOptions(t)
+				p.VotingEndTime = unixBlockTime(t, ctx) - 1
+			},
+			Src: tallySetup{
+				yes:                   10,
+				threshold:             Fraction{Numerator: 1, Denominator: 2},
+				totalWeightElectorate: 11,
+			},
+			ExpResult:         Proposal_Accepted,
+			ExpExecutorResult: Proposal_Failure,
+			WantDeliverLog:    "Proposal accepted: error: options invalid",
+		},
 		"Does not update an electorate when rejected": {
 			Mods: func(ctx weave.Context, p *Proposal) {
 				p.RawOption = genElectorateOptions(t, Elector{hAlice, 10})
@@ -1251,7 +1267,7 @@ func TestTally(t *testing.T) {
 	}
 	rt := app.NewRouter()
 	// Tally is registered for the cron, not for the usual routes.
-	RegisterCronRoutes(rt, nil, decodeProposalOptions, proposalOptionsExecutor())
+	RegisterCronRoutes(rt, nil, decodeProposalOptions, proposalOptionsExecutor(), &weavetest.Cron{}, nil)
 
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -1426,7 +1442,7 @@ func TestUpdateElectorate(t *testing.T) {
 				Signer: spec.SignedBy,
 			}
 			rt := app.NewRouter()
-			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{})
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
 			db := store.MemStore()
 			migration.MustInitPkg(db, packageName)
 
@@ -1608,7 +1624,7 @@ func TestUpdateElectionRules(t *testing.T) {
 				Signer: spec.SignedBy,
 			}
 			rt := app.NewRouter()
-			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{})
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
 			db := store.MemStore()
 			migration.MustInitPkg(db, packageName)
 
@@ -1651,3 +1667,934 @@ func unixBlockTime(t testing.TB, ctx context.Context) weave.UnixTime {
 	}
 	return weave.AsUnixTime(now)
 }
+
+func TestDelegate(t *testing.T) {
+	electorateID := weavetest.SequenceID(1)
+	nonElectorCond := weavetest.NewCondition()
+	nonElector := nonElectorCond.Address()
+
+	specs := map[string]struct {
+		Init           func(t *testing.T, db weave.KVStore)
+		Msg            weave.Msg
+		SignedBy       weave.Condition
+		WantCheckErr   *errors.Error
+		WantDeliverErr *errors.Error
+		ExpDelegatee   weave.Address
+	}{
+		"Delegator defaults to main signer": {
+			Msg:          &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID, Delegatee: hBobby},
+			SignedBy:     hAliceCond,
+			ExpDelegatee: hBobby,
+		},
+		"Delegation with explicit delegator": {
+			Msg:          &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID, Delegator: hAlice, Delegatee: hBobby},
+			SignedBy:     hAliceCond,
+			ExpDelegatee: hBobby,
+		},
+		"Existing delegation is replaced": {
+			Init: func(t *testing.T, db weave.KVStore) {
+				b := NewDelegationBucket()
+				d := Delegation{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID, Delegator: hAlice, Delegatee: hCharlie}
+				if err := b.Save(db, b.Build(db, d)); err != nil {
+					t.Fatalf("cannot save delegation: %s", err)
+				}
+			},
+			Msg:          &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID, Delegatee: hBobby},
+			SignedBy:     hAliceCond,
+			ExpDelegatee: hBobby,
+		},
+		"Delegator must sign": {
+			Msg:            &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID, Delegator: hBobby, Delegatee: hAlice},
+			SignedBy:       hAliceCond,
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+		},
+		"Delegator must be an elector": {
+			Msg:            &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID, Delegatee: hAlice},
+			SignedBy:       nonElectorCond,
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+		},
+		"Delegatee must be an elector": {
+			Msg:            &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID, Delegatee: nonElector},
+			SignedBy:       hAliceCond,
+			WantCheckErr:   errors.ErrInput,
+			WantDeliverErr: errors.ErrInput,
+		},
+		"Delegation to self is not allowed": {
+			Msg:            &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID, Delegatee: hAlice},
+			SignedBy:       hAliceCond,
+			WantCheckErr:   errors.ErrInput,
+			WantDeliverErr: errors.ErrInput,
+		},
+		"Unknown electorate": {
+			Msg:            &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(2), Delegatee: hBobby},
+			SignedBy:       hAliceCond,
+			WantCheckErr:   errors.ErrNotFound,
+			WantDeliverErr: errors.ErrNotFound,
+		},
+		"Revoke delegation": {
+			Init: func(t *testing.T, db weave.KVStore) {
+				b := NewDelegationBucket()
+				d := Delegation{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID, Delegator: hAlice, Delegatee: hBobby}
+				if err := b.Save(db, b.Build(db, d)); err != nil {
+					t.Fatalf("cannot save delegation: %s", err)
+				}
+			},
+			Msg:      &RevokeDelegationMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID},
+			SignedBy: hAliceCond,
+		},
+		"Revoke delegation must be signed by the delegator": {
+			Init: func(t *testing.T, db weave.KVStore) {
+				b := NewDelegationBucket()
+				d := Delegation{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID, Delegator: hAlice, Delegatee: hBobby}
+				if err := b.Save(db, b.Build(db, d)); err != nil {
+					t.Fatalf("cannot save delegation: %s", err)
+				}
+			},
+			Msg:            &RevokeDelegationMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID, Delegator: hAlice},
+			SignedBy:       hBobbyCond,
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+		},
+		"Revoke unknown delegation": {
+			Msg:            &RevokeDelegationMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: electorateID},
+			SignedBy:       hAliceCond,
+			WantCheckErr:   errors.ErrNotFound,
+			WantDeliverErr: errors.ErrNotFound,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			auth := &weavetest.Auth{
+				Signer: spec.SignedBy,
+			}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
+			db := store.MemStore()
+			migration.MustInitPkg(db, packageName)
+
+			e := withElectorate(t, db)
+			e.Electors = append(e.Electors, Elector{Address: hCharlie, Weight: 1})
+			e.TotalElectorateWeight++
+			if _, err := NewElectorateBucket().Update(db, electorateID, e); err != nil {
+				t.Fatalf("cannot update electorate: %s", err)
+			}
+			if spec.Init != nil {
+				spec.Init(t, db)
+			}
+			cache := db.CacheWrap()
+
+			ctx := context.Background()
+			tx := &weavetest.Tx{Msg: spec.Msg}
+			if _, err := rt.Check(ctx, cache, tx); !spec.WantCheckErr.Is(err) {
+				t.Fatalf("check expected: %+v  but got %+v", spec.WantCheckErr, err)
+			}
+			cache.Discard()
+
+			if _, err := rt.Deliver(ctx, db, tx); !spec.WantDeliverErr.Is(err) {
+				t.Fatalf("deliver expected: %+v  but got %+v", spec.WantDeliverErr, err)
+			}
+			if spec.WantDeliverErr != nil {
+				return // skip further checks on expected error
+			}
+
+			d, err := NewDelegationBucket().GetDelegation(db, electorateID, spec.SignedBy.Address())
+			if spec.ExpDelegatee == nil {
+				if !errors.ErrNotFound.Is(err) {
+					t.Fatalf("expected delegation to be deleted, got %+v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			assert.Equal(t, spec.ExpDelegatee, d.Delegatee)
+		})
+	}
+}
+
+func TestTallyDelegatedVotes(t *testing.T) {
+	electorateID := weavetest.SequenceID(1)
+	proposalID := weavetest.SequenceID(1)
+
+	electors := make([]weave.Address, 10)
+	for i := range electors {
+		electors[i] = weavetest.NewCondition().Address()
+	}
+	// Weights are assigned by the position, starting with 1.
+	weight := func(i int) uint64 { return uint64(i) + 1 }
+
+	type delegation struct{ from, to int }
+
+	specs := map[string]struct {
+		Votes       map[int]VoteOption
+		Delegations []delegation
+		Exp         TallyResult
+		ExpResult   Proposal_Result
+	}{
+		"Delegated weight follows the delegatee vote": {
+			Votes:       map[int]VoteOption{1: VoteOption_Yes},
+			Delegations: []delegation{{0, 1}},
+			Exp:         TallyResult{TotalYes: weight(0) + weight(1)},
+			ExpResult:   Proposal_Rejected,
+		},
+		"Direct vote overrides delegation": {
+			Votes:       map[int]VoteOption{0: VoteOption_No, 1: VoteOption_Yes},
+			Delegations: []delegation{{0, 1}},
+			Exp:         TallyResult{TotalYes: weight(1), TotalNo: weight(0)},
+			ExpResult:   Proposal_Rejected,
+		},
+		"Delegation chain is resolved": {
+			Votes:       map[int]VoteOption{9: VoteOption_Yes},
+			Delegations: []delegation{{0, 8}, {8, 7}, {7, 9}},
+			Exp:         TallyResult{TotalYes: weight(0) + weight(7) + weight(8) + weight(9)},
+			ExpResult:   Proposal_Accepted,
+		},
+		"Delegation chain stops at the first direct vote": {
+			Votes:       map[int]VoteOption{1: VoteOption_No, 2: VoteOption_Yes},
+			Delegations: []delegation{{0, 1}, {1, 2}},
+			Exp:         TallyResult{TotalYes: weight(2), TotalNo: weight(0) + weight(1)},
+			ExpResult:   Proposal_Rejected,
+		},
+		"Delegation without a vote is not counted": {
+			Delegations: []delegation{{0, 1}},
+			Exp:         TallyResult{},
+			ExpResult:   Proposal_Rejected,
+		},
+		"Delegation cycle is not counted": {
+			Votes:       map[int]VoteOption{9: VoteOption_Yes},
+			Delegations: []delegation{{0, 1}, {1, 2}, {2, 0}},
+			Exp:         TallyResult{TotalYes: weight(9)},
+			ExpResult:   Proposal_Rejected,
+		},
+		"Delegation chain exceeding max depth is not counted": {
+			Votes: map[int]VoteOption{9: VoteOption_Abstain},
+			Delegations: []delegation{
+				{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 6}, {6, 7}, {7, 8}, {8, 9},
+			},
+			// Elector 0 is 9 hops away from the vote, elector 1 is 8 hops away.
+			Exp: TallyResult{
+				TotalAbstain: weight(1) + weight(2) + weight(3) + weight(4) + weight(5) + weight(6) + weight(7) + weight(8) + weight(9),
+			},
+			ExpResult: Proposal_Rejected,
+		},
+	}
+
+	rt := app.NewRouter()
+	RegisterCronRoutes(rt, nil, decodeProposalOptions, proposalOptionsExecutor(), &weavetest.Cron{}, nil)
+
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, packageName)
+			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
+
+			createElectorate(t, db, electors)
+			withElectionRule(t, db)
+
+			var total uint64
+			for i := range electors {
+				total += weight(i)
+			}
+			proposal := proposalFixture(t, hAlice, func(p *Proposal) {
+				p.VoteState = NewTallyResult(nil, Fraction{Numerator: 1, Denominator: 2}, total)
+				p.VotingEndTime = unixBlockTime(t, ctx) - 1
+			})
+			votes := NewVoteBucket()
+			for i, option := range spec.Votes {
+				v := Vote{
+					Metadata: &weave.Metadata{Schema: 1},
+					Elector:  Elector{Address: electors[i], Weight: uint32(weight(i))},
+					Voted:    option,
+				}
+				if err := proposal.CountVote(v); err != nil {
+					t.Fatalf("cannot count vote: %s", err)
+				}
+				if err := votes.Save(db, votes.Build(db, proposalID, v)); err != nil {
+					t.Fatalf("cannot save vote: %s", err)
+				}
+			}
+			pBucket := NewProposalBucket()
+			if _, err := pBucket.Create(db, &proposal); err != nil {
+				t.Fatalf("cannot create proposal: %s", err)
+			}
+			delegations := NewDelegationBucket()
+			for _, d := range spec.Delegations {
+				obj := delegations.Build(db, Delegation{
+					Metadata:     &weave.Metadata{Schema: 1},
+					ElectorateID: electorateID,
+					Delegator:    electors[d.from],
+					Delegatee:    electors[d.to],
+				})
+				if err := delegations.Save(db, obj); err != nil {
+					t.Fatalf("cannot save delegation: %s", err)
+				}
+			}
+
+			tx := &weavetest.Tx{
+				Msg: &TallyMsg{Metadata: &weave.Metadata{Schema: 1}, ProposalID: proposalID},
+			}
+			if _, err := rt.Deliver(ctx, db, tx); err != nil {
+				t.Fatalf("cannot deliver tally: %+v", err)
+			}
+
+			p, err := pBucket.GetProposal(db, proposalID)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			exp := spec.Exp
+			exp.Threshold = Fraction{Numerator: 1, Denominator: 2}
+			exp.TotalElectorateWeight = total
+			if got := p.VoteState; !reflect.DeepEqual(exp, got) {
+				t.Errorf("expected %v but got %v", exp, got)
+			}
+			if exp, got := spec.ExpResult, p.Result; exp != got {
+				t.Errorf("expected %v but got %v", exp, got)
+			}
+		})
+	}
+}
+
+func TestProposalDeposit(t *testing.T) {
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+	deposit := coin.NewCoin(10, 0, "IOV")
+
+	specs := map[string]struct {
+		Funds          coin.Coin
+		Deposit        *coin.Coin
+		Collector      weave.Address
+		Quorum         *Fraction
+		Withdraw       bool
+		Yes, No        uint64
+		WantCreateErr  *errors.Error
+		ExpAuthor      coin.Coin
+		ExpCollector   coin.Coin
+		ExpDepositHeld bool
+	}{
+		"No deposit required": {
+			Funds:     coin.NewCoin(100, 0, "IOV"),
+			No:        10,
+			ExpAuthor: coin.NewCoin(100, 0, "IOV"),
+		},
+		"Deposit is returned when proposal is accepted": {
+			Funds:     coin.NewCoin(100, 0, "IOV"),
+			Deposit:   &deposit,
+			Collector: hCharlie,
+			Yes:       10,
+			ExpAuthor: coin.NewCoin(100, 0, "IOV"),
+		},
+		"Deposit is returned when quorum is reached": {
+			Funds:     coin.NewCoin(100, 0, "IOV"),
+			Deposit:   &deposit,
+			Collector: hCharlie,
+			Quorum:    &Fraction{Numerator: 1, Denominator: 2},
+			No:        10,
+			ExpAuthor: coin.NewCoin(100, 0, "IOV"),
+		},
+		"Deposit is collected when proposal is rejected": {
+			Funds:        coin.NewCoin(100, 0, "IOV"),
+			Deposit:      &deposit,
+			Collector:    hCharlie,
+			No:           10,
+			ExpAuthor:    coin.NewCoin(90, 0, "IOV"),
+			ExpCollector: deposit,
+		},
+		"Deposit is burned when proposal is rejected and collector is not set": {
+			Funds:     coin.NewCoin(100, 0, "IOV"),
+			Deposit:   &deposit,
+			No:        10,
+			ExpAuthor: coin.NewCoin(90, 0, "IOV"),
+		},
+		"Deposit is returned when proposal is withdrawn": {
+			Funds:     coin.NewCoin(100, 0, "IOV"),
+			Deposit:   &deposit,
+			Collector: hCharlie,
+			Withdraw:  true,
+			ExpAuthor: coin.NewCoin(100, 0, "IOV"),
+		},
+		"Insufficient funds to deposit": {
+			Funds:         coin.NewCoin(5, 0, "IOV"),
+			Deposit:       &deposit,
+			WantCreateErr: errors.ErrAmount,
+		},
+	}
+
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, packageName, "cash")
+
+			ctrl := cash.NewController(cash.NewBucket())
+			if err := ctrl.CoinMint(db, hAlice, spec.Funds); err != nil {
+				t.Fatalf("cannot fund author: %s", err)
+			}
+
+			rule := withElectionRule(t, db)
+			rule.ProposalDeposit = spec.Deposit
+			rule.DepositCollector = spec.Collector
+			rule.Quorum = spec.Quorum
+			if _, err := NewElectionRulesBucket().Update(db, weavetest.SequenceID(1), rule); err != nil {
+				t.Fatalf("cannot update election rule: %s", err)
+			}
+			withElectorate(t, db)
+
+			auth := &weavetest.Auth{Signer: hAliceCond}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, ctrl)
+			RegisterCronRoutes(rt, auth, decodeProposalOptions, proposalOptionsExecutor(), &weavetest.Cron{}, ctrl)
+
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			tx := &weavetest.Tx{
+				Msg: &CreateProposalMsg{
+					Metadata:       &weave.Metadata{Schema: 1},
+					Title:          "my proposal",
+					Description:    "my description",
+					StartTime:      now.Add(time.Hour),
+					ElectionRuleID: weavetest.SequenceID(1),
+					RawOption:      genTextOptions(t),
+				},
+			}
+			res, err := rt.Deliver(ctx, db, tx)
+			if !spec.WantCreateErr.Is(err) {
+				t.Fatalf("create expected: %+v  but got %+v", spec.WantCreateErr, err)
+			}
+			if spec.WantCreateErr != nil {
+				return
+			}
+			proposalID := res.Data
+
+			pBucket := NewProposalBucket()
+			p, err := pBucket.GetProposal(db, proposalID)
+			if err != nil {
+				t.Fatalf("cannot load proposal: %s", err)
+			}
+			if !reflect.DeepEqual(spec.Deposit, p.Deposit) {
+				t.Fatalf("want %v deposit, got %v", spec.Deposit, p.Deposit)
+			}
+
+			if spec.Withdraw {
+				tx := &weavetest.Tx{
+					Msg: &DeleteProposalMsg{Metadata: &weave.Metadata{Schema: 1}, ProposalID: proposalID},
+				}
+				if _, err := rt.Deliver(ctx, db, tx); err != nil {
+					t.Fatalf("cannot withdraw proposal: %+v", err)
+				}
+			} else {
+				p.VoteState.TotalYes = spec.Yes
+				p.VoteState.TotalNo = spec.No
+				if err := pBucket.Update(db, proposalID, p); err != nil {
+					t.Fatalf("cannot update proposal: %s", err)
+				}
+				tallyCtx := weave.WithBlockTime(context.Background(), now.Add(3*time.Hour).Time())
+				tx := &weavetest.Tx{
+					Msg: &TallyMsg{Metadata: &weave.Metadata{Schema: 1}, ProposalID: proposalID},
+				}
+				if _, err := rt.Deliver(tallyCtx, db, tx); err != nil {
+					t.Fatalf("cannot deliver tally: %+v", err)
+				}
+			}
+
+			assertBalance(t, db, ctrl, hAlice, spec.ExpAuthor)
+			assertBalance(t, db, ctrl, hCharlie, spec.ExpCollector)
+			assertBalance(t, db, ctrl, DepositAddress(proposalID), coin.Coin{})
+		})
+	}
+}
+
+func assertBalance(t testing.TB, db weave.KVStore, ctrl cash.Controller, a weave.Address, want coin.Coin) {
+	t.Helper()
+
+	coins, err := ctrl.Balance(db, a)
+	if err != nil && !errors.ErrNotFound.Is(err) {
+		t.Fatalf("cannot get %s balance: %s", a, err)
+	}
+	var got coin.Coin
+	for _, c := range coins {
+		if !c.IsZero() {
+			got = *c
+		}
+	}
+	if !got.Equals(want) {
+		t.Fatalf("want %s balance of %v, got %v", a, want, got)
+	}
+}
+
+func TestExecutionDelay(t *testing.T) {
+	proposalID := weavetest.SequenceID(1)
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+	deposit := coin.NewCoin(10, 0, "IOV")
+
+	type step struct {
+		Msg     weave.Msg
+		Signer  weave.Condition
+		At      weave.UnixTime
+		WantErr *errors.Error
+	}
+	veto := func(c weave.Condition, at weave.UnixTime, wantErr *errors.Error) step {
+		return step{
+			Msg:     &VetoMsg{Metadata: &weave.Metadata{Schema: 1}, ProposalID: proposalID},
+			Signer:  c,
+			At:      at,
+			WantErr: wantErr,
+		}
+	}
+	execute := func(at weave.UnixTime, wantErr *errors.Error) step {
+		return step{
+			Msg:     &ExecuteProposalMsg{Metadata: &weave.Metadata{Schema: 1}, ProposalID: proposalID},
+			At:      at,
+			WantErr: wantErr,
+		}
+	}
+
+	specs := map[string]struct {
+		Steps             []step
+		ExpResult         Proposal_Result
+		ExpExecutorResult Proposal_ExecutorResult
+		ExpTotalVeto      uint64
+		ExpAuthor         coin.Coin
+		ExpCollector      coin.Coin
+	}{
+		"Accepted proposal is pending execution": {
+			ExpResult:         Proposal_Accepted,
+			ExpExecutorResult: Proposal_Pending,
+		},
+		"Proposal is executed after the execution delay": {
+			Steps: []step{
+				execute(now.Add(2*time.Hour), nil),
+			},
+			ExpResult:         Proposal_Accepted,
+			ExpExecutorResult: Proposal_Success,
+			ExpAuthor:         deposit,
+		},
+		"Proposal cannot be executed before the execution delay": {
+			Steps: []step{
+				execute(now.Add(30*time.Minute), errors.ErrState),
+			},
+			ExpResult:         Proposal_Accepted,
+			ExpExecutorResult: Proposal_Pending,
+		},
+		"Proposal can be executed only once": {
+			Steps: []step{
+				execute(now.Add(2*time.Hour), nil),
+				execute(now.Add(2*time.Hour), errors.ErrState),
+			},
+			ExpResult:         Proposal_Accepted,
+			ExpExecutorResult: Proposal_Success,
+			ExpAuthor:         deposit,
+		},
+		"Veto below the threshold does not stop the execution": {
+			Steps: []step{
+				veto(hAliceCond, now.Add(time.Minute), nil),
+				execute(now.Add(2*time.Hour), nil),
+			},
+			ExpResult:         Proposal_Accepted,
+			ExpExecutorResult: Proposal_Success,
+			ExpTotalVeto:      1,
+			ExpAuthor:         deposit,
+		},
+		"Veto exceeding the threshold stops the execution": {
+			Steps: []step{
+				veto(hBobbyCond, now.Add(time.Minute), nil),
+				execute(now.Add(2*time.Hour), errors.ErrState),
+			},
+			ExpResult:         Proposal_Vetoed,
+			ExpExecutorResult: Proposal_NotRun,
+			ExpTotalVeto:      10,
+			ExpCollector:      deposit,
+		},
+		"Elector can veto only once": {
+			Steps: []step{
+				veto(hAliceCond, now.Add(time.Minute), nil),
+				veto(hAliceCond, now.Add(2*time.Minute), errors.ErrDuplicate),
+			},
+			ExpResult:         Proposal_Accepted,
+			ExpExecutorResult: Proposal_Pending,
+			ExpTotalVeto:      1,
+		},
+		"Non elector cannot veto": {
+			Steps: []step{
+				veto(hCharlieCond, now.Add(time.Minute), errors.ErrUnauthorized),
+			},
+			ExpResult:         Proposal_Accepted,
+			ExpExecutorResult: Proposal_Pending,
+		},
+		"Veto after the execution time is not accepted": {
+			Steps: []step{
+				veto(hBobbyCond, now.Add(2*time.Hour), errors.ErrState),
+			},
+			ExpResult:         Proposal_Accepted,
+			ExpExecutorResult: Proposal_Pending,
+		},
+	}
+
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, packageName, "cash")
+
+			ctrl := cash.NewController(cash.NewBucket())
+			if err := ctrl.CoinMint(db, DepositAddress(proposalID), deposit); err != nil {
+				t.Fatalf("cannot fund deposit: %s", err)
+			}
+
+			withElectorate(t, db)
+			rule := withElectionRule(t, db)
+			rule.ExecutionDelay = weave.AsUnixDuration(time.Hour)
+			rule.DepositCollector = hCharlie
+			if _, err := NewElectionRulesBucket().Update(db, weavetest.SequenceID(1), rule); err != nil {
+				t.Fatalf("cannot update election rule: %s", err)
+			}
+			proposal := proposalFixture(t, hAlice, func(p *Proposal) {
+				p.ElectionRuleRef.Version = 2
+				p.VoteState.TotalYes = 10
+				p.VotingStartTime = now.Add(-2 * time.Minute)
+				p.VotingEndTime = now.Add(-time.Minute)
+				p.Deposit = &deposit
+			})
+			pBucket := NewProposalBucket()
+			if _, err := pBucket.Create(db, &proposal); err != nil {
+				t.Fatalf("cannot create proposal: %s", err)
+			}
+
+			auth := &weavetest.Auth{}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, ctrl)
+			RegisterCronRoutes(rt, auth, decodeProposalOptions, proposalOptionsExecutor(), &weavetest.Cron{}, ctrl)
+
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			tx := &weavetest.Tx{
+				Msg: &TallyMsg{Metadata: &weave.Metadata{Schema: 1}, ProposalID: proposalID},
+			}
+			res, err := rt.Deliver(ctx, db, tx)
+			if err != nil {
+				t.Fatalf("cannot deliver tally: %+v", err)
+			}
+			if exp, got := "Proposal accepted: execution pending", res.Log; exp != got {
+				t.Fatalf("want %q log, got %q", exp, got)
+			}
+
+			for i, s := range spec.Steps {
+				auth.Signer = s.Signer
+				ctx := weave.WithBlockTime(context.Background(), s.At.Time())
+				tx := &weavetest.Tx{Msg: s.Msg}
+				if _, err := rt.Deliver(ctx, db, tx); !s.WantErr.Is(err) {
+					t.Fatalf("step %d: want %+v error, got %+v", i, s.WantErr, err)
+				}
+			}
+
+			p, err := pBucket.GetProposal(db, proposalID)
+			if err != nil {
+				t.Fatalf("cannot load proposal: %s", err)
+			}
+			if exp, got := spec.ExpResult, p.Result; exp != got {
+				t.Errorf("want %v result, got %v", exp, got)
+			}
+			if exp, got := spec.ExpExecutorResult, p.ExecutorResult; exp != got {
+				t.Errorf("want %v executor result, got %v", exp, got)
+			}
+			if exp, got := now.Add(time.Hour), p.ExecutionTime; exp != got {
+				t.Errorf("want %v execution time, got %v", exp, got)
+			}
+			if exp, got := spec.ExpTotalVeto, p.TotalVeto; exp != got {
+				t.Errorf("want %d total veto, got %d", exp, got)
+			}
+			assertBalance(t, db, ctrl, hAlice, spec.ExpAuthor)
+			assertBalance(t, db, ctrl, hCharlie, spec.ExpCollector)
+		})
+	}
+}
+
+func TestVoteHistory(t *testing.T) {
+	proposalID := weavetest.SequenceID(1)
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+
+	auth := &weavetest.Auth{}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
+
+	pBucket := withTextProposal(t, db, weave.WithBlockTime(context.Background(), now.Time()))
+
+	votes := []struct {
+		signer weave.Condition
+		option VoteOption
+		height int64
+	}{
+		{hAliceCond, VoteOption_Yes, 10},
+		{hBobbyCond, VoteOption_No, 11},
+		{hAliceCond, VoteOption_No, 12},
+		{hAliceCond, VoteOption_Abstain, 13},
+	}
+	for i, v := range votes {
+		auth.Signer = v.signer
+		ctx := weave.WithBlockTime(context.Background(), now.Add(time.Duration(i)*time.Second).Time())
+		ctx = weave.WithHeight(ctx, v.height)
+		tx := &weavetest.Tx{
+			Msg: &VoteMsg{Metadata: &weave.Metadata{Schema: 1}, ProposalID: proposalID, Selected: v.option},
+		}
+		if _, err := rt.Deliver(ctx, db, tx); err != nil {
+			t.Fatalf("cannot deliver vote %d: %+v", i, err)
+		}
+	}
+
+	// Only the last vote of each elector is counted.
+	p, err := pBucket.GetProposal(db, proposalID)
+	if err != nil {
+		t.Fatalf("cannot load proposal: %s", err)
+	}
+	exp := TallyResult{
+		TotalNo:               10,
+		TotalAbstain:          1,
+		Threshold:             Fraction{Numerator: 1, Denominator: 2},
+		TotalElectorateWeight: 11,
+	}
+	if got := p.VoteState; exp != got {
+		t.Errorf("expected %v but got %v", exp, got)
+	}
+
+	records, err := NewVoteRecordBucket().VoteHistory(db, proposalID, hAlice)
+	if err != nil {
+		t.Fatalf("cannot load vote history: %s", err)
+	}
+	want := []*VoteRecord{
+		{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ProposalID: proposalID,
+			Elector:    Elector{Address: hAlice, Weight: 1},
+			Voted:      VoteOption_Yes,
+			VotedAt:    now,
+			Height:     10,
+		},
+		{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ProposalID: proposalID,
+			Elector:    Elector{Address: hAlice, Weight: 1},
+			Voted:      VoteOption_No,
+			VotedAt:    now.Add(2 * time.Second),
+			Height:     12,
+		},
+		{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ProposalID: proposalID,
+			Elector:    Elector{Address: hAlice, Weight: 1},
+			Voted:      VoteOption_Abstain,
+			VotedAt:    now.Add(3 * time.Second),
+			Height:     13,
+		},
+	}
+	if !reflect.DeepEqual(want, records) {
+		t.Fatalf("unexpected vote history: %v", records)
+	}
+
+	objs, err := NewVoteRecordBucket().GetIndexed(db, indexNameProposal, proposalID)
+	if err != nil {
+		t.Fatalf("cannot query proposal vote records: %s", err)
+	}
+	if exp, got := len(votes), len(objs); exp != got {
+		t.Fatalf("want %d proposal vote records, got %d", exp, got)
+	}
+}
+
+func TestTokenWeightedElectorate(t *testing.T) {
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+
+	specs := map[string]struct {
+		Funds         map[string]coin.Coin
+		Signer        weave.Condition
+		WantCreateErr *errors.Error
+		ExpElectors   []Elector
+		ExpTotal      uint64
+	}{
+		"Weights are whole tokens held": {
+			Funds: map[string]coin.Coin{
+				hAlice.String(): coin.NewCoin(3, 500000000, "IOV"),
+				hBobby.String(): coin.NewCoin(10, 0, "IOV"),
+			},
+			Signer: hAliceCond,
+			ExpElectors: []Elector{
+				{Address: hAlice, Weight: 3},
+				{Address: hBobby, Weight: 10},
+			},
+			ExpTotal: 13,
+		},
+		"Weights are scaled when a holder exceeds the max weight": {
+			Funds: map[string]coin.Coin{
+				hAlice.String():   coin.NewCoin(40000, 0, "IOV"),
+				hBobby.String():   coin.NewCoin(100000, 0, "IOV"),
+				hCharlie.String(): coin.NewCoin(1, 0, "IOV"),
+			},
+			Signer: hBobbyCond,
+			// Charlie's scaled weight is below one.
+			ExpElectors: []Elector{
+				{Address: hAlice, Weight: 26214},
+				{Address: hBobby, Weight: maxWeight},
+			},
+			ExpTotal: 26214 + maxWeight,
+		},
+		"Weights of huge holdings are scaled without an overflow": {
+			Funds: map[string]coin.Coin{
+				hAlice.String(): coin.NewCoin(coin.MaxInt/4, 0, "IOV"),
+				hBobby.String(): coin.NewCoin(coin.MaxInt/2, 0, "IOV"),
+			},
+			Signer: hAliceCond,
+			ExpElectors: []Elector{
+				{Address: hAlice, Weight: maxWeight / 2},
+				{Address: hBobby, Weight: maxWeight},
+			},
+			ExpTotal: maxWeight/2 + maxWeight,
+		},
+		"Holders of less than a whole token or other tokens are not electors": {
+			Funds: map[string]coin.Coin{
+				hAlice.String():   coin.NewCoin(2, 0, "IOV"),
+				hBobby.String():   coin.NewCoin(0, 900000000, "IOV"),
+				hCharlie.String(): coin.NewCoin(50, 0, "ETH"),
+			},
+			Signer: hAliceCond,
+			ExpElectors: []Elector{
+				{Address: hAlice, Weight: 2},
+			},
+			ExpTotal: 2,
+		},
+		"Author must hold tokens": {
+			Funds: map[string]coin.Coin{
+				hAlice.String():   coin.NewCoin(2, 0, "IOV"),
+				hCharlie.String(): coin.NewCoin(0, 1, "IOV"),
+			},
+			Signer:        hCharlieCond,
+			WantCreateErr: errors.ErrUnauthorized,
+		},
+		"No token holders": {
+			Funds: map[string]coin.Coin{
+				hAlice.String(): coin.NewCoin(2, 0, "ETH"),
+			},
+			Signer:        hAliceCond,
+			WantCreateErr: errors.ErrState,
+		},
+	}
+
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, packageName, "cash")
+
+			ctrl := cash.NewController(cash.NewBucket())
+			for _, a := range []weave.Address{hAlice, hBobby, hCharlie} {
+				if funds, ok := spec.Funds[a.String()]; ok {
+					if err := ctrl.CoinMint(db, a, funds); err != nil {
+						t.Fatalf("cannot fund %s: %s", a, err)
+					}
+				}
+			}
+
+			electorate := &Electorate{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Title:        "token holders",
+				Admin:        hBobby,
+				WeightTicker: "IOV",
+			}
+			if _, err := NewElectorateBucket().Create(db, electorate); err != nil {
+				t.Fatalf("cannot create electorate: %+v", err)
+			}
+			withElectionRule(t, db)
+
+			auth := &weavetest.Auth{Signer: spec.Signer}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, ctrl)
+
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			tx := &weavetest.Tx{
+				Msg: &CreateProposalMsg{
+					Metadata:       &weave.Metadata{Schema: 1},
+					Title:          "my proposal",
+					Description:    "my description",
+					StartTime:      now.Add(time.Hour),
+					ElectionRuleID: weavetest.SequenceID(1),
+					RawOption:      genTextOptions(t),
+				},
+			}
+			cres, err := rt.Check(ctx, db.CacheWrap(), tx)
+			if !spec.WantCreateErr.Is(err) {
+				t.Fatalf("check expected: %+v  but got %+v", spec.WantCreateErr, err)
+			}
+			if err == nil {
+				// Gas is charged for every holder read.
+				var holders int64
+				for _, c := range spec.Funds {
+					if c.Ticker == "IOV" {
+						holders++
+					}
+				}
+				if want := holders * snapshotHolderCost; cres.GasAllocated != want {
+					t.Fatalf("want %d gas allocated, got %d", want, cres.GasAllocated)
+				}
+			}
+
+			res, err := rt.Deliver(ctx, db, tx)
+			if !spec.WantCreateErr.Is(err) {
+				t.Fatalf("create expected: %+v  but got %+v", spec.WantCreateErr, err)
+			}
+			if spec.WantCreateErr != nil {
+				return
+			}
+			proposalID := res.Data
+
+			pBucket := NewProposalBucket()
+			p, err := pBucket.GetProposal(db, proposalID)
+			if err != nil {
+				t.Fatalf("cannot load proposal: %s", err)
+			}
+			if p.VoteState.TotalElectorateWeight != spec.ExpTotal {
+				t.Fatalf("want %d total weight, got %d", spec.ExpTotal, p.VoteState.TotalElectorateWeight)
+			}
+
+			// The snapshot is stored as a new electorate version.
+			if p.ElectorateRef.Version != 2 {
+				t.Fatalf("want electorate version 2, got %d", p.ElectorateRef.Version)
+			}
+			obj, err := NewElectorateBucket().GetVersion(db, p.ElectorateRef)
+			if err != nil {
+				t.Fatalf("cannot load electorate snapshot: %s", err)
+			}
+			snapshot, err := asElectorate(obj)
+			if err != nil {
+				t.Fatalf("cannot load electorate snapshot: %s", err)
+			}
+			exp := append([]Elector(nil), spec.ExpElectors...)
+			sortByAddress(exp)
+			if !reflect.DeepEqual(exp, snapshot.Electors) {
+				t.Fatalf("want electors %v, got %v", exp, snapshot.Electors)
+			}
+			if snapshot.TotalElectorateWeight != spec.ExpTotal {
+				t.Fatalf("want %d snapshot total weight, got %d", spec.ExpTotal, snapshot.TotalElectorateWeight)
+			}
+
+			// Balance changes after the proposal creation do not
+			// change the voting power.
+			signer := spec.Signer.Address()
+			if err := ctrl.MoveCoins(db, signer, hCharlie, coin.NewCoin(1, 0, "IOV")); err != nil {
+				t.Fatalf("cannot move coins: %s", err)
+			}
+			voteCtx := weave.WithBlockTime(context.Background(), now.Add(90*time.Minute).Time())
+			tx = &weavetest.Tx{
+				Msg: &VoteMsg{Metadata: &weave.Metadata{Schema: 1}, ProposalID: proposalID, Selected: VoteOption_Yes},
+			}
+			if _, err := rt.Deliver(voteCtx, db, tx); err != nil {
+				t.Fatalf("cannot vote: %+v", err)
+			}
+			elector, ok := snapshot.Elector(signer)
+			if !ok {
+				t.Fatal("signer is not an elector")
+			}
+			p, err = pBucket.GetProposal(db, proposalID)
+			if err != nil {
+				t.Fatalf("cannot load proposal: %s", err)
+			}
+			if p.VoteState.TotalYes != uint64(elector.Weight) {
+				t.Fatalf("want %d yes votes, got %d", elector.Weight, p.VoteState.TotalYes)
+			}
+		})
+	}
+}
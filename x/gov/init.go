@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 )
 
@@ -22,20 +23,24 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 	}
 	var governance struct {
 		Electorate []struct {
-			Admin    weave.Address `json:"admin"`
-			Title    string        `json:"title"`
-			Electors []struct {
+			Admin        weave.Address `json:"admin"`
+			Title        string        `json:"title"`
+			WeightTicker string        `json:"weight_ticker"`
+			Electors     []struct {
 				Address weave.Address `json:"address"`
 				Weight  uint32        `json:"weight"`
 			} `json:"electors"`
 		} `json:"electorate"`
 		Rules []struct {
-			Admin        weave.Address      `json:"admin"`
-			ElectorateID uint64             `json:"electorate_id"`
-			Title        string             `json:"title"`
-			VotingPeriod weave.UnixDuration `json:"voting_period"`
-			Quorum       fraction           `json:"quorum"`
-			Threshold    fraction           `json:"threshold"`
+			Admin            weave.Address      `json:"admin"`
+			ElectorateID     uint64             `json:"electorate_id"`
+			Title            string             `json:"title"`
+			VotingPeriod     weave.UnixDuration `json:"voting_period"`
+			Quorum           fraction           `json:"quorum"`
+			Threshold        fraction           `json:"threshold"`
+			ProposalDeposit  *coin.Coin         `json:"proposal_deposit"`
+			DepositCollector weave.Address      `json:"deposit_collector"`
+			ExecutionDelay   weave.UnixDuration `json:"execution_delay"`
 		} `json:"rules"`
 	}
 	if err := opts.ReadOptions("governance", &governance); err != nil {
@@ -60,6 +65,7 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			Title:                 e.Title,
 			Electors:              ps,
 			TotalElectorateWeight: total,
+			WeightTicker:          e.WeightTicker,
 		}
 		if err := electorate.Validate(); err != nil {
 			return errors.Wrapf(err, "electorate #%d is invalid", i)
@@ -91,6 +97,10 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			Threshold:    Fraction{Numerator: r.Threshold.Numerator, Denominator: r.Threshold.Denominator},
 			ElectorateID: electorateID,
 			Address:      Condition(newRuleID).Address(),
+
+			ProposalDeposit:  r.ProposalDeposit,
+			DepositCollector: r.DepositCollector,
+			ExecutionDelay:   r.ExecutionDelay,
 		}
 		if r.Quorum.Numerator != 0 || r.Quorum.Denominator != 0 {
 			rule.Quorum = &Fraction{Numerator: r.Quorum.Numerator, Denominator: r.Quorum.Denominator}
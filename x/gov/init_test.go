@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
@@ -67,6 +68,8 @@ func TestInitFromGenesis(t *testing.T) {
 						"numerator": 2,
 						"denominator": 3
 					},
+					"proposal_deposit": "10 IOV",
+					"deposit_collector": "5555555555555555555555555555555555555555",
 					"electorate_id": 2
 				}
 			]
@@ -167,6 +170,9 @@ func TestInitFromGenesis(t *testing.T) {
 	if r.Quorum != nil {
 		t.Errorf("expected nil but got %v", r.Quorum)
 	}
+	if r.ProposalDeposit != nil {
+		t.Errorf("expected nil but got %v", r.ProposalDeposit)
+	}
 	if exp, got := weavetest.SequenceID(1), r.ElectorateID; !bytes.Equal(exp, got) {
 		t.Errorf("expected %v but got %v", exp, got)
 	}
@@ -209,6 +215,12 @@ func TestInitFromGenesis(t *testing.T) {
 	if exp, got := Condition(weavetest.SequenceID(2)).Address(), r.Address; !bytes.Equal(exp, got) {
 		t.Errorf("expected %v but got %v", exp, got)
 	}
+	if exp, got := coin.NewCoin(10, 0, "IOV"), r.ProposalDeposit; got == nil || !exp.Equals(*got) {
+		t.Errorf("expected %v but got %v", exp, got)
+	}
+	if exp, got := addr("5555555555555555555555555555555555555555"), r.DepositCollector; !exp.Equals(got) {
+		t.Errorf("expected %X but got %X", exp, got)
+	}
 }
 
 func addr(s string) weave.Address {
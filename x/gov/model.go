@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
@@ -21,6 +22,9 @@ func init() {
 	migration.MustRegister(1, &Proposal{}, migration.NoModification)
 	migration.MustRegister(1, &Resolution{}, migration.NoModification)
 	migration.MustRegister(1, &Vote{}, migration.NoModification)
+	migration.MustRegister(1, &VoteRecord{}, migration.NoModification)
+	migration.MustRegister(1, &Veto{}, migration.NoModification)
+	migration.MustRegister(1, &Delegation{}, migration.NoModification)
 }
 
 // Condition calculates the address of an election rule given
@@ -38,7 +42,11 @@ func (m Electorate) Validate() error {
 		return errors.Wrap(err, "invalid metadata")
 	}
 
-	if len(m.Electors) == 0 {
+	if m.IsTokenWeighted() {
+		if !coin.IsCC(m.WeightTicker) {
+			return errors.Wrapf(errors.ErrCurrency, "invalid weight ticker: %q", m.WeightTicker)
+		}
+	} else if len(m.Electors) == 0 {
 		return errors.Wrap(errors.ErrInput, "electors must not be empty")
 	}
 	if len(m.Electors) > maxElectors {
@@ -71,12 +79,19 @@ func (m Electorate) Copy() orm.CloneableData {
 	p := make([]Elector, 0, len(m.Electors))
 	copy(p, m.Electors)
 	return &Electorate{
-		Title:    m.Title,
-		Electors: p,
-		Version:  m.Version,
+		Title:        m.Title,
+		Electors:     p,
+		Version:      m.Version,
+		WeightTicker: m.WeightTicker,
 	}
 }
 
+// IsTokenWeighted returns true if the electors of this electorate are derived
+// from the cash balances instead of being maintained manually.
+func (m Electorate) IsTokenWeighted() bool {
+	return m.WeightTicker != ""
+}
+
 // Weight return the weight for the given address is in the electors list and an ok flag which
 // is true when the address exists in the electors list only.
 func (m Electorate) Elector(a weave.Address) (*Elector, bool) {
@@ -103,6 +118,8 @@ func (m Elector) Validate() error {
 var (
 	minVotingPeriod = time.Second
 	maxVotingPeriod = 4 * 7 * 24 * time.Hour // 4 weeks
+
+	maxExecutionDelay = 4 * 7 * 24 * time.Hour // 4 weeks
 )
 
 func (m *ElectionRule) SetVersion(v uint32) {
@@ -144,14 +161,46 @@ func (m ElectionRule) Validate() error {
 	if err := m.Address.Validate(); err != nil {
 		return errors.Wrap(err, "address")
 	}
+	if err := validateDeposit(m.ProposalDeposit, m.DepositCollector); err != nil {
+		return err
+	}
+	if m.ExecutionDelay.Duration() > maxExecutionDelay {
+		return errors.Wrapf(errors.ErrInput, "execution delay max %s", maxExecutionDelay)
+	}
+	return nil
+}
+
+// validateDeposit returns an error if the proposal deposit configuration is
+// not valid. Both values are optional.
+func validateDeposit(deposit *coin.Coin, collector weave.Address) error {
+	if !coin.IsEmpty(deposit) {
+		if err := deposit.Validate(); err != nil {
+			return errors.Wrap(err, "proposal deposit")
+		}
+		if !deposit.IsPositive() {
+			return errors.Wrap(errors.ErrAmount, "proposal deposit must be positive")
+		}
+	}
+	if collector != nil {
+		if err := collector.Validate(); err != nil {
+			return errors.Wrap(err, "deposit collector")
+		}
+	}
 	return nil
 }
 
 func (m ElectionRule) Copy() orm.CloneableData {
+	var deposit *coin.Coin
+	if m.ProposalDeposit != nil {
+		deposit = m.ProposalDeposit.Clone()
+	}
 	return &ElectionRule{
-		Title:        m.Title,
-		VotingPeriod: m.VotingPeriod,
-		Threshold:    m.Threshold,
+		Title:            m.Title,
+		VotingPeriod:     m.VotingPeriod,
+		Threshold:        m.Threshold,
+		ProposalDeposit:  deposit,
+		DepositCollector: m.DepositCollector,
+		ExecutionDelay:   m.ExecutionDelay,
 	}
 }
 
@@ -218,11 +267,20 @@ func (m *Proposal) Validate() error {
 	if err := m.ElectorateRef.Validate(); err != nil {
 		return errors.Wrap(err, "electorate reference")
 	}
+	if !coin.IsEmpty(m.Deposit) {
+		if err := m.Deposit.Validate(); err != nil {
+			return errors.Wrap(err, "deposit")
+		}
+	}
 	return m.VoteState.Validate()
 }
 
 func (m Proposal) Copy() orm.CloneableData {
 	optionCopy := append([]byte{}, m.RawOption...)
+	var deposit *coin.Coin
+	if m.Deposit != nil {
+		deposit = m.Deposit.Clone()
+	}
 	return &Proposal{
 		Metadata:        m.Metadata.Copy(),
 		Title:           m.Title,
@@ -237,9 +295,22 @@ func (m Proposal) Copy() orm.CloneableData {
 		VoteState:       m.VoteState,
 		Status:          m.Status,
 		Result:          m.Result,
+		Deposit:         deposit,
+		ExecutionTime:   m.ExecutionTime,
+		ExecutionTaskID: m.ExecutionTaskID,
+		TotalVeto:       m.TotalVeto,
 	}
 }
 
+// VetoThresholdReached returns true if the total veto weight exceeds the
+// threshold fraction of the total electorate weight.
+func (m Proposal) VetoThresholdReached() bool {
+	// (veto * denominator) > (electorate * numerator)
+	p1 := new(big.Int).Mul(new(big.Int).SetUint64(m.TotalVeto), big.NewInt(int64(m.VoteState.Threshold.Denominator)))
+	p2 := new(big.Int).Mul(new(big.Int).SetUint64(m.VoteState.TotalElectorateWeight), big.NewInt(int64(m.VoteState.Threshold.Numerator)))
+	return p1.Cmp(p2) > 0
+}
+
 // CountVote updates the intermediate tally result by adding the new vote weight.
 func (m *Proposal) CountVote(vote Vote) error {
 	oldTotal := m.VoteState.TotalVotes()
@@ -335,22 +406,12 @@ func (m TallyResult) Accepted() bool {
 		return true
 	}
 
-	total := m.TotalVotes()
-	bTotalElectorateWeight := new(big.Int).SetUint64(m.TotalElectorateWeight)
-	bBaseWeight := bTotalElectorateWeight
+	bBaseWeight := new(big.Int).SetUint64(m.TotalElectorateWeight)
 	if m.Quorum != nil {
 		// new base = total Yes + total No
 		bBaseWeight = new(big.Int).Add(new(big.Int).SetUint64(m.TotalYes), new(big.Int).SetUint64(m.TotalNo))
-
-		if total != m.TotalElectorateWeight { // handles non 1/1 quorums only
-			// quorum reached when
-			// totalVotes * quorumDenominator > electorate * quorumNumerator
-			bTotalVotes := new(big.Int).SetUint64(total)
-			p1 := new(big.Int).Mul(bTotalVotes, big.NewInt(int64(m.Quorum.Denominator)))
-			p2 := new(big.Int).Mul(bTotalElectorateWeight, big.NewInt(int64(m.Quorum.Numerator)))
-			if p1.Cmp(p2) < 1 {
-				return false
-			}
+		if !m.QuorumReached() {
+			return false
 		}
 	}
 
@@ -361,6 +422,23 @@ func (m TallyResult) Accepted() bool {
 	return p1.Cmp(p2) > 0
 }
 
+// QuorumReached returns true if a quorum is set and the total number of votes
+// exceeds the quorum fraction of the total electorate weight.
+func (m TallyResult) QuorumReached() bool {
+	if m.Quorum == nil {
+		return false
+	}
+	total := m.TotalVotes()
+	if total == m.TotalElectorateWeight { // handles 1/1 quorum
+		return true
+	}
+	// quorum reached when
+	// totalVotes * quorumDenominator > electorate * quorumNumerator
+	p1 := new(big.Int).Mul(new(big.Int).SetUint64(total), big.NewInt(int64(m.Quorum.Denominator)))
+	p2 := new(big.Int).Mul(new(big.Int).SetUint64(m.TotalElectorateWeight), big.NewInt(int64(m.Quorum.Numerator)))
+	return p1.Cmp(p2) > 0
+}
+
 // TotalVotes returns the sum of yes, no, abstain votes weights.
 func (m TallyResult) TotalVotes() uint64 {
 	return m.TotalYes + m.TotalNo + m.TotalAbstain
@@ -405,6 +483,84 @@ func (m Vote) Copy() orm.CloneableData {
 	}
 }
 
+func (m VoteRecord) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "invalid metadata")
+	}
+	if len(m.ProposalID) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "proposal id")
+	}
+	if err := m.Elector.Validate(); err != nil {
+		return errors.Wrap(err, "invalid elector")
+	}
+	if m.Voted == VoteOption_Invalid {
+		return errors.Wrap(errors.ErrInput, "invalid vote option")
+	}
+	if err := m.VotedAt.Validate(); err != nil {
+		return errors.Wrap(err, "voted at")
+	}
+	if m.Height < 0 {
+		return errors.Wrap(errors.ErrInput, "negative height")
+	}
+	return nil
+}
+
+func (m VoteRecord) Copy() orm.CloneableData {
+	return &VoteRecord{
+		Metadata:   m.Metadata.Copy(),
+		ProposalID: append([]byte{}, m.ProposalID...),
+		Elector:    m.Elector,
+		Voted:      m.Voted,
+		VotedAt:    m.VotedAt,
+		Height:     m.Height,
+	}
+}
+
+func (m Veto) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "invalid metadata")
+	}
+	if err := m.Elector.Validate(); err != nil {
+		return errors.Wrap(err, "invalid elector")
+	}
+	return nil
+}
+
+func (m Veto) Copy() orm.CloneableData {
+	return &Veto{
+		Metadata: m.Metadata.Copy(),
+		Elector:  m.Elector,
+	}
+}
+
+func (m Delegation) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "invalid metadata")
+	}
+	if len(m.ElectorateID) != 8 {
+		return errors.Wrap(errors.ErrInput, "must refer to electorate with 8 byte id")
+	}
+	if err := m.Delegator.Validate(); err != nil {
+		return errors.Wrap(err, "delegator")
+	}
+	if err := m.Delegatee.Validate(); err != nil {
+		return errors.Wrap(err, "delegatee")
+	}
+	if m.Delegator.Equals(m.Delegatee) {
+		return errors.Wrap(errors.ErrInput, "must not delegate to self")
+	}
+	return nil
+}
+
+func (m Delegation) Copy() orm.CloneableData {
+	return &Delegation{
+		Metadata:     m.Metadata.Copy(),
+		ElectorateID: append([]byte{}, m.ElectorateID...),
+		Delegator:    m.Delegator.Clone(),
+		Delegatee:    m.Delegatee.Clone(),
+	}
+}
+
 // DiffElectors contains the changes that should be applied. Adding an address should have a positive weight, removing
 // with weight=0.
 type ElectorsDiff []Elector
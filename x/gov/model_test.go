@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/weavetest"
@@ -53,6 +54,22 @@ func TestElectorateValidation(t *testing.T) {
 			},
 			Exp: errors.ErrInput,
 		},
+		"All good with token weighted electorate": {
+			Src: Electorate{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Title:        "My Electorate",
+				Admin:        alice,
+				WeightTicker: "IOV",
+			}},
+		"Invalid weight ticker": {
+			Src: Electorate{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Title:        "My Electorate",
+				Admin:        alice,
+				WeightTicker: "iov",
+			},
+			Exp: errors.ErrCurrency,
+		},
 		"Too many electors": {
 			Src: Electorate{
 				Metadata:              &weave.Metadata{Schema: 1},
@@ -420,6 +437,46 @@ func TestElectionRuleValidation(t *testing.T) {
 			},
 			Exp: errors.ErrMetadata,
 		},
+		"Proposal deposit with collector": {
+			Src: ElectionRule{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Title:            "My election rule",
+				Admin:            alice,
+				VotingPeriod:     weave.AsUnixDuration(time.Hour),
+				Threshold:        Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:     weavetest.SequenceID(5),
+				Address:          Condition(weavetest.SequenceID(6)).Address(),
+				ProposalDeposit:  coin.NewCoinp(10, 0, "IOV"),
+				DepositCollector: alice,
+			},
+		},
+		"Proposal deposit must be positive": {
+			Src: ElectionRule{
+				Metadata:        &weave.Metadata{Schema: 1},
+				Title:           "My election rule",
+				Admin:           alice,
+				VotingPeriod:    weave.AsUnixDuration(time.Hour),
+				Threshold:       Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:    weavetest.SequenceID(5),
+				Address:         Condition(weavetest.SequenceID(6)).Address(),
+				ProposalDeposit: coin.NewCoinp(-10, 0, "IOV"),
+			},
+			Exp: errors.ErrAmount,
+		},
+		"Deposit collector must be valid": {
+			Src: ElectionRule{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Title:            "My election rule",
+				Admin:            alice,
+				VotingPeriod:     weave.AsUnixDuration(time.Hour),
+				Threshold:        Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:     weavetest.SequenceID(5),
+				Address:          Condition(weavetest.SequenceID(6)).Address(),
+				ProposalDeposit:  coin.NewCoinp(10, 0, "IOV"),
+				DepositCollector: weave.Address("invalid"),
+			},
+			Exp: errors.ErrInput,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -579,6 +636,72 @@ func TestVoteValidate(t *testing.T) {
 	}
 }
 
+func TestVoteRecordValidate(t *testing.T) {
+	bobby := weavetest.NewCondition().Address()
+	now := weave.AsUnixTime(time.Now())
+
+	specs := map[string]struct {
+		Src VoteRecord
+		Exp *errors.Error
+	}{
+		"All good": {
+			Src: VoteRecord{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: weavetest.SequenceID(1),
+				Voted:      VoteOption_Yes,
+				Elector:    Elector{Address: bobby, Weight: 10},
+				VotedAt:    now,
+				Height:     5,
+			},
+		},
+		"Proposal ID missing": {
+			Src: VoteRecord{
+				Metadata: &weave.Metadata{Schema: 1},
+				Voted:    VoteOption_Yes,
+				Elector:  Elector{Address: bobby, Weight: 10},
+				VotedAt:  now,
+			},
+			Exp: errors.ErrEmpty,
+		},
+		"Invalid option": {
+			Src: VoteRecord{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: weavetest.SequenceID(1),
+				Elector:    Elector{Address: bobby, Weight: 10},
+				VotedAt:    now,
+			},
+			Exp: errors.ErrInput,
+		},
+		"Negative height": {
+			Src: VoteRecord{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: weavetest.SequenceID(1),
+				Voted:      VoteOption_Yes,
+				Elector:    Elector{Address: bobby, Weight: 10},
+				VotedAt:    now,
+				Height:     -1,
+			},
+			Exp: errors.ErrInput,
+		},
+		"Metadata missing": {
+			Src: VoteRecord{
+				ProposalID: weavetest.SequenceID(1),
+				Voted:      VoteOption_Yes,
+				Elector:    Elector{Address: bobby, Weight: 10},
+				VotedAt:    now,
+			},
+			Exp: errors.ErrMetadata,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			if exp, got := spec.Exp, spec.Src.Validate(); !exp.Is(got) {
+				t.Errorf("expected %v but got %v", exp, got)
+			}
+		})
+	}
+}
+
 func TestResolutionValidate(t *testing.T) {
 	specs := map[string]struct {
 		Mutator func(r *Resolution)
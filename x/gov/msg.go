@@ -10,9 +10,13 @@ func init() {
 	migration.MustRegister(1, &CreateProposalMsg{}, migration.NoModification)
 	migration.MustRegister(1, &VoteMsg{}, migration.NoModification)
 	migration.MustRegister(1, &TallyMsg{}, migration.NoModification)
+	migration.MustRegister(1, &VetoMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ExecuteProposalMsg{}, migration.NoModification)
 	migration.MustRegister(1, &DeleteProposalMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateElectionRuleMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateElectorateMsg{}, migration.NoModification)
+	migration.MustRegister(1, &DelegateMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RevokeDelegationMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*CreateProposalMsg)(nil)
@@ -110,6 +114,41 @@ func (m TallyMsg) Validate() error {
 	return nil
 }
 
+var _ weave.Msg = (*VetoMsg)(nil)
+
+func (VetoMsg) Path() string {
+	return "gov/veto"
+}
+
+func (m VetoMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "invalid metadata")
+	}
+	if len(m.ProposalID) == 0 {
+		return errors.Wrap(errors.ErrInput, "empty proposal id")
+	}
+	if err := m.Elector.Validate(); m.Elector != nil && err != nil {
+		return errors.Wrap(err, "invalid elector")
+	}
+	return nil
+}
+
+var _ weave.Msg = (*ExecuteProposalMsg)(nil)
+
+func (ExecuteProposalMsg) Path() string {
+	return "gov/execute_proposal"
+}
+
+func (m ExecuteProposalMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "invalid metadata")
+	}
+	if len(m.ProposalID) == 0 {
+		return errors.Wrap(errors.ErrInput, "empty proposal id")
+	}
+	return nil
+}
+
 var _ weave.Msg = (*UpdateElectionRuleMsg)(nil)
 
 func (UpdateElectionRuleMsg) Path() string {
@@ -139,6 +178,12 @@ func (m UpdateElectionRuleMsg) Validate() error {
 	if err := m.Threshold.Validate(); err != nil {
 		errs = errors.Append(errs, errors.Wrap(err, "threshold"))
 	}
+	if err := validateDeposit(m.ProposalDeposit, m.DepositCollector); err != nil {
+		errs = errors.Append(errs, err)
+	}
+	if m.ExecutionDelay.Duration() > maxExecutionDelay {
+		errs = errors.Append(errs, errors.Wrapf(errors.ErrInput, "execution delay max %s", maxExecutionDelay))
+	}
 	return errs
 }
 
@@ -174,3 +219,47 @@ func (m UpdateElectorateMsg) Validate() error {
 	}
 	return ElectorsDiff(m.DiffElectors).Validate()
 }
+
+var _ weave.Msg = (*DelegateMsg)(nil)
+
+func (DelegateMsg) Path() string {
+	return "gov/delegate"
+}
+
+func (m DelegateMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "invalid metadata")
+	}
+	if len(m.ElectorateID) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "electorate id")
+	}
+	if err := m.Delegator.Validate(); m.Delegator != nil && err != nil {
+		return errors.Wrap(err, "invalid delegator")
+	}
+	if err := m.Delegatee.Validate(); err != nil {
+		return errors.Wrap(err, "invalid delegatee")
+	}
+	if m.Delegatee.Equals(m.Delegator) {
+		return errors.Wrap(errors.ErrInput, "must not delegate to self")
+	}
+	return nil
+}
+
+var _ weave.Msg = (*RevokeDelegationMsg)(nil)
+
+func (RevokeDelegationMsg) Path() string {
+	return "gov/revoke_delegation"
+}
+
+func (m RevokeDelegationMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "invalid metadata")
+	}
+	if len(m.ElectorateID) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "electorate id")
+	}
+	if err := m.Delegator.Validate(); m.Delegator != nil && err != nil {
+		return errors.Wrap(err, "invalid delegator")
+	}
+	return nil
+}
@@ -81,6 +81,48 @@ func TestTallyMsg(t *testing.T) {
 	}
 }
 
+func TestVetoMsg(t *testing.T) {
+	specs := map[string]struct {
+		Msg VetoMsg
+		Exp *errors.Error
+	}{
+		"Happy path": {
+			Msg: VetoMsg{ProposalID: weavetest.SequenceID(1), Metadata: &weave.Metadata{Schema: 1}},
+		},
+		"With elector": {
+			Msg: VetoMsg{
+				ProposalID: weavetest.SequenceID(1),
+				Elector:    weavetest.NewCondition().Address(),
+				Metadata:   &weave.Metadata{Schema: 1},
+			},
+		},
+		"Invalid elector": {
+			Msg: VetoMsg{
+				ProposalID: weavetest.SequenceID(1),
+				Elector:    weave.Address("invalid"),
+				Metadata:   &weave.Metadata{Schema: 1},
+			},
+			Exp: errors.ErrInput,
+		},
+		"ID missing": {
+			Msg: VetoMsg{Metadata: &weave.Metadata{Schema: 1}},
+			Exp: errors.ErrInput,
+		},
+		"Metadata missing": {
+			Msg: VetoMsg{ProposalID: weavetest.SequenceID(1)},
+			Exp: errors.ErrMetadata,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.Msg.Validate()
+			if !spec.Exp.Is(err) {
+				t.Fatalf("check expected: %v  but got %+v", spec.Exp, err)
+			}
+		})
+	}
+}
+
 func TestCreateProposalMsg(t *testing.T) {
 	alice := weavetest.NewCondition().Address()
 
@@ -255,3 +297,54 @@ func BigString(n int) string {
 	}
 	return r
 }
+
+func TestDelegateMsg(t *testing.T) {
+	alice := weavetest.NewCondition().Address()
+	bobby := weavetest.NewCondition().Address()
+
+	specs := map[string]struct {
+		Msg    weave.Msg
+		ExpErr *errors.Error
+	}{
+		"Happy path": {
+			Msg: &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(1), Delegator: alice, Delegatee: bobby},
+		},
+		"Delegator is optional": {
+			Msg: &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(1), Delegatee: bobby},
+		},
+		"Delegatee missing": {
+			Msg:    &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(1), Delegator: alice},
+			ExpErr: errors.ErrEmpty,
+		},
+		"Delegation to self": {
+			Msg:    &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(1), Delegator: alice, Delegatee: alice},
+			ExpErr: errors.ErrInput,
+		},
+		"Electorate id missing": {
+			Msg:    &DelegateMsg{Metadata: &weave.Metadata{Schema: 1}, Delegatee: bobby},
+			ExpErr: errors.ErrEmpty,
+		},
+		"Metadata missing": {
+			Msg:    &DelegateMsg{ElectorateID: weavetest.SequenceID(1), Delegatee: bobby},
+			ExpErr: errors.ErrMetadata,
+		},
+		"Revoke happy path": {
+			Msg: &RevokeDelegationMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(1), Delegator: alice},
+		},
+		"Revoke without delegator": {
+			Msg: &RevokeDelegationMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(1)},
+		},
+		"Revoke electorate id missing": {
+			Msg:    &RevokeDelegationMsg{Metadata: &weave.Metadata{Schema: 1}},
+			ExpErr: errors.ErrEmpty,
+		},
+	}
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			err := spec.Msg.Validate()
+			if !spec.ExpErr.Is(err) {
+				t.Fatalf("check expected: %v  but got %+v", spec.ExpErr, err)
+			}
+		})
+	}
+}
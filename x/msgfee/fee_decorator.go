@@ -66,6 +66,14 @@ func (d *FeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.
 	return res, nil
 }
 
+// FeeUnitsMsg is implemented by a message that represents multiple
+// operations, for example a transfer to many recipients. The fee configured
+// for such message is charged once for each unit.
+type FeeUnitsMsg interface {
+	weave.Msg
+	FeeUnits() int64
+}
+
 // txFee returns the fee value for a given transaction as configured in the store.
 func txFee(bucket *MsgFeeBucket, store weave.KVStore, tx weave.Tx) (*coin.Coin, error) {
 	msg, err := tx.GetMsg()
@@ -76,5 +84,12 @@ func txFee(bucket *MsgFeeBucket, store weave.KVStore, tx weave.Tx) (*coin.Coin,
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get fee")
 	}
+	if m, ok := msg.(FeeUnitsMsg); ok && !coin.IsEmpty(fee) {
+		total, err := fee.Multiply(m.FeeUnits())
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot multiply fee")
+		}
+		fee = &total
+	}
 	return fee, nil
 }
@@ -81,6 +81,19 @@ func TestFeeDecorator(t *testing.T) {
 			WantCheckErr:   errors.ErrUnauthorized,
 			WantDeliverFee: coin.NewCoin(0, 1234, "DOGE"),
 		},
+		"message fee charged for each unit": {
+			InitFees: []MsgFee{
+				{
+					Metadata: &weave.Metadata{Schema: 1},
+					MsgPath:  "foo/bar",
+					Fee:      coin.NewCoin(0, 100, "DOGE"),
+				},
+			},
+			Handler:        &weavetest.Handler{},
+			Tx:             &weavetest.Tx{Msg: &unitsMsg{Msg: weavetest.Msg{RoutePath: "foo/bar"}, units: 3}},
+			WantCheckFee:   coin.NewCoin(0, 300, "DOGE"),
+			WantDeliverFee: coin.NewCoin(0, 300, "DOGE"),
+		},
 		"no fee for the transaction message": {
 			InitFees:       []MsgFee{},
 			Handler:        &weavetest.Handler{},
@@ -139,3 +152,12 @@ func TestFeeDecorator(t *testing.T) {
 		})
 	}
 }
+
+type unitsMsg struct {
+	weavetest.Msg
+	units int64
+}
+
+func (m *unitsMsg) FeeUnits() int64 {
+	return m.units
+}
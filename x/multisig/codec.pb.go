@@ -9,6 +9,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_iov_one_weave "github.com/iov-one/weave"
 	weave "github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	io "io"
 	math "math"
 )
@@ -39,6 +40,16 @@ type Contract struct {
 	AdminThreshold Weight `protobuf:"varint,4,opt,name=admin_threshold,json=adminThreshold,proto3,casttype=Weight" json:"admin_threshold,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,5,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Spending policy is optional. When set, funds can be sent from the
+	// contract with a lower weight, as long as the amount spent within a
+	// period does not exceed the limit.
+	SpendingPolicy *SpendingPolicy `protobuf:"bytes,6,opt,name=spending_policy,json=spendingPolicy,proto3" json:"spending_policy,omitempty"`
+	// Admin timelock is the delay after which an update of the contract takes
+	// effect. Zero means that an update is applied immediately.
+	AdminTimelock github_com_iov_one_weave.UnixDuration `protobuf:"varint,7,opt,name=admin_timelock,json=adminTimelock,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"admin_timelock,omitempty"`
+	// Pending update is set when an update is waiting for the admin timelock
+	// to pass.
+	PendingUpdate *PendingUpdate `protobuf:"bytes,8,opt,name=pending_update,json=pendingUpdate,proto3" json:"pending_update,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -109,6 +120,257 @@ func (m *Contract) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Contract) GetSpendingPolicy() *SpendingPolicy {
+	if m != nil {
+		return m.SpendingPolicy
+	}
+	return nil
+}
+
+func (m *Contract) GetAdminTimelock() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.AdminTimelock
+	}
+	return 0
+}
+
+func (m *Contract) GetPendingUpdate() *PendingUpdate {
+	if m != nil {
+		return m.PendingUpdate
+	}
+	return nil
+}
+
+// SpendingPolicy defines a threshold lower than the activation threshold that
+// is enough to send funds from the contract, as long as the total amount sent
+// within a spending period does not exceed the limit.
+type SpendingPolicy struct {
+	// Threshold is the minimal weight value that must be provided from
+	// participants in order to send funds below the limit.
+	Threshold Weight `protobuf:"varint,1,opt,name=threshold,proto3,casttype=Weight" json:"threshold,omitempty"`
+	// Period is the duration of a single spending period. The spent amount is
+	// reset at the beginning of every period.
+	Period github_com_iov_one_weave.UnixDuration `protobuf:"varint,2,opt,name=period,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"period,omitempty"`
+	// Limit is the maximum amount of each currency that can be spent within a
+	// period. Currencies that are not listed cannot be sent using the policy
+	// threshold.
+	Limit []*coin.Coin `protobuf:"bytes,3,rep,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *SpendingPolicy) Reset()         { *m = SpendingPolicy{} }
+func (m *SpendingPolicy) String() string { return proto.CompactTextString(m) }
+func (*SpendingPolicy) ProtoMessage()    {}
+func (*SpendingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{1}
+}
+func (m *SpendingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendingPolicy.Merge(m, src)
+}
+func (m *SpendingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendingPolicy proto.InternalMessageInfo
+
+func (m *SpendingPolicy) GetThreshold() Weight {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *SpendingPolicy) GetPeriod() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *SpendingPolicy) GetLimit() []*coin.Coin {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+// PendingUpdate is a contract configuration that takes effect once the admin
+// timelock passes.
+type PendingUpdate struct {
+	Participants        []*Participant                        `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	ActivationThreshold Weight                                `protobuf:"varint,2,opt,name=activation_threshold,json=activationThreshold,proto3,casttype=Weight" json:"activation_threshold,omitempty"`
+	AdminThreshold      Weight                                `protobuf:"varint,3,opt,name=admin_threshold,json=adminThreshold,proto3,casttype=Weight" json:"admin_threshold,omitempty"`
+	SpendingPolicy      *SpendingPolicy                       `protobuf:"bytes,4,opt,name=spending_policy,json=spendingPolicy,proto3" json:"spending_policy,omitempty"`
+	AdminTimelock       github_com_iov_one_weave.UnixDuration `protobuf:"varint,5,opt,name=admin_timelock,json=adminTimelock,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"admin_timelock,omitempty"`
+	// Execute at is the time after which the update is applied.
+	ExecuteAt github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=execute_at,json=executeAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"execute_at,omitempty"`
+	// Task ID references the scheduled task that applies the update.
+	TaskID []byte `protobuf:"bytes,7,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
+func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingUpdate) ProtoMessage()    {}
+func (*PendingUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{2}
+}
+func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingUpdate.Merge(m, src)
+}
+func (m *PendingUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingUpdate proto.InternalMessageInfo
+
+func (m *PendingUpdate) GetParticipants() []*Participant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *PendingUpdate) GetActivationThreshold() Weight {
+	if m != nil {
+		return m.ActivationThreshold
+	}
+	return 0
+}
+
+func (m *PendingUpdate) GetAdminThreshold() Weight {
+	if m != nil {
+		return m.AdminThreshold
+	}
+	return 0
+}
+
+func (m *PendingUpdate) GetSpendingPolicy() *SpendingPolicy {
+	if m != nil {
+		return m.SpendingPolicy
+	}
+	return nil
+}
+
+func (m *PendingUpdate) GetAdminTimelock() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.AdminTimelock
+	}
+	return 0
+}
+
+func (m *PendingUpdate) GetExecuteAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ExecuteAt
+	}
+	return 0
+}
+
+func (m *PendingUpdate) GetTaskID() []byte {
+	if m != nil {
+		return m.TaskID
+	}
+	return nil
+}
+
+// Spending tracks the amount sent from a contract within the current spending
+// period. It is stored under the contract ID.
+type Spending struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Period start is the beginning of the spending period the spent amount
+	// refers to.
+	PeriodStart github_com_iov_one_weave.UnixTime `protobuf:"varint,2,opt,name=period_start,json=periodStart,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"period_start,omitempty"`
+	Spent       []*coin.Coin                      `protobuf:"bytes,3,rep,name=spent,proto3" json:"spent,omitempty"`
+}
+
+func (m *Spending) Reset()         { *m = Spending{} }
+func (m *Spending) String() string { return proto.CompactTextString(m) }
+func (*Spending) ProtoMessage()    {}
+func (*Spending) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{3}
+}
+func (m *Spending) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Spending) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Spending.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Spending) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spending.Merge(m, src)
+}
+func (m *Spending) XXX_Size() int {
+	return m.Size()
+}
+func (m *Spending) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spending.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Spending proto.InternalMessageInfo
+
+func (m *Spending) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Spending) GetPeriodStart() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return 0
+}
+
+func (m *Spending) GetSpent() []*coin.Coin {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
 // Participant clubs together a signature with a weight. The greater the weight
 // the greater the power of a signature.
 type Participant struct {
@@ -120,7 +382,7 @@ func (m *Participant) Reset()         { *m = Participant{} }
 func (m *Participant) String() string { return proto.CompactTextString(m) }
 func (*Participant) ProtoMessage()    {}
 func (*Participant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5080d98b87cf9a7, []int{1}
+	return fileDescriptor_e5080d98b87cf9a7, []int{4}
 }
 func (m *Participant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,17 +426,19 @@ func (m *Participant) GetWeight() Weight {
 }
 
 type CreateMsg struct {
-	Metadata            *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Participants        []*Participant  `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
-	ActivationThreshold Weight          `protobuf:"varint,3,opt,name=activation_threshold,json=activationThreshold,proto3,casttype=Weight" json:"activation_threshold,omitempty"`
-	AdminThreshold      Weight          `protobuf:"varint,4,opt,name=admin_threshold,json=adminThreshold,proto3,casttype=Weight" json:"admin_threshold,omitempty"`
+	Metadata            *weave.Metadata                       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Participants        []*Participant                        `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	ActivationThreshold Weight                                `protobuf:"varint,3,opt,name=activation_threshold,json=activationThreshold,proto3,casttype=Weight" json:"activation_threshold,omitempty"`
+	AdminThreshold      Weight                                `protobuf:"varint,4,opt,name=admin_threshold,json=adminThreshold,proto3,casttype=Weight" json:"admin_threshold,omitempty"`
+	SpendingPolicy      *SpendingPolicy                       `protobuf:"bytes,5,opt,name=spending_policy,json=spendingPolicy,proto3" json:"spending_policy,omitempty"`
+	AdminTimelock       github_com_iov_one_weave.UnixDuration `protobuf:"varint,6,opt,name=admin_timelock,json=adminTimelock,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"admin_timelock,omitempty"`
 }
 
 func (m *CreateMsg) Reset()         { *m = CreateMsg{} }
 func (m *CreateMsg) String() string { return proto.CompactTextString(m) }
 func (*CreateMsg) ProtoMessage()    {}
 func (*CreateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5080d98b87cf9a7, []int{2}
+	return fileDescriptor_e5080d98b87cf9a7, []int{5}
 }
 func (m *CreateMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,19 +495,35 @@ func (m *CreateMsg) GetAdminThreshold() Weight {
 	return 0
 }
 
+func (m *CreateMsg) GetSpendingPolicy() *SpendingPolicy {
+	if m != nil {
+		return m.SpendingPolicy
+	}
+	return nil
+}
+
+func (m *CreateMsg) GetAdminTimelock() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.AdminTimelock
+	}
+	return 0
+}
+
 type UpdateMsg struct {
-	Metadata            *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ContractID          []byte          `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	Participants        []*Participant  `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
-	ActivationThreshold Weight          `protobuf:"varint,4,opt,name=activation_threshold,json=activationThreshold,proto3,casttype=Weight" json:"activation_threshold,omitempty"`
-	AdminThreshold      Weight          `protobuf:"varint,5,opt,name=admin_threshold,json=adminThreshold,proto3,casttype=Weight" json:"admin_threshold,omitempty"`
+	Metadata            *weave.Metadata                       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ContractID          []byte                                `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Participants        []*Participant                        `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+	ActivationThreshold Weight                                `protobuf:"varint,4,opt,name=activation_threshold,json=activationThreshold,proto3,casttype=Weight" json:"activation_threshold,omitempty"`
+	AdminThreshold      Weight                                `protobuf:"varint,5,opt,name=admin_threshold,json=adminThreshold,proto3,casttype=Weight" json:"admin_threshold,omitempty"`
+	SpendingPolicy      *SpendingPolicy                       `protobuf:"bytes,6,opt,name=spending_policy,json=spendingPolicy,proto3" json:"spending_policy,omitempty"`
+	AdminTimelock       github_com_iov_one_weave.UnixDuration `protobuf:"varint,7,opt,name=admin_timelock,json=adminTimelock,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"admin_timelock,omitempty"`
 }
 
 func (m *UpdateMsg) Reset()         { *m = UpdateMsg{} }
 func (m *UpdateMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdateMsg) ProtoMessage()    {}
 func (*UpdateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5080d98b87cf9a7, []int{3}
+	return fileDescriptor_e5080d98b87cf9a7, []int{6}
 }
 func (m *UpdateMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,43 +587,133 @@ func (m *UpdateMsg) GetAdminThreshold() Weight {
 	return 0
 }
 
+func (m *UpdateMsg) GetSpendingPolicy() *SpendingPolicy {
+	if m != nil {
+		return m.SpendingPolicy
+	}
+	return nil
+}
+
+func (m *UpdateMsg) GetAdminTimelock() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.AdminTimelock
+	}
+	return 0
+}
+
+// ExecuteUpdateMsg applies the pending update of a contract once the admin
+// timelock has passed. It is executed by the cron only.
+type ExecuteUpdateMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ContractID []byte          `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (m *ExecuteUpdateMsg) Reset()         { *m = ExecuteUpdateMsg{} }
+func (m *ExecuteUpdateMsg) String() string { return proto.CompactTextString(m) }
+func (*ExecuteUpdateMsg) ProtoMessage()    {}
+func (*ExecuteUpdateMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{7}
+}
+func (m *ExecuteUpdateMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteUpdateMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteUpdateMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecuteUpdateMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteUpdateMsg.Merge(m, src)
+}
+func (m *ExecuteUpdateMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteUpdateMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteUpdateMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteUpdateMsg proto.InternalMessageInfo
+
+func (m *ExecuteUpdateMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ExecuteUpdateMsg) GetContractID() []byte {
+	if m != nil {
+		return m.ContractID
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Contract)(nil), "multisig.Contract")
+	proto.RegisterType((*SpendingPolicy)(nil), "multisig.SpendingPolicy")
+	proto.RegisterType((*PendingUpdate)(nil), "multisig.PendingUpdate")
+	proto.RegisterType((*Spending)(nil), "multisig.Spending")
 	proto.RegisterType((*Participant)(nil), "multisig.Participant")
 	proto.RegisterType((*CreateMsg)(nil), "multisig.CreateMsg")
 	proto.RegisterType((*UpdateMsg)(nil), "multisig.UpdateMsg")
+	proto.RegisterType((*ExecuteUpdateMsg)(nil), "multisig.ExecuteUpdateMsg")
 }
 
 func init() { proto.RegisterFile("x/multisig/codec.proto", fileDescriptor_e5080d98b87cf9a7) }
 
 var fileDescriptor_e5080d98b87cf9a7 = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x93, 0xcf, 0x8e, 0x9a, 0x50,
-	0x14, 0xc6, 0xbd, 0xf8, 0xa7, 0x7a, 0xb0, 0x9a, 0x50, 0xdb, 0x10, 0x17, 0x48, 0x48, 0x17, 0x24,
-	0x4d, 0x21, 0xd1, 0x55, 0x17, 0x6d, 0x52, 0xec, 0xc6, 0x85, 0x49, 0x43, 0xda, 0x74, 0x69, 0xae,
-	0xdc, 0x1b, 0xb8, 0x89, 0x70, 0x09, 0x5c, 0xb4, 0x8f, 0xd1, 0x65, 0x9f, 0xa0, 0xcf, 0xd2, 0xa5,
-	0xcb, 0xae, 0x4c, 0x83, 0x8b, 0xbe, 0x83, 0xab, 0x66, 0x50, 0x06, 0x27, 0x2e, 0x66, 0xe2, 0xec,
-	0x66, 0x77, 0xf2, 0x9d, 0xdf, 0x07, 0xe7, 0x7c, 0x1c, 0xe0, 0xd5, 0x77, 0x3b, 0xcc, 0x56, 0x82,
-	0xa5, 0xcc, 0xb7, 0x3d, 0x4e, 0xa8, 0x67, 0xc5, 0x09, 0x17, 0x5c, 0x69, 0x97, 0xea, 0x50, 0x3e,
-	0x93, 0x87, 0x03, 0x9f, 0xfb, 0xbc, 0x28, 0xed, 0x9b, 0xea, 0xa8, 0x1a, 0xbf, 0x24, 0x68, 0x4f,
-	0x79, 0x24, 0x12, 0xec, 0x09, 0xe5, 0x0d, 0xb4, 0x43, 0x2a, 0x30, 0xc1, 0x02, 0xab, 0x48, 0x47,
-	0xa6, 0x3c, 0xee, 0x5b, 0x1b, 0x8a, 0xd7, 0xd4, 0x9a, 0x9f, 0x64, 0xf7, 0x16, 0x50, 0xde, 0x41,
-	0x37, 0xc6, 0x89, 0x60, 0x1e, 0x8b, 0x71, 0x24, 0x52, 0x55, 0xd2, 0xeb, 0xa6, 0x3c, 0x7e, 0x69,
-	0x95, 0x6f, 0xb7, 0x3e, 0x57, 0x5d, 0xf7, 0x0e, 0xaa, 0xbc, 0x87, 0x01, 0xf6, 0x04, 0x5b, 0x63,
-	0xc1, 0x78, 0xb4, 0x10, 0x41, 0x42, 0xd3, 0x80, 0xaf, 0x88, 0x5a, 0xd7, 0x91, 0xf9, 0xdc, 0x81,
-	0xc3, 0x6e, 0xd4, 0xfa, 0x46, 0x99, 0x1f, 0x08, 0xf7, 0x45, 0xc5, 0x7d, 0x29, 0x31, 0x65, 0x02,
-	0x7d, 0x4c, 0x42, 0x76, 0xee, 0x6c, 0x5c, 0x38, 0x7b, 0x05, 0x52, 0x99, 0x3e, 0xc0, 0x33, 0x4c,
-	0x48, 0x42, 0xd3, 0x54, 0x6d, 0xea, 0xc8, 0xec, 0x3a, 0xaf, 0x0f, 0xbb, 0x91, 0xee, 0x33, 0x11,
-	0x64, 0x4b, 0xcb, 0xe3, 0xa1, 0xcd, 0xf8, 0xfa, 0x2d, 0x8f, 0xa8, 0x7d, 0x5c, 0xf8, 0xe3, 0x91,
-	0x75, 0x4b, 0x93, 0x91, 0x81, 0x7c, 0xb6, 0x90, 0xe2, 0x40, 0x27, 0x65, 0x7e, 0x84, 0x45, 0x96,
-	0xd0, 0x22, 0xab, 0x87, 0x3e, 0xb0, 0xb2, 0x29, 0x06, 0xb4, 0x36, 0xc5, 0xb0, 0xaa, 0x74, 0x31,
-	0xfe, 0xa9, 0x63, 0xfc, 0x43, 0xd0, 0x99, 0x26, 0x14, 0x0b, 0x3a, 0x4f, 0xfd, 0xa7, 0xfc, 0x81,
-	0x8c, 0x9f, 0x12, 0x74, 0xbe, 0xc6, 0xe4, 0x9a, 0x4d, 0x6d, 0x90, 0xbd, 0xd3, 0x0d, 0x2f, 0x18,
-	0x29, 0xd2, 0xec, 0x3a, 0xbd, 0x7c, 0x37, 0x82, 0xf2, 0xb4, 0x67, 0x9f, 0x5c, 0x28, 0x91, 0x19,
-	0xb9, 0x88, 0xa6, 0xfe, 0xf8, 0x68, 0x1a, 0x57, 0x47, 0xd3, 0xbc, 0x2f, 0x1a, 0x47, 0xfd, 0x9d,
-	0x6b, 0x68, 0x9b, 0x6b, 0xe8, 0x6f, 0xae, 0xa1, 0x1f, 0x7b, 0xad, 0xb6, 0xdd, 0x6b, 0xb5, 0x3f,
-	0x7b, 0xad, 0xb6, 0x6c, 0x15, 0x7f, 0xf1, 0xe4, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xdc,
-	0x6e, 0x9f, 0x0c, 0x04, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x96, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0xeb, 0xb8, 0x71, 0x93, 0x27, 0x2f, 0xad, 0x8e, 0x02, 0xa7, 0x0e, 0x49, 0x30, 0x54,
+	0x0a, 0x42, 0x38, 0x52, 0x3b, 0x31, 0x50, 0x29, 0x69, 0x90, 0xc8, 0x50, 0xa9, 0x72, 0x5b, 0x31,
+	0x46, 0x57, 0xfb, 0xe4, 0x9c, 0x9a, 0xf8, 0x2c, 0xfb, 0xd2, 0x96, 0x6f, 0xc1, 0x37, 0x60, 0x60,
+	0xe6, 0x2b, 0x30, 0x33, 0x76, 0x64, 0x8a, 0x50, 0xfa, 0x2d, 0x02, 0x03, 0xf2, 0xd9, 0xc6, 0x89,
+	0x12, 0x40, 0x69, 0x24, 0x90, 0xd8, 0xec, 0x7b, 0xfe, 0xff, 0xe8, 0x79, 0xf9, 0xf9, 0xb9, 0xc0,
+	0x83, 0xeb, 0xc6, 0x60, 0xd8, 0x17, 0x2c, 0x60, 0x4e, 0xc3, 0xe2, 0x36, 0xb5, 0x0c, 0xcf, 0xe7,
+	0x82, 0xa3, 0x5c, 0x72, 0xba, 0x53, 0x98, 0x3a, 0xde, 0xd9, 0xb2, 0x38, 0x73, 0xa7, 0x85, 0x3b,
+	0xdb, 0x0e, 0x77, 0xb8, 0x7c, 0x6c, 0x84, 0x4f, 0xd1, 0xa9, 0xfe, 0x4d, 0x85, 0xdc, 0x21, 0x77,
+	0x85, 0x4f, 0x2c, 0x81, 0x9e, 0x41, 0x6e, 0x40, 0x05, 0xb1, 0x89, 0x20, 0x58, 0xa9, 0x29, 0xf5,
+	0xc2, 0xde, 0xa6, 0x71, 0x45, 0xc9, 0x25, 0x35, 0x8e, 0xe2, 0x63, 0xf3, 0xa7, 0x00, 0xbd, 0x80,
+	0xa2, 0x47, 0x7c, 0xc1, 0x2c, 0xe6, 0x11, 0x57, 0x04, 0x38, 0x53, 0x53, 0xeb, 0x85, 0xbd, 0xfb,
+	0x46, 0x92, 0x8f, 0x71, 0x9c, 0x46, 0xcd, 0x19, 0x29, 0x7a, 0x09, 0xdb, 0xc4, 0x12, 0xec, 0x92,
+	0x08, 0xc6, 0xdd, 0xae, 0xe8, 0xf9, 0x34, 0xe8, 0xf1, 0xbe, 0x8d, 0xd5, 0x9a, 0x52, 0x2f, 0xb5,
+	0x60, 0x32, 0xaa, 0x6a, 0x6f, 0x28, 0x73, 0x7a, 0xc2, 0xbc, 0x97, 0xea, 0x4e, 0x13, 0x19, 0xda,
+	0x87, 0x4d, 0x62, 0x0f, 0xd8, 0xb4, 0x73, 0x7d, 0xce, 0x59, 0x96, 0x92, 0xd4, 0x74, 0x00, 0x1b,
+	0xc4, 0xb6, 0x7d, 0x1a, 0x04, 0x38, 0x5b, 0x53, 0xea, 0xc5, 0xd6, 0x93, 0xc9, 0xa8, 0x5a, 0x73,
+	0x98, 0xe8, 0x0d, 0xcf, 0x0d, 0x8b, 0x0f, 0x1a, 0x8c, 0x5f, 0x3e, 0xe7, 0x2e, 0x6d, 0x44, 0x05,
+	0x37, 0x23, 0xad, 0x99, 0x98, 0x50, 0x13, 0x36, 0x03, 0x8f, 0xba, 0x36, 0x73, 0x9d, 0xae, 0xc7,
+	0xfb, 0xcc, 0x7a, 0x8b, 0x35, 0xd9, 0x22, 0x9c, 0x56, 0x7c, 0x12, 0x0b, 0x8e, 0x65, 0xdc, 0x2c,
+	0x07, 0x33, 0xef, 0xe8, 0x18, 0xca, 0x71, 0xde, 0x6c, 0x40, 0xfb, 0xdc, 0xba, 0xc0, 0x1b, 0x32,
+	0xed, 0xa7, 0x93, 0x51, 0x75, 0xf7, 0x97, 0x99, 0x9c, 0xb9, 0xec, 0xba, 0x3d, 0xf4, 0x65, 0x0f,
+	0xcc, 0x52, 0x54, 0x55, 0xec, 0x47, 0x07, 0x50, 0x4e, 0x72, 0x1a, 0x7a, 0x36, 0x11, 0x14, 0xe7,
+	0x64, 0x4e, 0x0f, 0xa7, 0xa6, 0x10, 0xc5, 0xcf, 0x64, 0xd8, 0x2c, 0x79, 0xd3, 0xaf, 0xfa, 0x7b,
+	0x05, 0xca, 0xb3, 0x49, 0xa3, 0x3a, 0xe4, 0xd3, 0xb6, 0x2a, 0x73, 0x6d, 0x4d, 0x83, 0xa8, 0x09,
+	0x9a, 0x47, 0x7d, 0xc6, 0x6d, 0x9c, 0x59, 0xb6, 0x8c, 0xd8, 0x88, 0x6a, 0x90, 0xed, 0xb3, 0x01,
+	0x13, 0x58, 0x95, 0xf0, 0x80, 0x11, 0x52, 0x6b, 0x1c, 0x72, 0xe6, 0x9a, 0x51, 0x40, 0xff, 0xa4,
+	0x42, 0x69, 0xa6, 0x84, 0x39, 0xee, 0x94, 0xd5, 0xb9, 0xcb, 0xdc, 0x99, 0x3b, 0xf5, 0x8f, 0xdc,
+	0x2d, 0xe0, 0x66, 0x7d, 0x65, 0x6e, 0xb2, 0x2b, 0x72, 0xd3, 0x06, 0xa0, 0xd7, 0xd4, 0x1a, 0x0a,
+	0xda, 0x25, 0x42, 0x72, 0xac, 0xb6, 0x76, 0x27, 0xa3, 0xea, 0xa3, 0xdf, 0xfe, 0x5a, 0x68, 0x37,
+	0xf3, 0xb1, 0xb1, 0x29, 0xd0, 0x63, 0xd8, 0x10, 0x24, 0xb8, 0xe8, 0x32, 0x5b, 0x82, 0x5c, 0x6c,
+	0xc1, 0x78, 0x54, 0xd5, 0x4e, 0x49, 0x70, 0xd1, 0x69, 0x9b, 0x5a, 0x18, 0xea, 0xd8, 0xfa, 0x07,
+	0x05, 0x72, 0x49, 0x7d, 0xcb, 0x2d, 0x98, 0xd7, 0x50, 0x8c, 0x30, 0xe9, 0x06, 0x82, 0xf8, 0x02,
+	0x67, 0x96, 0x49, 0xb3, 0x10, 0x59, 0x4f, 0x42, 0x67, 0x88, 0x59, 0xd8, 0xd2, 0x85, 0x98, 0xc9,
+	0x80, 0x3e, 0x84, 0xc2, 0x14, 0x36, 0xa8, 0x05, 0xf9, 0x80, 0x39, 0x2e, 0x11, 0x43, 0x9f, 0x62,
+	0x65, 0x89, 0x75, 0x91, 0xda, 0x90, 0x0e, 0xda, 0x95, 0x44, 0x62, 0x01, 0x5e, 0x71, 0x44, 0xff,
+	0x9e, 0x81, 0xfc, 0xa1, 0x4f, 0x89, 0xa0, 0x47, 0x81, 0xf3, 0x5f, 0xaf, 0xdf, 0x05, 0x9f, 0x41,
+	0x76, 0xe5, 0xcf, 0x40, 0x5b, 0xed, 0x33, 0xd0, 0x3f, 0xaa, 0x90, 0x8f, 0xb6, 0xca, 0xd2, 0xed,
+	0x6f, 0x40, 0xc1, 0x8a, 0xaf, 0xcd, 0x90, 0xff, 0x8c, 0x64, 0xa4, 0x3c, 0x1e, 0x55, 0x21, 0xb9,
+	0x4d, 0x3b, 0x6d, 0x13, 0x12, 0x49, 0xc7, 0x9e, 0x9b, 0x97, 0xba, 0xfa, 0xbc, 0xd6, 0xef, 0x3c,
+	0xaf, 0xec, 0x5d, 0xe6, 0xf5, 0xcf, 0xaf, 0x3b, 0xdd, 0x83, 0xad, 0x57, 0xd1, 0xf6, 0xf9, 0x4b,
+	0x53, 0x6b, 0xe1, 0xcf, 0xe3, 0x8a, 0x72, 0x33, 0xae, 0x28, 0x5f, 0xc7, 0x15, 0xe5, 0xdd, 0x6d,
+	0x65, 0xed, 0xe6, 0xb6, 0xb2, 0xf6, 0xe5, 0xb6, 0xb2, 0x76, 0xae, 0xc9, 0xff, 0x4f, 0xfb, 0x3f,
+	0x06, 0x00, 0xfb, 0xdf, 0x64, 0x0e, 0x98, 0x09, 0x00, 0x00,
 }
 
 func (m *Contract) Marshal() (dAtA []byte, err error) {
@@ -399,10 +769,35 @@ func (m *Contract) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
 		i += copy(dAtA[i:], m.Address)
 	}
+	if m.SpendingPolicy != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SpendingPolicy.Size()))
+		n2, err := m.SpendingPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.AdminTimelock != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AdminTimelock))
+	}
+	if m.PendingUpdate != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PendingUpdate.Size()))
+		n3, err := m.PendingUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
 	return i, nil
 }
 
-func (m *Participant) Marshal() (dAtA []byte, err error) {
+func (m *SpendingPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -412,26 +807,37 @@ func (m *Participant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Participant) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpendingPolicy) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		dAtA[i] = 0xa
+	if m.Threshold != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Signature)))
-		i += copy(dAtA[i:], m.Signature)
+		i = encodeVarintCodec(dAtA, i, uint64(m.Threshold))
 	}
-	if m.Weight != 0 {
+	if m.Period != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Weight))
+		i = encodeVarintCodec(dAtA, i, uint64(m.Period))
+	}
+	if len(m.Limit) > 0 {
+		for _, msg := range m.Limit {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
 
-func (m *CreateMsg) Marshal() (dAtA []byte, err error) {
+func (m *PendingUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -441,24 +847,14 @@ func (m *CreateMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n2, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
-	}
 	if len(m.Participants) > 0 {
 		for _, msg := range m.Participants {
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 			i++
 			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -469,16 +865,181 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if m.ActivationThreshold != 0 {
-		dAtA[i] = 0x18
+		dAtA[i] = 0x10
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ActivationThreshold))
 	}
 	if m.AdminThreshold != 0 {
-		dAtA[i] = 0x20
+		dAtA[i] = 0x18
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AdminThreshold))
 	}
-	return i, nil
+	if m.SpendingPolicy != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SpendingPolicy.Size()))
+		n4, err := m.SpendingPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.AdminTimelock != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AdminTimelock))
+	}
+	if m.ExecuteAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteAt))
+	}
+	if len(m.TaskID) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TaskID)))
+		i += copy(dAtA[i:], m.TaskID)
+	}
+	return i, nil
+}
+
+func (m *Spending) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Spending) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.PeriodStart != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PeriodStart))
+	}
+	if len(m.Spent) > 0 {
+		for _, msg := range m.Spent {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Participant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Participant) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
+	}
+	if m.Weight != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Weight))
+	}
+	return i, nil
+}
+
+func (m *CreateMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.Participants) > 0 {
+		for _, msg := range m.Participants {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ActivationThreshold != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ActivationThreshold))
+	}
+	if m.AdminThreshold != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AdminThreshold))
+	}
+	if m.SpendingPolicy != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SpendingPolicy.Size()))
+		n7, err := m.SpendingPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.AdminTimelock != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AdminTimelock))
+	}
+	return i, nil
 }
 
 func (m *UpdateMsg) Marshal() (dAtA []byte, err error) {
@@ -500,11 +1061,11 @@ func (m *UpdateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+		n8, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n8
 	}
 	if len(m.ContractID) > 0 {
 		dAtA[i] = 0x12
@@ -534,6 +1095,55 @@ func (m *UpdateMsg) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AdminThreshold))
 	}
+	if m.SpendingPolicy != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SpendingPolicy.Size()))
+		n9, err := m.SpendingPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.AdminTimelock != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AdminTimelock))
+	}
+	return i, nil
+}
+
+func (m *ExecuteUpdateMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n10, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.ContractID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ContractID)))
+		i += copy(dAtA[i:], m.ContractID)
+	}
 	return i, nil
 }
 
@@ -572,6 +1182,95 @@ func (m *Contract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.SpendingPolicy != nil {
+		l = m.SpendingPolicy.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.AdminTimelock != 0 {
+		n += 1 + sovCodec(uint64(m.AdminTimelock))
+	}
+	if m.PendingUpdate != nil {
+		l = m.PendingUpdate.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *SpendingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovCodec(uint64(m.Threshold))
+	}
+	if m.Period != 0 {
+		n += 1 + sovCodec(uint64(m.Period))
+	}
+	if len(m.Limit) > 0 {
+		for _, e := range m.Limit {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ActivationThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.ActivationThreshold))
+	}
+	if m.AdminThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.AdminThreshold))
+	}
+	if m.SpendingPolicy != nil {
+		l = m.SpendingPolicy.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.AdminTimelock != 0 {
+		n += 1 + sovCodec(uint64(m.AdminTimelock))
+	}
+	if m.ExecuteAt != 0 {
+		n += 1 + sovCodec(uint64(m.ExecuteAt))
+	}
+	l = len(m.TaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Spending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.PeriodStart != 0 {
+		n += 1 + sovCodec(uint64(m.PeriodStart))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -613,6 +1312,13 @@ func (m *CreateMsg) Size() (n int) {
 	if m.AdminThreshold != 0 {
 		n += 1 + sovCodec(uint64(m.AdminThreshold))
 	}
+	if m.SpendingPolicy != nil {
+		l = m.SpendingPolicy.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.AdminTimelock != 0 {
+		n += 1 + sovCodec(uint64(m.AdminTimelock))
+	}
 	return n
 }
 
@@ -642,22 +1348,46 @@ func (m *UpdateMsg) Size() (n int) {
 	if m.AdminThreshold != 0 {
 		n += 1 + sovCodec(uint64(m.AdminThreshold))
 	}
+	if m.SpendingPolicy != nil {
+		l = m.SpendingPolicy.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.AdminTimelock != 0 {
+		n += 1 + sovCodec(uint64(m.AdminTimelock))
+	}
 	return n
 }
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *ExecuteUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ContractID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *Contract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -761,7 +1491,581 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThreshold", wireType)
 			}
-			m.ActivationThreshold = 0
+			m.ActivationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationThreshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+			}
+			m.AdminThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminThreshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendingPolicy == nil {
+				m.SpendingPolicy = &SpendingPolicy{}
+			}
+			if err := m.SpendingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminTimelock", wireType)
+			}
+			m.AdminTimelock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminTimelock |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingUpdate == nil {
+				m.PendingUpdate = &PendingUpdate{}
+			}
+			if err := m.PendingUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendingPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendingPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limit = append(m.Limit, &coin.Coin{})
+			if err := m.Limit[len(m.Limit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &Participant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThreshold", wireType)
+			}
+			m.ActivationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationThreshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+			}
+			m.AdminThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminThreshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendingPolicy == nil {
+				m.SpendingPolicy = &SpendingPolicy{}
+			}
+			if err := m.SpendingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminTimelock", wireType)
+			}
+			m.AdminTimelock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminTimelock |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAt", wireType)
+			}
+			m.ExecuteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskID = append(m.TaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskID == nil {
+				m.TaskID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Spending) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Spending: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Spending: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -771,16 +2075,33 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActivationThreshold |= Weight(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
 			}
-			m.AdminThreshold = 0
+			m.PeriodStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -790,16 +2111,16 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AdminThreshold |= Weight(b&0x7F) << shift
+				m.PeriodStart |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -809,24 +2130,24 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.Spent = append(m.Spent, &coin.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1096,6 +2417,61 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendingPolicy == nil {
+				m.SpendingPolicy = &SpendingPolicy{}
+			}
+			if err := m.SpendingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminTimelock", wireType)
+			}
+			m.AdminTimelock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminTimelock |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1291,6 +2667,184 @@ func (m *UpdateMsg) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendingPolicy == nil {
+				m.SpendingPolicy = &SpendingPolicy{}
+			}
+			if err := m.SpendingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminTimelock", wireType)
+			}
+			m.AdminTimelock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminTimelock |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteUpdateMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteUpdateMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteUpdateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractID = append(m.ContractID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContractID == nil {
+				m.ContractID = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
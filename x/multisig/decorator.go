@@ -2,9 +2,11 @@ package multisig
 
 import (
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
 )
 
 const (
@@ -13,20 +15,21 @@ const (
 
 // Decorator checks multisig contract if available
 type Decorator struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
+	auth     x.Authenticator
+	bucket   orm.ModelBucket
+	spending orm.ModelBucket
 }
 
 var _ weave.Decorator = Decorator{}
 
 // NewDecorator returns a default multisig decorator
 func NewDecorator(auth x.Authenticator) Decorator {
-	return Decorator{auth, NewContractBucket()}
+	return Decorator{auth, NewContractBucket(), NewSpendingBucket()}
 }
 
 // Check enforce multisig contract before calling down the stack
 func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
-	newCtx, cost, err := d.authMultisig(ctx, store, tx)
+	newCtx, cost, spendings, err := d.authMultisig(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -35,27 +38,50 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 	if err != nil {
 		return nil, err
 	}
+	if err := d.saveSpendings(store, spendings); err != nil {
+		return nil, err
+	}
 	res.GasPayment += cost
 	return res, nil
 }
 
 // Deliver enforces multisig contract before calling down the stack
 func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
-	newCtx, _, err := d.authMultisig(ctx, store, tx)
+	newCtx, _, spendings, err := d.authMultisig(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	return next.Deliver(newCtx, store, tx)
+	res, err := next.Deliver(newCtx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+	// Spending is recorded only when the funds were sent. This decorator
+	// is placed above the fee decorator savepoint, so anything written
+	// before a failed delivery would remain in the store.
+	if err := d.saveSpendings(store, spendings); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
-func (d Decorator) authMultisig(ctx weave.Context, store weave.KVStore, tx weave.Tx) (weave.Context, int64, error) {
+// contractSpending is the spending of a contract that is to be saved once the
+// transaction is processed.
+type contractSpending struct {
+	contractID []byte
+	spending   *Spending
+}
+
+func (d Decorator) authMultisig(ctx weave.Context, store weave.KVStore, tx weave.Tx) (weave.Context, int64, []contractSpending, error) {
 	multisigContract, ok := tx.(MultiSigTx)
 	if !ok {
-		return ctx, 0, nil
+		return ctx, 0, nil, nil
 	}
 
-	var gasCost int64
+	var (
+		gasCost   int64
+		spendings []contractSpending
+	)
 	ids := multisigContract.GetMultisig()
 	for _, contractID := range ids {
 		if contractID == nil {
@@ -69,7 +95,7 @@ func (d Decorator) authMultisig(ctx weave.Context, store weave.KVStore, tx weave
 
 		var contract Contract
 		if err := d.bucket.One(store, contractID, &contract); err != nil {
-			return ctx, 0, errors.Wrap(err, "cannot load contract from the store")
+			return ctx, 0, nil, errors.Wrap(err, "cannot load contract from the store")
 		}
 
 		var weight Weight
@@ -79,14 +105,141 @@ func (d Decorator) authMultisig(ctx weave.Context, store weave.KVStore, tx weave
 				gasCost += multisigParticipantGasCost
 			}
 		}
-		if weight < contract.ActivationThreshold {
+		var limited bool
+		switch policy := contract.SpendingPolicy; {
+		case weight >= contract.ActivationThreshold:
+			// Full authorization.
+		case policy != nil && weight >= policy.Threshold:
+			limited = true
+		default:
 			err := errors.Wrapf(errors.ErrUnauthorized,
 				"%d weight is not enough to activate %q", weight, contractID)
-			return ctx, 0, err
+			return ctx, 0, nil, err
+		}
+		spending, err := d.trackSpending(ctx, store, tx, contractID, &contract, limited)
+		if err != nil {
+			return ctx, 0, nil, err
+		}
+		if spending != nil {
+			spendings = append(spendings, contractSpending{contractID: contractID, spending: spending})
 		}
 
 		ctx = withMultisig(ctx, contractID)
 	}
 
-	return ctx, gasCost, nil
+	return ctx, gasCost, spendings, nil
+}
+
+// saveSpendings stores spendings of all contracts.
+func (d Decorator) saveSpendings(db weave.KVStore, spendings []contractSpending) error {
+	for _, s := range spendings {
+		if _, err := d.spending.Put(db, s.contractID, s.spending); err != nil {
+			return errors.Wrap(err, "cannot save spending")
+		}
+	}
+	return nil
+}
+
+// trackSpending returns the spending of the contract within the current
+// spending period, including funds sent and the fee paid by the transaction.
+// It returns nil if the transaction does not move funds from the contract.
+// When limited is true, the transaction is authorized with the spending policy
+// threshold only and it must send funds from the contract without exceeding
+// the spending limit.
+//
+// Funds sent using the activation threshold are counted as well, but they
+// are never rejected.
+//
+// Returned spending must be saved only after the transaction was processed
+// successfully.
+func (d Decorator) trackSpending(
+	ctx weave.Context,
+	db weave.KVStore,
+	tx weave.Tx,
+	contractID []byte,
+	contract *Contract,
+	limited bool,
+) (*Spending, error) {
+	policy := contract.SpendingPolicy
+	if policy == nil {
+		return nil, nil
+	}
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get message")
+	}
+	var amounts coin.Coins
+	spend, ok := msg.(SpendMsg)
+	if !ok || !contract.Address.Equals(spend.GetSource()) {
+		if limited {
+			return nil, errors.Wrapf(errors.ErrUnauthorized,
+				"weight is enough only to send funds from %q", contractID)
+		}
+	} else {
+		amount := spend.GetAmount()
+		if amount == nil || !amount.IsPositive() {
+			return nil, errors.Wrap(errors.ErrAmount, "amount must be positive")
+		}
+		amounts = coin.Coins{amount}
+	}
+	// A fee paid by the contract is spent as well. Without counting it,
+	// the spending threshold would allow to pay any fee.
+	if fee := d.contractFee(ctx, tx, contract); fee != nil {
+		if amounts, err = amounts.Clone().Add(*fee); err != nil {
+			return nil, errors.Wrap(err, "cannot add fee")
+		}
+	}
+	if len(amounts) == 0 {
+		return nil, nil
+	}
+
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+	periodStart := weave.UnixTime(now.Unix() - now.Unix()%int64(policy.Period))
+
+	var spending Spending
+	switch err := d.spending.One(db, contractID, &spending); {
+	case err == nil:
+		if spending.PeriodStart != periodStart {
+			spending.Spent = nil
+		}
+	case errors.ErrNotFound.Is(err):
+		spending.Metadata = &weave.Metadata{Schema: 1}
+	default:
+		return nil, errors.Wrap(err, "cannot load spending")
+	}
+	spending.PeriodStart = periodStart
+
+	spent, err := coin.Coins(spending.Spent).Clone().Combine(amounts)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot add spent amount")
+	}
+	if limited {
+		// Only currencies moved by this transaction are checked.
+		for _, c := range spent {
+			if amounts.Contains(coin.Coin{Ticker: c.Ticker}) && !coin.Coins(policy.Limit).Contains(*c) {
+				return nil, errors.Wrapf(errors.ErrUnauthorized,
+					"spending limit of %q exceeded", contractID)
+			}
+		}
+	}
+	spending.Spent = spent
+	return &spending, nil
+}
+
+// contractFee returns the fee of the transaction if it is paid by the
+// contract. Nil is returned if the contract does not pay the fee.
+func (d Decorator) contractFee(ctx weave.Context, tx weave.Tx, contract *Contract) *coin.Coin {
+	ftx, ok := tx.(cash.FeeTx)
+	if !ok {
+		return nil
+	}
+	finfo := ftx.GetFees().DefaultPayer(x.MainSigner(ctx, d.auth).Address())
+	fee := finfo.GetFees()
+	if fee == nil || !fee.IsPositive() || !contract.Address.Equals(finfo.GetPayer()) {
+		return nil
+	}
+	return fee
 }
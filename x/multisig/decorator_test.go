@@ -3,13 +3,17 @@ package multisig
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
 )
 
 func TestDecorator(t *testing.T) {
@@ -140,10 +144,186 @@ func TestDecorator(t *testing.T) {
 	}
 }
 
+func TestDecoratorSpendingPolicy(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "multisig")
+
+	a := weavetest.NewCondition()
+	b := weavetest.NewCondition()
+	c := weavetest.NewCondition()
+
+	contractID := createContract(t, db, Contract{
+		Metadata: &weave.Metadata{Schema: 1},
+		Participants: []*Participant{
+			{Weight: 1, Signature: a.Address()},
+			{Weight: 1, Signature: b.Address()},
+			{Weight: 1, Signature: c.Address()},
+		},
+		ActivationThreshold: 2,
+		AdminThreshold:      3,
+		SpendingPolicy: &SpendingPolicy{
+			Threshold: 1,
+			Period:    weave.AsUnixDuration(24 * time.Hour),
+			Limit:     []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+		},
+	})
+	contractAddr := MultiSigCondition(contractID).Address()
+
+	send := func(source weave.Address, amount *coin.Coin) weave.Tx {
+		msg := &cash.SendMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Source:      source,
+			Destination: weavetest.NewCondition().Address(),
+			Amount:      amount,
+		}
+		return ContractTx{Tx: &weavetest.Tx{Msg: msg}, MultisigID: [][]byte{contractID}}
+	}
+	sendWithFee := func(amount *coin.Coin, payer weave.Address, fee *coin.Coin) weave.Tx {
+		tx := send(contractAddr, amount).(ContractTx)
+		tx.Fees = &cash.FeeInfo{Payer: payer, Fees: fee}
+		return tx
+	}
+
+	day := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// Steps are executed in order and share the spending state.
+	steps := []struct {
+		name      string
+		tx        weave.Tx
+		signers   []weave.Condition
+		blockTime time.Time
+		// Error returned by the handler.
+		handlerErr error
+		wantErr    *errors.Error
+		wantSpent  coin.Coins
+	}{
+		{
+			name:      "below the limit with the spending threshold",
+			tx:        send(contractAddr, coin.NewCoinp(6, 0, "IOV")),
+			signers:   []weave.Condition{a},
+			blockTime: day,
+			wantSpent: coin.Coins{coin.NewCoinp(6, 0, "IOV")},
+		},
+		{
+			name:       "failed send does not count toward the limit",
+			tx:         send(contractAddr, coin.NewCoinp(3, 0, "IOV")),
+			signers:    []weave.Condition{a},
+			blockTime:  day,
+			handlerErr: errors.ErrAmount,
+			wantErr:    errors.ErrAmount,
+			wantSpent:  coin.Coins{coin.NewCoinp(6, 0, "IOV")},
+		},
+		{
+			name:      "above the limit with the spending threshold",
+			tx:        send(contractAddr, coin.NewCoinp(5, 0, "IOV")),
+			signers:   []weave.Condition{a},
+			blockTime: day,
+			wantErr:   errors.ErrUnauthorized,
+			wantSpent: coin.Coins{coin.NewCoinp(6, 0, "IOV")},
+		},
+		{
+			name:      "fee paid by the contract counts toward the limit",
+			tx:        sendWithFee(coin.NewCoinp(2, 0, "IOV"), contractAddr, coin.NewCoinp(1, 0, "IOV")),
+			signers:   []weave.Condition{a},
+			blockTime: day,
+			wantSpent: coin.Coins{coin.NewCoinp(9, 0, "IOV")},
+		},
+		{
+			name:      "fee paid by the contract above the limit with the spending threshold",
+			tx:        sendWithFee(coin.NewCoinp(1, 0, "IOV"), contractAddr, coin.NewCoinp(1, 0, "IOV")),
+			signers:   []weave.Condition{a},
+			blockTime: day,
+			wantErr:   errors.ErrUnauthorized,
+			wantSpent: coin.Coins{coin.NewCoinp(9, 0, "IOV")},
+		},
+		{
+			name:      "fee paid by the contract in a currency without a limit with the spending threshold",
+			tx:        sendWithFee(coin.NewCoinp(1, 0, "IOV"), contractAddr, coin.NewCoinp(1, 0, "ETH")),
+			signers:   []weave.Condition{a},
+			blockTime: day,
+			wantErr:   errors.ErrUnauthorized,
+			wantSpent: coin.Coins{coin.NewCoinp(9, 0, "IOV")},
+		},
+		{
+			name:      "up to the limit with a fee paid by another account",
+			tx:        sendWithFee(coin.NewCoinp(1, 0, "IOV"), b.Address(), coin.NewCoinp(5, 0, "IOV")),
+			signers:   []weave.Condition{b},
+			blockTime: day.Add(time.Hour),
+			wantSpent: coin.Coins{coin.NewCoinp(10, 0, "IOV")},
+		},
+		{
+			name:      "currency without a limit with the spending threshold",
+			tx:        send(contractAddr, coin.NewCoinp(1, 0, "ETH")),
+			signers:   []weave.Condition{a},
+			blockTime: day,
+			wantErr:   errors.ErrUnauthorized,
+			wantSpent: coin.Coins{coin.NewCoinp(10, 0, "IOV")},
+		},
+		{
+			name:      "sending from another account with the spending threshold",
+			tx:        send(a.Address(), coin.NewCoinp(1, 0, "IOV")),
+			signers:   []weave.Condition{a},
+			blockTime: day,
+			wantErr:   errors.ErrUnauthorized,
+			wantSpent: coin.Coins{coin.NewCoinp(10, 0, "IOV")},
+		},
+		{
+			name:      "not a payment with the spending threshold",
+			tx:        ContractTx{Tx: &weavetest.Tx{Msg: &weavetest.Msg{}}, MultisigID: [][]byte{contractID}},
+			signers:   []weave.Condition{a},
+			blockTime: day,
+			wantErr:   errors.ErrUnauthorized,
+			wantSpent: coin.Coins{coin.NewCoinp(10, 0, "IOV")},
+		},
+		{
+			name:      "above the limit with the activation threshold",
+			tx:        send(contractAddr, coin.NewCoinp(5, 0, "IOV")),
+			signers:   []weave.Condition{a, b},
+			blockTime: day,
+			wantSpent: coin.Coins{coin.NewCoinp(15, 0, "IOV")},
+		},
+		{
+			name:      "limit is reset in the next period",
+			tx:        send(contractAddr, coin.NewCoinp(10, 0, "IOV")),
+			signers:   []weave.Condition{c},
+			blockTime: day.Add(24 * time.Hour),
+			wantSpent: coin.Coins{coin.NewCoinp(10, 0, "IOV")},
+		},
+	}
+
+	spending := NewSpendingBucket()
+	for _, step := range steps {
+		ctx := context.Background()
+		ctx = weave.WithHeight(ctx, 100)
+		ctx = weave.WithBlockTime(ctx, step.blockTime)
+		auth := &weavetest.CtxAuth{Key: "authKey"}
+		ctx = auth.SetConditions(ctx, step.signers...)
+		d := NewDecorator(x.ChainAuth(auth, Authenticate{}))
+
+		hn := MultisigCheckHandler{Err: step.handlerErr}
+		stack := weavetest.Decorate(&hn, d)
+
+		// Changes are written even if the delivery failed, because
+		// nothing above the decorator rolls them back in a real
+		// application stack.
+		if _, err := stack.Deliver(ctx, db, step.tx); !step.wantErr.Is(err) {
+			t.Fatalf("%s: unexpected error: %+v", step.name, err)
+		}
+
+		var got Spending
+		assert.Nil(t, spending.One(db, contractID, &got))
+		if !coin.Coins(got.Spent).Equals(step.wantSpent) {
+			t.Fatalf("%s: want %v spent, got %v", step.name, step.wantSpent, got.Spent)
+		}
+	}
+}
+
 // MultisigCheckHandler stores the seen permissions on each call
 // for this extension's authenticator (ie. multisig.Authenticate)
+// Delivery fails with Err if set.
 type MultisigCheckHandler struct {
 	Perms []weave.Condition
+	Err   error
 }
 
 var _ weave.Handler = (*MultisigCheckHandler)(nil)
@@ -155,6 +335,9 @@ func (s *MultisigCheckHandler) Check(ctx weave.Context, store weave.KVStore, tx
 
 func (s *MultisigCheckHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
 	s.Perms = Authenticate{}.GetConditions(ctx)
+	if s.Err != nil {
+		return nil, s.Err
+	}
 	return &weave.DeliverResult{}, nil
 }
 
@@ -162,6 +345,7 @@ func (s *MultisigCheckHandler) Deliver(ctx weave.Context, store weave.KVStore, t
 type ContractTx struct {
 	weave.Tx
 	MultisigID [][]byte
+	Fees       *cash.FeeInfo
 }
 
 var _ MultiSigTx = ContractTx{}
@@ -171,6 +355,10 @@ func (p ContractTx) GetMultisig() [][]byte {
 	return p.MultisigID
 }
 
+func (p ContractTx) GetFees() *cash.FeeInfo {
+	return p.Fees
+}
+
 func createContract(t testing.TB, db weave.KVStore, c Contract) []byte {
 	t.Helper()
 
@@ -10,16 +10,24 @@ import (
 
 // RegisterRoutes will instantiate and register
 // all handlers in this package
-func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, scheduler weave.Scheduler) {
 	r = migration.SchemaMigratingRegistry("multisig", r)
 	bucket := NewContractBucket()
 	r.Handle(&CreateMsg{}, CreateMsgHandler{auth, bucket})
-	r.Handle(&UpdateMsg{}, UpdateMsgHandler{auth, bucket})
+	r.Handle(&UpdateMsg{}, UpdateMsgHandler{auth, bucket, scheduler})
+}
+
+// RegisterCronRoutes registers handlers for messages that are executed by the
+// cron only.
+func RegisterCronRoutes(r weave.Registry, auth x.Authenticator) {
+	r = migration.SchemaMigratingRegistry("multisig", r)
+	r.Handle(&ExecuteUpdateMsg{}, ExecuteUpdateMsgHandler{auth, NewContractBucket()})
 }
 
 // RegisterQuery register queries from buckets in this package
 func RegisterQuery(qr weave.QueryRouter) {
 	NewContractBucket().Register("contracts", qr)
+	NewSpendingBucket().Register("spending", qr)
 }
 
 type CreateMsgHandler struct {
@@ -55,6 +63,8 @@ func (h CreateMsgHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.
 		ActivationThreshold: msg.ActivationThreshold,
 		AdminThreshold:      msg.AdminThreshold,
 		Address:             MultiSigCondition(key).Address(),
+		SpendingPolicy:      msg.SpendingPolicy,
+		AdminTimelock:       msg.AdminTimelock,
 	}
 
 	if _, err = h.bucket.Put(db, key, contract); err != nil {
@@ -80,14 +90,15 @@ func (h CreateMsgHandler) validate(ctx weave.Context, db weave.KVStore, tx weave
 }
 
 type UpdateMsgHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = CreateMsgHandler{}
 
 func (h UpdateMsgHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	_, err := h.validate(ctx, db, tx)
+	_, _, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -95,17 +106,57 @@ func (h UpdateMsgHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx
 }
 
 func (h UpdateMsgHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	msg, err := h.validate(ctx, db, tx)
+	msg, current, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
 
+	// A contract with an admin timelock is not updated immediately.
+	// Instead the update is scheduled for execution once the timelock
+	// passes. A new update replaces the one that is pending.
+	if current.AdminTimelock > 0 {
+		if p := current.PendingUpdate; p != nil {
+			if err := h.scheduler.Delete(db, p.TaskID); err != nil && !errors.ErrNotFound.Is(err) {
+				return nil, errors.Wrap(err, "cannot delete pending update task")
+			}
+		}
+		blockTime, err := weave.BlockTime(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "block time")
+		}
+		runAt := blockTime.Add(current.AdminTimelock.Duration())
+		executeMsg := &ExecuteUpdateMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ContractID: msg.ContractID,
+		}
+		// Execute message requires no authentication.
+		taskID, err := h.scheduler.Schedule(db, runAt, nil, executeMsg)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot schedule update task")
+		}
+		current.PendingUpdate = &PendingUpdate{
+			Participants:        msg.Participants,
+			ActivationThreshold: msg.ActivationThreshold,
+			AdminThreshold:      msg.AdminThreshold,
+			SpendingPolicy:      msg.SpendingPolicy,
+			AdminTimelock:       msg.AdminTimelock,
+			ExecuteAt:           weave.AsUnixTime(runAt),
+			TaskID:              taskID,
+		}
+		if _, err := h.bucket.Put(db, msg.ContractID, current); err != nil {
+			return nil, errors.Wrap(err, "cannot update contract")
+		}
+		return &weave.DeliverResult{Log: "Update pending"}, nil
+	}
+
 	contract := &Contract{
 		Metadata:            &weave.Metadata{Schema: 1},
 		Participants:        msg.Participants,
 		ActivationThreshold: msg.ActivationThreshold,
 		AdminThreshold:      msg.AdminThreshold,
 		Address:             MultiSigCondition(msg.ContractID).Address(),
+		SpendingPolicy:      msg.SpendingPolicy,
+		AdminTimelock:       msg.AdminTimelock,
 	}
 
 	if _, err := h.bucket.Put(db, msg.ContractID, contract); err != nil {
@@ -115,10 +166,10 @@ func (h UpdateMsgHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.
 	return &weave.DeliverResult{}, nil
 }
 
-func (h UpdateMsgHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UpdateMsg, error) {
+func (h UpdateMsgHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UpdateMsg, *Contract, error) {
 	var msg UpdateMsg
 	if err := weave.LoadMsg(tx, &msg); err != nil {
-		return nil, errors.Wrap(err, "load msg")
+		return nil, nil, errors.Wrap(err, "load msg")
 	}
 
 	// Using current version of the contract, ensure that enoguht
@@ -126,7 +177,7 @@ func (h UpdateMsgHandler) validate(ctx weave.Context, db weave.KVStore, tx weave
 	// order to run functionality that requires admin rights.
 	var contract Contract
 	if err := h.bucket.One(db, msg.ContractID, &contract); err != nil {
-		return nil, errors.Wrap(err, "cannot load contract from the store")
+		return nil, nil, errors.Wrap(err, "cannot load contract from the store")
 	}
 	var weight Weight
 	for _, p := range contract.Participants {
@@ -135,8 +186,51 @@ func (h UpdateMsgHandler) validate(ctx weave.Context, db weave.KVStore, tx weave
 		}
 	}
 	if weight < contract.AdminThreshold {
-		return &msg, errors.Wrapf(errors.ErrUnauthorized,
+		return &msg, nil, errors.Wrapf(errors.ErrUnauthorized,
 			"%d weight is not enough to administrate %q", weight, msg.ContractID)
 	}
-	return &msg, nil
+	return &msg, &contract, nil
+}
+
+type ExecuteUpdateMsgHandler struct {
+	auth   x.Authenticator
+	bucket orm.ModelBucket
+}
+
+var _ weave.Handler = ExecuteUpdateMsgHandler{}
+
+func (h ExecuteUpdateMsgHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	return nil, errors.Wrap(errors.ErrHuman, "execute update handler is to be executed by cron only")
+}
+
+func (h ExecuteUpdateMsgHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	var msg ExecuteUpdateMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	var contract Contract
+	if err := h.bucket.One(db, msg.ContractID, &contract); err != nil {
+		return nil, errors.Wrap(err, "cannot load contract from the store")
+	}
+	u := contract.PendingUpdate
+	if u == nil {
+		return nil, errors.Wrap(errors.ErrState, "no pending update")
+	}
+	if weave.InTheFuture(ctx, u.ExecuteAt.Time()) {
+		return nil, errors.Wrap(errors.ErrState, "execution before the admin timelock passed")
+	}
+
+	updated := &Contract{
+		Metadata:            &weave.Metadata{Schema: 1},
+		Participants:        u.Participants,
+		ActivationThreshold: u.ActivationThreshold,
+		AdminThreshold:      u.AdminThreshold,
+		Address:             MultiSigCondition(msg.ContractID).Address(),
+		SpendingPolicy:      u.SpendingPolicy,
+		AdminTimelock:       u.AdminTimelock,
+	}
+	if _, err := h.bucket.Put(db, msg.ContractID, updated); err != nil {
+		return nil, errors.Wrap(err, "cannot update contract")
+	}
+	return &weave.DeliverResult{}, nil
 }
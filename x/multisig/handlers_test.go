@@ -3,9 +3,11 @@ package multisig
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
@@ -81,13 +83,83 @@ func TestCreateContractHandler(t *testing.T) {
 			},
 			WantCheckErr: errors.ErrMsg,
 		},
+		"successfully create a contract with a spending policy": {
+			Msg: &CreateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Participants: []*Participant{
+					{Weight: 1, Signature: alice},
+					{Weight: 2, Signature: bobby},
+					{Weight: 3, Signature: cindy},
+				},
+				ActivationThreshold: 3,
+				AdminThreshold:      5,
+				SpendingPolicy: &SpendingPolicy{
+					Threshold: 1,
+					Period:    weave.AsUnixDuration(24 * time.Hour),
+					Limit:     []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+				},
+				AdminTimelock: weave.AsUnixDuration(48 * time.Hour),
+			},
+		},
+		"cannot create if spending threshold is not lower than activation threshold": {
+			Msg: &CreateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Participants: []*Participant{
+					{Weight: 1, Signature: alice},
+					{Weight: 2, Signature: bobby},
+					{Weight: 3, Signature: cindy},
+				},
+				ActivationThreshold: 3,
+				AdminThreshold:      5,
+				SpendingPolicy: &SpendingPolicy{
+					Threshold: 3,
+					Period:    weave.AsUnixDuration(24 * time.Hour),
+					Limit:     []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+				},
+			},
+			WantCheckErr: errors.ErrMsg,
+		},
+		"cannot create a spending policy without a limit": {
+			Msg: &CreateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Participants: []*Participant{
+					{Weight: 1, Signature: alice},
+					{Weight: 2, Signature: bobby},
+					{Weight: 3, Signature: cindy},
+				},
+				ActivationThreshold: 3,
+				AdminThreshold:      5,
+				SpendingPolicy: &SpendingPolicy{
+					Threshold: 1,
+					Period:    weave.AsUnixDuration(24 * time.Hour),
+				},
+			},
+			WantCheckErr: errors.ErrMsg,
+		},
+		"cannot create a spending policy without a period": {
+			Msg: &CreateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Participants: []*Participant{
+					{Weight: 1, Signature: alice},
+					{Weight: 2, Signature: bobby},
+					{Weight: 3, Signature: cindy},
+				},
+				ActivationThreshold: 3,
+				AdminThreshold:      5,
+				SpendingPolicy: &SpendingPolicy{
+					Threshold: 1,
+					Limit:     []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+				},
+			},
+			WantCheckErr: errors.ErrMsg,
+		},
 	}
 
 	auth := &weavetest.Auth{
 		Signer: weavetest.NewCondition(), // Any signer will do.
 	}
 	rt := app.NewRouter()
-	RegisterRoutes(rt, auth)
+	RegisterRoutes(rt, auth, &weavetest.Cron{})
 
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
@@ -250,7 +322,7 @@ func TestUpdateContractHandler(t *testing.T) {
 
 	auth := &weavetest.CtxAuth{Key: "auth"}
 	rt := app.NewRouter()
-	RegisterRoutes(rt, auth)
+	RegisterRoutes(rt, auth, &weavetest.Cron{})
 
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
@@ -300,3 +372,82 @@ func TestUpdateContractHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateContractWithAdminTimelock(t *testing.T) {
+	aliceCond := weavetest.NewCondition()
+	alice := aliceCond.Address()
+	bobby := weavetest.NewCondition().Address()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "multisig")
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	cron := &weavetest.Cron{}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, cron)
+	RegisterCronRoutes(rt, auth)
+
+	now := time.Now().UTC()
+	contractID := createContract(t, db, Contract{
+		Metadata: &weave.Metadata{Schema: 1},
+		Participants: []*Participant{
+			{Weight: 1, Signature: alice},
+		},
+		ActivationThreshold: 1,
+		AdminThreshold:      1,
+		AdminTimelock:       weave.AsUnixDuration(time.Hour),
+	})
+
+	ctx := weave.WithBlockTime(context.Background(), now)
+	ctx = auth.SetConditions(ctx, aliceCond)
+	update := &weavetest.Tx{Msg: &UpdateMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ContractID: contractID,
+		Participants: []*Participant{
+			{Weight: 1, Signature: alice},
+			{Weight: 1, Signature: bobby},
+		},
+		ActivationThreshold: 2,
+		AdminThreshold:      2,
+	}}
+	if _, err := rt.Deliver(ctx, db, update); err != nil {
+		t.Fatalf("cannot update: %s", err)
+	}
+
+	// The update is pending until the admin timelock passes.
+	var contract Contract
+	assert.Nil(t, NewContractBucket().One(db, contractID, &contract))
+	assert.Equal(t, 1, len(contract.Participants))
+	if contract.PendingUpdate == nil {
+		t.Fatal("pending update not set")
+	}
+	assert.Equal(t, weave.AsUnixTime(now.Add(time.Hour)), contract.PendingUpdate.ExecuteAt)
+
+	execute := &weavetest.Tx{Msg: &ExecuteUpdateMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ContractID: contractID,
+	}}
+	if _, err := rt.Check(ctx, db, execute); !errors.ErrHuman.Is(err) {
+		t.Fatalf("want cron only error, got %+v", err)
+	}
+	if _, err := rt.Deliver(ctx, db, execute); !errors.ErrState.Is(err) {
+		t.Fatalf("want timelock error, got %+v", err)
+	}
+
+	ctx = weave.WithBlockTime(context.Background(), now.Add(time.Hour+time.Second))
+	if _, err := rt.Deliver(ctx, db, execute); err != nil {
+		t.Fatalf("cannot execute update: %s", err)
+	}
+	assert.Nil(t, NewContractBucket().One(db, contractID, &contract))
+	assert.Equal(t, 2, len(contract.Participants))
+	assert.Equal(t, Weight(2), contract.ActivationThreshold)
+	assert.Equal(t, weave.UnixDuration(0), contract.AdminTimelock)
+	if contract.PendingUpdate != nil {
+		t.Fatal("pending update not cleared")
+	}
+
+	// Without pending update there is nothing to execute.
+	if _, err := rt.Deliver(ctx, db, execute); !errors.ErrState.Is(err) {
+		t.Fatalf("want no pending update error, got %+v", err)
+	}
+}
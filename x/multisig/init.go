@@ -19,8 +19,10 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			Signature weave.Address `json:"signature"`
 			Weight    Weight        `json:"weight"`
 		} `json:"participants"`
-		ActivationThreshold Weight `json:"activation_threshold"`
-		AdminThreshold      Weight `json:"admin_threshold"`
+		ActivationThreshold Weight             `json:"activation_threshold"`
+		AdminThreshold      Weight             `json:"admin_threshold"`
+		SpendingPolicy      *SpendingPolicy    `json:"spending_policy"`
+		AdminTimelock       weave.UnixDuration `json:"admin_timelock"`
 	}
 	if err := opts.ReadOptions("multisig", &contracts); err != nil {
 		return err
@@ -45,6 +47,8 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			ActivationThreshold: c.ActivationThreshold,
 			AdminThreshold:      c.AdminThreshold,
 			Address:             MultiSigCondition(key).Address(),
+			SpendingPolicy:      c.SpendingPolicy,
+			AdminTimelock:       c.AdminTimelock,
 		}
 		if _, err := bucket.Put(kv, key, &contract); err != nil {
 			return errors.Wrapf(err, "cannot save #%d contract", i)
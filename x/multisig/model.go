@@ -2,6 +2,7 @@ package multisig
 
 import (
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
@@ -9,6 +10,7 @@ import (
 
 func init() {
 	migration.MustRegister(1, &Contract{}, migration.NoModification)
+	migration.MustRegister(1, &Spending{}, migration.NoModification)
 }
 
 const (
@@ -48,13 +50,38 @@ func (c *Contract) Validate() error {
 	if err := c.Address.Validate(); err != nil {
 		return errors.Wrap(err, "address")
 	}
-	return validateWeights(errors.ErrModel,
-		c.Participants, c.ActivationThreshold, c.AdminThreshold)
+	if err := validateWeights(errors.ErrModel,
+		c.Participants, c.ActivationThreshold, c.AdminThreshold); err != nil {
+		return err
+	}
+	if err := validatePolicy(errors.ErrModel,
+		c.SpendingPolicy, c.ActivationThreshold, c.AdminTimelock); err != nil {
+		return err
+	}
+	if u := c.PendingUpdate; u != nil {
+		if err := u.Validate(); err != nil {
+			return errors.Wrap(err, "pending update")
+		}
+	}
+	return nil
 }
 
 func (c *Contract) Copy() orm.CloneableData {
-	ps := make([]*Participant, 0, len(c.Participants))
-	for _, p := range c.Participants {
+	return &Contract{
+		Metadata:            c.Metadata.Copy(),
+		Participants:        copyParticipants(c.Participants),
+		ActivationThreshold: c.ActivationThreshold,
+		AdminThreshold:      c.AdminThreshold,
+		Address:             c.Address.Clone(),
+		SpendingPolicy:      c.SpendingPolicy.Copy(),
+		AdminTimelock:       c.AdminTimelock,
+		PendingUpdate:       c.PendingUpdate.Copy(),
+	}
+}
+
+func copyParticipants(participants []*Participant) []*Participant {
+	ps := make([]*Participant, 0, len(participants))
+	for _, p := range participants {
 		sig := make(weave.Address, len(p.Signature))
 		copy(sig, p.Signature)
 		ps = append(ps, &Participant{
@@ -62,12 +89,56 @@ func (c *Contract) Copy() orm.CloneableData {
 			Weight:    p.Weight,
 		})
 	}
-	return &Contract{
-		Metadata:            c.Metadata.Copy(),
-		Participants:        ps,
-		ActivationThreshold: c.ActivationThreshold,
-		AdminThreshold:      c.AdminThreshold,
-		Address:             c.Address.Clone(),
+	return ps
+}
+
+// Copy returns a deep copy of the policy. Copy of a nil policy is nil.
+func (p *SpendingPolicy) Copy() *SpendingPolicy {
+	if p == nil {
+		return nil
+	}
+	return &SpendingPolicy{
+		Threshold: p.Threshold,
+		Period:    p.Period,
+		Limit:     coin.Coins(p.Limit).Clone(),
+	}
+}
+
+// Validate returns an error if the pending update does not describe a valid
+// contract configuration.
+func (u *PendingUpdate) Validate() error {
+	if err := validateWeights(errors.ErrModel,
+		u.Participants, u.ActivationThreshold, u.AdminThreshold); err != nil {
+		return err
+	}
+	if err := validatePolicy(errors.ErrModel,
+		u.SpendingPolicy, u.ActivationThreshold, u.AdminTimelock); err != nil {
+		return err
+	}
+	if err := u.ExecuteAt.Validate(); err != nil {
+		return errors.Wrap(err, "execute at")
+	}
+	if len(u.TaskID) == 0 {
+		return errors.Wrap(errors.ErrModel, "missing task ID")
+	}
+	return nil
+}
+
+// Copy returns a deep copy of the pending update. Copy of a nil update is nil.
+func (u *PendingUpdate) Copy() *PendingUpdate {
+	if u == nil {
+		return nil
+	}
+	taskID := make([]byte, len(u.TaskID))
+	copy(taskID, u.TaskID)
+	return &PendingUpdate{
+		Participants:        copyParticipants(u.Participants),
+		ActivationThreshold: u.ActivationThreshold,
+		AdminThreshold:      u.AdminThreshold,
+		SpendingPolicy:      u.SpendingPolicy.Copy(),
+		AdminTimelock:       u.AdminTimelock,
+		ExecuteAt:           u.ExecuteAt,
+		TaskID:              taskID,
 	}
 }
 
@@ -79,3 +150,34 @@ func NewContractBucket() orm.ModelBucket {
 }
 
 var contractSeq = orm.NewSequence("contracts", "id")
+
+var _ orm.CloneableData = (*Spending)(nil)
+
+func (s *Spending) Validate() error {
+	if err := s.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if err := s.PeriodStart.Validate(); err != nil {
+		return errors.Wrap(err, "period start")
+	}
+	if err := coin.Coins(s.Spent).Validate(); err != nil {
+		return errors.Wrap(err, "spent")
+	}
+	return nil
+}
+
+func (s *Spending) Copy() orm.CloneableData {
+	return &Spending{
+		Metadata:    s.Metadata.Copy(),
+		PeriodStart: s.PeriodStart,
+		Spent:       coin.Coins(s.Spent).Clone(),
+	}
+}
+
+// NewSpendingBucket returns a bucket that tracks the amount sent from each
+// contract within the current spending period. Entities are stored under the
+// contract ID.
+func NewSpendingBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("spending", &Spending{})
+	return migration.NewModelBucket("multisig", b)
+}
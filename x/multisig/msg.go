@@ -2,6 +2,7 @@ package multisig
 
 import (
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 )
@@ -9,6 +10,7 @@ import (
 func init() {
 	migration.MustRegister(1, &CreateMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ExecuteUpdateMsg{}, migration.NoModification)
 }
 
 const (
@@ -38,8 +40,12 @@ func (c *CreateMsg) Validate() error {
 	case n > maxParticipantsAllowed:
 		return errors.Wrap(errors.ErrMsg, "too many participants")
 	}
-	return validateWeights(errors.ErrMsg,
-		c.Participants, c.ActivationThreshold, c.AdminThreshold)
+	if err := validateWeights(errors.ErrMsg,
+		c.Participants, c.ActivationThreshold, c.AdminThreshold); err != nil {
+		return err
+	}
+	return validatePolicy(errors.ErrMsg,
+		c.SpendingPolicy, c.ActivationThreshold, c.AdminTimelock)
 }
 
 var _ weave.Msg = (*UpdateMsg)(nil)
@@ -60,8 +66,30 @@ func (c *UpdateMsg) Validate() error {
 	case n > maxParticipantsAllowed:
 		return errors.Wrap(errors.ErrMsg, "too many participants")
 	}
-	return validateWeights(errors.ErrMsg,
-		c.Participants, c.ActivationThreshold, c.AdminThreshold)
+	if err := validateWeights(errors.ErrMsg,
+		c.Participants, c.ActivationThreshold, c.AdminThreshold); err != nil {
+		return err
+	}
+	return validatePolicy(errors.ErrMsg,
+		c.SpendingPolicy, c.ActivationThreshold, c.AdminTimelock)
+}
+
+var _ weave.Msg = (*ExecuteUpdateMsg)(nil)
+
+// Path fulfills weave.Msg interface to allow routing.
+func (ExecuteUpdateMsg) Path() string {
+	return "multisig/execute_update"
+}
+
+// Validate enforces the contract ID presence.
+func (c *ExecuteUpdateMsg) Validate() error {
+	if err := c.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if len(c.ContractID) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "contract ID")
+	}
+	return nil
 }
 
 // validateWeights returns an error if given participants and thresholds
@@ -113,3 +141,37 @@ func validateWeights(
 
 	return nil
 }
+
+// validatePolicy returns an error if given spending policy and admin timelock
+// configuration is not valid. A nil policy is valid, because the spending
+// policy is optional.
+func validatePolicy(
+	baseErr error,
+	policy *SpendingPolicy,
+	activationThreshold Weight,
+	adminTimelock weave.UnixDuration,
+) error {
+	if adminTimelock < 0 {
+		return errors.Wrap(baseErr, "admin timelock must not be negative")
+	}
+	if policy == nil {
+		return nil
+	}
+	if err := policy.Threshold.Validate(); err != nil {
+		return errors.Wrap(err, "spending threshold")
+	}
+	if policy.Threshold >= activationThreshold {
+		// Spending with the policy threshold would not be limited.
+		return errors.Wrap(baseErr, "spending threshold must be lower than the activation threshold")
+	}
+	if policy.Period <= 0 {
+		return errors.Wrap(baseErr, "spending period must be greater than zero")
+	}
+	if len(policy.Limit) == 0 {
+		return errors.Wrap(baseErr, "missing spending limit")
+	}
+	if err := coin.Coins(policy.Limit).Validate(); err != nil {
+		return errors.Wrap(err, "spending limit")
+	}
+	return nil
+}
@@ -1,8 +1,23 @@
 package multisig
 
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+)
+
 // MultiSigTx is an optional interface for a Tx that allows it to
 // support multisig contract. Multisig authentication can be done only
 // for transactions that do support this interface.
 type MultiSigTx interface {
 	GetMultisig() [][]byte
 }
+
+// SpendMsg is implemented by messages that send funds from a source account,
+// for example cash.SendMsg. Only such messages can be authorized with the
+// spending policy threshold of a contract and only they are counted toward
+// the spending limit.
+type SpendMsg interface {
+	weave.Msg
+	GetSource() weave.Address
+	GetAmount() *coin.Coin
+}
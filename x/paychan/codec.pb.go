@@ -458,518 +458,3618 @@ func (m *CloseMsg) GetMemo() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*PaymentChannel)(nil), "paychan.PaymentChannel")
-	proto.RegisterType((*CreateMsg)(nil), "paychan.CreateMsg")
-	proto.RegisterType((*Payment)(nil), "paychan.Payment")
-	proto.RegisterType((*TransferMsg)(nil), "paychan.TransferMsg")
-	proto.RegisterType((*CloseMsg)(nil), "paychan.CloseMsg")
+// BidirectionalChannel holds the state of a payment channel between two
+// parties that can both send payments to each other.
+//
+// Both parties deposit funds when the channel is created. Payments are made
+// off the chain by exchanging balance proofs signed by both parties. The
+// channel is closed either cooperatively, using a final balance proof, or
+// unilaterally. Unilateral close starts a dispute period during which the
+// other party can challenge the close with a balance proof of a higher nonce.
+// Once the dispute period is over, the channel is settled using the latest
+// known balances.
+type BidirectionalChannel struct {
+	Metadata *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PartyA   github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=party_a,json=partyA,proto3,casttype=github.com/iov-one/weave.Address" json:"party_a,omitempty"`
+	// Party A public key is used to verify party A signature of a balance
+	// proof.
+	PartyAPubkey *crypto.PublicKey                `protobuf:"bytes,3,opt,name=party_a_pubkey,json=partyAPubkey,proto3" json:"party_a_pubkey,omitempty"`
+	PartyB       github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=party_b,json=partyB,proto3,casttype=github.com/iov-one/weave.Address" json:"party_b,omitempty"`
+	// Party B public key is used to verify party B signature of a balance
+	// proof.
+	PartyBPubkey *crypto.PublicKey `protobuf:"bytes,5,opt,name=party_b_pubkey,json=partyBPubkey,proto3" json:"party_b_pubkey,omitempty"`
+	// Deposit A is the amount allocated by party A. It can be zero.
+	DepositA *coin.Coin `protobuf:"bytes,6,opt,name=deposit_a,json=depositA,proto3" json:"deposit_a,omitempty"`
+	// Deposit B is the amount allocated by party B. It can be zero.
+	DepositB *coin.Coin `protobuf:"bytes,7,opt,name=deposit_b,json=depositB,proto3" json:"deposit_b,omitempty"`
+	// Dispute period is the time that the other party has to challenge an
+	// unilateral close.
+	DisputePeriod github_com_iov_one_weave.UnixDuration `protobuf:"varint,8,opt,name=dispute_period,json=disputePeriod,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"dispute_period,omitempty"`
+	// Max length 128 character.
+	Memo string `protobuf:"bytes,9,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Address of this entity. Set during creation and does not change.
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,10,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Nonce of the latest balance proof submitted during the close. Zero if
+	// no balance proof was submitted.
+	Nonce uint64 `protobuf:"varint,11,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Balance A is the amount that party A receives when the channel is
+	// settled. Set when the close is started.
+	BalanceA *coin.Coin `protobuf:"bytes,12,opt,name=balance_a,json=balanceA,proto3" json:"balance_a,omitempty"`
+	// Balance B is the amount that party B receives when the channel is
+	// settled. Set when the close is started.
+	BalanceB *coin.Coin `protobuf:"bytes,13,opt,name=balance_b,json=balanceB,proto3" json:"balance_b,omitempty"`
+	// Close at is the time when the dispute period ends and the channel is
+	// settled. Zero if the close was not started.
+	CloseAt github_com_iov_one_weave.UnixTime `protobuf:"varint,14,opt,name=close_at,json=closeAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"close_at,omitempty"`
+	// Task ID is the ID of the scheduled task that settles the channel.
+	TaskID []byte `protobuf:"bytes,15,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("x/paychan/codec.proto", fileDescriptor_daf7b5492d84b22a) }
-
-var fileDescriptor_daf7b5492d84b22a = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x9b, 0x34, 0x8e, 0xc7, 0x2d, 0x94, 0x05, 0x24, 0x2b, 0x07, 0xc7, 0x44, 0x80, 0x22,
-	0x28, 0xb6, 0x54, 0x24, 0x4e, 0x08, 0x44, 0x52, 0x21, 0x45, 0xa8, 0x52, 0x64, 0xca, 0xb9, 0xda,
-	0xd8, 0x43, 0xb2, 0x22, 0xde, 0x8d, 0xec, 0x75, 0xa9, 0xff, 0x82, 0x1b, 0xbf, 0xc4, 0xb1, 0x47,
-	0x4e, 0x51, 0x95, 0x9c, 0xf9, 0x81, 0x9e, 0x90, 0xed, 0x4d, 0x9b, 0x82, 0x38, 0xf8, 0xc0, 0xad,
-	0xb7, 0xd1, 0x9b, 0x37, 0x3b, 0x33, 0x6f, 0x67, 0x06, 0x1e, 0x9e, 0x79, 0x73, 0x9a, 0x05, 0x53,
-	0xca, 0xbd, 0x40, 0x84, 0x18, 0xb8, 0xf3, 0x58, 0x48, 0x41, 0x74, 0x05, 0xb6, 0xcd, 0x0d, 0xb4,
-	0xbd, 0x17, 0x08, 0x76, 0x83, 0xd7, 0xbe, 0x1f, 0xc4, 0xd9, 0x5c, 0x0a, 0x2f, 0x12, 0x21, 0xce,
-	0x12, 0x05, 0x3e, 0x98, 0x88, 0x89, 0x28, 0x4c, 0x2f, 0xb7, 0x4a, 0xb4, 0x7b, 0x51, 0x87, 0x3b,
-	0x23, 0x9a, 0x45, 0xc8, 0xe5, 0x60, 0x4a, 0x39, 0xc7, 0x19, 0x79, 0x0e, 0xad, 0x08, 0x25, 0x0d,
-	0xa9, 0xa4, 0x96, 0xe6, 0x68, 0x3d, 0xf3, 0xe0, 0xae, 0xfb, 0x15, 0xe9, 0x29, 0xba, 0x47, 0x0a,
-	0xf6, 0xaf, 0x08, 0xe4, 0x35, 0x34, 0x13, 0x91, 0xc6, 0x01, 0x5a, 0x5b, 0x8e, 0xd6, 0xdb, 0xe9,
-	0x3f, 0xbe, 0x5c, 0x74, 0x9c, 0x09, 0x93, 0xd3, 0x74, 0xec, 0x06, 0x22, 0xf2, 0x98, 0x38, 0x7d,
-	0x21, 0x38, 0x7a, 0xe5, 0x03, 0xef, 0xc2, 0x30, 0xc6, 0x24, 0xf1, 0x55, 0x0c, 0x79, 0x05, 0xbb,
-	0xa5, 0x75, 0x32, 0x4f, 0xc7, 0x5f, 0x30, 0xb3, 0xea, 0x45, 0xbe, 0x7b, 0x6e, 0xd9, 0x80, 0x3b,
-	0x4a, 0xc7, 0x33, 0x16, 0x7c, 0xc0, 0xcc, 0xdf, 0x29, 0x79, 0xa3, 0x82, 0x46, 0xde, 0x83, 0x19,
-	0x62, 0x22, 0x19, 0xa7, 0x92, 0x09, 0x6e, 0x35, 0x2a, 0xa4, 0xde, 0x0c, 0x24, 0x0e, 0x6c, 0x4b,
-	0x21, 0xe9, 0xcc, 0xda, 0x2e, 0xf2, 0x82, 0x9b, 0x4b, 0xe9, 0x0e, 0x04, 0xe3, 0x7e, 0xe9, 0x20,
-	0x6f, 0x41, 0x97, 0x2c, 0x42, 0x91, 0x4a, 0xab, 0xe9, 0x68, 0xbd, 0x7a, 0xff, 0xc9, 0xe5, 0xa2,
-	0xf3, 0xe8, 0x9f, 0x59, 0x3e, 0x71, 0x76, 0x76, 0xcc, 0x22, 0xf4, 0xd7, 0x51, 0x84, 0x40, 0x23,
-	0xc2, 0x48, 0x58, 0xba, 0xa3, 0xf5, 0x0c, 0xbf, 0xb0, 0xc9, 0x3e, 0x98, 0x32, 0xa6, 0x3c, 0xf9,
-	0x8c, 0x71, 0x8c, 0xa1, 0xd5, 0xfa, 0x2b, 0xf9, 0xa6, 0x9b, 0xbc, 0x01, 0x9d, 0x96, 0xc5, 0x5b,
-	0x46, 0x85, 0x46, 0xd7, 0x41, 0xdd, 0x5f, 0x5b, 0x60, 0x0c, 0x62, 0xa4, 0x12, 0x8f, 0x92, 0xc9,
-	0xed, 0xef, 0xfe, 0xef, 0xdf, 0xed, 0x7e, 0xd7, 0x40, 0x57, 0x2b, 0x45, 0x9e, 0x42, 0x2b, 0x98,
-	0x52, 0xc6, 0x4f, 0x58, 0x58, 0xa8, 0x6d, 0xf4, 0xcd, 0xe5, 0xa2, 0xa3, 0x0f, 0x72, 0x6c, 0x78,
-	0xe8, 0xeb, 0x85, 0x73, 0x18, 0x92, 0x7d, 0x80, 0xa0, 0x5c, 0xbf, 0x9c, 0x59, 0x8a, 0xbd, 0xbb,
-	0x5c, 0x74, 0x0c, 0xb5, 0x94, 0xc3, 0x43, 0xdf, 0x50, 0x84, 0x61, 0x48, 0xba, 0xd0, 0xa4, 0x91,
-	0x48, 0xb9, 0x54, 0x8a, 0x6e, 0x76, 0xa6, 0x3c, 0x57, 0x95, 0x35, 0x6e, 0x56, 0x66, 0x1e, 0xab,
-	0xc9, 0xaa, 0x3c, 0x0b, 0xcf, 0x20, 0x3f, 0x3f, 0x79, 0x57, 0x45, 0x7d, 0xe6, 0xc1, 0x9e, 0xab,
-	0xce, 0x91, 0xab, 0xba, 0xf5, 0xd7, 0x04, 0xe2, 0x81, 0x91, 0xb0, 0x09, 0xa7, 0x32, 0x8d, 0xf1,
-	0xcf, 0x5f, 0xff, 0xb8, 0x76, 0xf8, 0xd7, 0x9c, 0x6e, 0x06, 0xad, 0xc1, 0x4c, 0x24, 0xd5, 0x27,
-	0xb4, 0x9a, 0x70, 0x6b, 0x51, 0xea, 0xd7, 0xa2, 0xf4, 0xad, 0x1f, 0x4b, 0x5b, 0x3b, 0x5f, 0xda,
-	0xda, 0xc5, 0xd2, 0xd6, 0xbe, 0xad, 0xec, 0xda, 0xf9, 0xca, 0xae, 0xfd, 0x5c, 0xd9, 0xb5, 0x71,
-	0xb3, 0x38, 0x91, 0x2f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x43, 0xfb, 0x17, 0x2b, 0x8e, 0x05,
-	0x00, 0x00,
+func (m *BidirectionalChannel) Reset()         { *m = BidirectionalChannel{} }
+func (m *BidirectionalChannel) String() string { return proto.CompactTextString(m) }
+func (*BidirectionalChannel) ProtoMessage()    {}
+func (*BidirectionalChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daf7b5492d84b22a, []int{5}
+}
+func (m *BidirectionalChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidirectionalChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidirectionalChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidirectionalChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidirectionalChannel.Merge(m, src)
+}
+func (m *BidirectionalChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidirectionalChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidirectionalChannel.DiscardUnknown(m)
 }
 
-func (m *PaymentChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BidirectionalChannel proto.InternalMessageInfo
+
+func (m *BidirectionalChannel) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PaymentChannel) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n1, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+func (m *BidirectionalChannel) GetPartyA() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.PartyA
 	}
-	if len(m.Source) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
-		i += copy(dAtA[i:], m.Source)
+	return nil
+}
+
+func (m *BidirectionalChannel) GetPartyAPubkey() *crypto.PublicKey {
+	if m != nil {
+		return m.PartyAPubkey
 	}
-	if m.SourcePubkey != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.SourcePubkey.Size()))
-		n2, err := m.SourcePubkey.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	return nil
+}
+
+func (m *BidirectionalChannel) GetPartyB() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.PartyB
 	}
-	if len(m.Destination) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
-		i += copy(dAtA[i:], m.Destination)
+	return nil
+}
+
+func (m *BidirectionalChannel) GetPartyBPubkey() *crypto.PublicKey {
+	if m != nil {
+		return m.PartyBPubkey
 	}
-	if m.Total != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Total.Size()))
-		n3, err := m.Total.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+	return nil
+}
+
+func (m *BidirectionalChannel) GetDepositA() *coin.Coin {
+	if m != nil {
+		return m.DepositA
 	}
-	if m.Timeout != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Timeout))
+	return nil
+}
+
+func (m *BidirectionalChannel) GetDepositB() *coin.Coin {
+	if m != nil {
+		return m.DepositB
 	}
-	if len(m.Memo) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
-		i += copy(dAtA[i:], m.Memo)
+	return nil
+}
+
+func (m *BidirectionalChannel) GetDisputePeriod() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.DisputePeriod
 	}
-	if m.Transferred != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Transferred.Size()))
-		n4, err := m.Transferred.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+	return 0
+}
+
+func (m *BidirectionalChannel) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	if len(m.Address) > 0 {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
-		i += copy(dAtA[i:], m.Address)
+	return ""
+}
+
+func (m *BidirectionalChannel) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
 	}
-	return i, nil
+	return nil
 }
 
-func (m *CreateMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *BidirectionalChannel) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+func (m *BidirectionalChannel) GetBalanceA() *coin.Coin {
+	if m != nil {
+		return m.BalanceA
 	}
-	if len(m.Source) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
-		i += copy(dAtA[i:], m.Source)
+	return nil
+}
+
+func (m *BidirectionalChannel) GetBalanceB() *coin.Coin {
+	if m != nil {
+		return m.BalanceB
 	}
-	if m.SourcePubkey != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.SourcePubkey.Size()))
-		n6, err := m.SourcePubkey.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+	return nil
+}
+
+func (m *BidirectionalChannel) GetCloseAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.CloseAt
 	}
-	if len(m.Destination) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
-		i += copy(dAtA[i:], m.Destination)
+	return 0
+}
+
+func (m *BidirectionalChannel) GetTaskID() []byte {
+	if m != nil {
+		return m.TaskID
 	}
-	if m.Total != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Total.Size()))
-		n7, err := m.Total.MarshalTo(dAtA[i:])
+	return nil
+}
+
+// CreateBidirectionalMsg creates a new bidirectional payment channel.
+//
+// Deposits are taken from the parties accounts and allocated on the channel
+// account. Each party with a non zero deposit must sign the transaction.
+type CreateBidirectionalMsg struct {
+	Metadata      *weave.Metadata                       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PartyA        github_com_iov_one_weave.Address      `protobuf:"bytes,2,opt,name=party_a,json=partyA,proto3,casttype=github.com/iov-one/weave.Address" json:"party_a,omitempty"`
+	PartyAPubkey  *crypto.PublicKey                     `protobuf:"bytes,3,opt,name=party_a_pubkey,json=partyAPubkey,proto3" json:"party_a_pubkey,omitempty"`
+	PartyB        github_com_iov_one_weave.Address      `protobuf:"bytes,4,opt,name=party_b,json=partyB,proto3,casttype=github.com/iov-one/weave.Address" json:"party_b,omitempty"`
+	PartyBPubkey  *crypto.PublicKey                     `protobuf:"bytes,5,opt,name=party_b_pubkey,json=partyBPubkey,proto3" json:"party_b_pubkey,omitempty"`
+	DepositA      *coin.Coin                            `protobuf:"bytes,6,opt,name=deposit_a,json=depositA,proto3" json:"deposit_a,omitempty"`
+	DepositB      *coin.Coin                            `protobuf:"bytes,7,opt,name=deposit_b,json=depositB,proto3" json:"deposit_b,omitempty"`
+	DisputePeriod github_com_iov_one_weave.UnixDuration `protobuf:"varint,8,opt,name=dispute_period,json=disputePeriod,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"dispute_period,omitempty"`
+	// Max length 128 character.
+	Memo string `protobuf:"bytes,9,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *CreateBidirectionalMsg) Reset()         { *m = CreateBidirectionalMsg{} }
+func (m *CreateBidirectionalMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateBidirectionalMsg) ProtoMessage()    {}
+func (*CreateBidirectionalMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daf7b5492d84b22a, []int{6}
+}
+func (m *CreateBidirectionalMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateBidirectionalMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateBidirectionalMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n7
+		return b[:n], nil
 	}
-	if m.Timeout != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Timeout))
+}
+func (m *CreateBidirectionalMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBidirectionalMsg.Merge(m, src)
+}
+func (m *CreateBidirectionalMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateBidirectionalMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBidirectionalMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateBidirectionalMsg proto.InternalMessageInfo
+
+func (m *CreateBidirectionalMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.Memo) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
-		i += copy(dAtA[i:], m.Memo)
+	return nil
+}
+
+func (m *CreateBidirectionalMsg) GetPartyA() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.PartyA
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Payment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CreateBidirectionalMsg) GetPartyAPubkey() *crypto.PublicKey {
+	if m != nil {
+		return m.PartyAPubkey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Payment) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChainID)))
-		i += copy(dAtA[i:], m.ChainID)
-	}
-	if len(m.ChannelID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChannelID)))
-		i += copy(dAtA[i:], m.ChannelID)
-	}
-	if m.Amount != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
-		n8, err := m.Amount.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
-	}
-	if len(m.Memo) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
-		i += copy(dAtA[i:], m.Memo)
+func (m *CreateBidirectionalMsg) GetPartyB() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.PartyB
 	}
-	return i, nil
+	return nil
 }
 
-func (m *TransferMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CreateBidirectionalMsg) GetPartyBPubkey() *crypto.PublicKey {
+	if m != nil {
+		return m.PartyBPubkey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TransferMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n9, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+func (m *CreateBidirectionalMsg) GetDepositA() *coin.Coin {
+	if m != nil {
+		return m.DepositA
 	}
-	if m.Payment != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Payment.Size()))
-		n10, err := m.Payment.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+	return nil
+}
+
+func (m *CreateBidirectionalMsg) GetDepositB() *coin.Coin {
+	if m != nil {
+		return m.DepositB
 	}
-	if m.Signature != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Signature.Size()))
-		n11, err := m.Signature.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+	return nil
+}
+
+func (m *CreateBidirectionalMsg) GetDisputePeriod() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.DisputePeriod
 	}
-	return i, nil
+	return 0
 }
 
-func (m *CloseMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CreateBidirectionalMsg) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CloseMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n12, err := m.Metadata.MarshalTo(dAtA[i:])
+// BalanceProof declares how the deposits of a bidirectional channel are split
+// between the parties. It is created off the chain and must be signed by both
+// parties.
+//
+// Each balance proof must be created with a nonce greater than the previous
+// one. Balances must sum up to the total deposit of the channel.
+type BalanceProof struct {
+	ChainID   string     `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ChannelID []byte     `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Nonce     uint64     `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	BalanceA  *coin.Coin `protobuf:"bytes,4,opt,name=balance_a,json=balanceA,proto3" json:"balance_a,omitempty"`
+	BalanceB  *coin.Coin `protobuf:"bytes,5,opt,name=balance_b,json=balanceB,proto3" json:"balance_b,omitempty"`
+	// Final is set when both parties agree to close the channel. Only a final
+	// balance proof can be used to close a channel without a dispute period.
+	Final bool `protobuf:"varint,6,opt,name=final,proto3" json:"final,omitempty"`
+}
+
+func (m *BalanceProof) Reset()         { *m = BalanceProof{} }
+func (m *BalanceProof) String() string { return proto.CompactTextString(m) }
+func (*BalanceProof) ProtoMessage()    {}
+func (*BalanceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daf7b5492d84b22a, []int{7}
+}
+func (m *BalanceProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n12
-	}
-	if len(m.ChannelID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChannelID)))
-		i += copy(dAtA[i:], m.ChannelID)
-	}
-	if len(m.Memo) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
-		i += copy(dAtA[i:], m.Memo)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *BalanceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceProof.Merge(m, src)
+}
+func (m *BalanceProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceProof.DiscardUnknown(m)
 }
 
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_BalanceProof proto.InternalMessageInfo
+
+func (m *BalanceProof) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return ""
 }
-func (m *PaymentChannel) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BalanceProof) GetChannelID() []byte {
+	if m != nil {
+		return m.ChannelID
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *BalanceProof) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return 0
+}
+
+func (m *BalanceProof) GetBalanceA() *coin.Coin {
+	if m != nil {
+		return m.BalanceA
 	}
-	if m.SourcePubkey != nil {
-		l = m.SourcePubkey.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *BalanceProof) GetBalanceB() *coin.Coin {
+	if m != nil {
+		return m.BalanceB
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *BalanceProof) GetFinal() bool {
+	if m != nil {
+		return m.Final
 	}
-	if m.Total != nil {
-		l = m.Total.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return false
+}
+
+// CooperativeCloseMsg closes a bidirectional channel immediately using a
+// final balance proof signed by both parties.
+type CooperativeCloseMsg struct {
+	Metadata   *weave.Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Proof      *BalanceProof     `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	SignatureA *crypto.Signature `protobuf:"bytes,3,opt,name=signature_a,json=signatureA,proto3" json:"signature_a,omitempty"`
+	SignatureB *crypto.Signature `protobuf:"bytes,4,opt,name=signature_b,json=signatureB,proto3" json:"signature_b,omitempty"`
+}
+
+func (m *CooperativeCloseMsg) Reset()         { *m = CooperativeCloseMsg{} }
+func (m *CooperativeCloseMsg) String() string { return proto.CompactTextString(m) }
+func (*CooperativeCloseMsg) ProtoMessage()    {}
+func (*CooperativeCloseMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daf7b5492d84b22a, []int{8}
+}
+func (m *CooperativeCloseMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CooperativeCloseMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CooperativeCloseMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovCodec(uint64(m.Timeout))
+}
+func (m *CooperativeCloseMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CooperativeCloseMsg.Merge(m, src)
+}
+func (m *CooperativeCloseMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CooperativeCloseMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CooperativeCloseMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CooperativeCloseMsg proto.InternalMessageInfo
+
+func (m *CooperativeCloseMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *CooperativeCloseMsg) GetProof() *BalanceProof {
+	if m != nil {
+		return m.Proof
 	}
-	if m.Transferred != nil {
-		l = m.Transferred.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *CooperativeCloseMsg) GetSignatureA() *crypto.Signature {
+	if m != nil {
+		return m.SignatureA
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *CooperativeCloseMsg) GetSignatureB() *crypto.Signature {
+	if m != nil {
+		return m.SignatureB
 	}
-	return n
+	return nil
 }
 
-func (m *CreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+// StartCloseMsg starts an unilateral close of a bidirectional channel. It can
+// be submitted by either party.
+//
+// If no balance proof is provided, the deposits are returned to the parties.
+type StartCloseMsg struct {
+	Metadata  *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ChannelID []byte          `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Optional balance proof.
+	Proof      *BalanceProof     `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	SignatureA *crypto.Signature `protobuf:"bytes,4,opt,name=signature_a,json=signatureA,proto3" json:"signature_a,omitempty"`
+	SignatureB *crypto.Signature `protobuf:"bytes,5,opt,name=signature_b,json=signatureB,proto3" json:"signature_b,omitempty"`
+}
+
+func (m *StartCloseMsg) Reset()         { *m = StartCloseMsg{} }
+func (m *StartCloseMsg) String() string { return proto.CompactTextString(m) }
+func (*StartCloseMsg) ProtoMessage()    {}
+func (*StartCloseMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daf7b5492d84b22a, []int{9}
+}
+func (m *StartCloseMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartCloseMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartCloseMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+}
+func (m *StartCloseMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartCloseMsg.Merge(m, src)
+}
+func (m *StartCloseMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartCloseMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartCloseMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartCloseMsg proto.InternalMessageInfo
+
+func (m *StartCloseMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *StartCloseMsg) GetChannelID() []byte {
+	if m != nil {
+		return m.ChannelID
 	}
-	if m.SourcePubkey != nil {
-		l = m.SourcePubkey.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *StartCloseMsg) GetProof() *BalanceProof {
+	if m != nil {
+		return m.Proof
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *StartCloseMsg) GetSignatureA() *crypto.Signature {
+	if m != nil {
+		return m.SignatureA
 	}
-	if m.Total != nil {
-		l = m.Total.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *StartCloseMsg) GetSignatureB() *crypto.Signature {
+	if m != nil {
+		return m.SignatureB
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovCodec(uint64(m.Timeout))
+	return nil
+}
+
+// ChallengeMsg replaces the balances of a closing bidirectional channel with
+// a balance proof of a higher nonce. It can be submitted by either party
+// during the dispute period.
+type ChallengeMsg struct {
+	Metadata   *weave.Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Proof      *BalanceProof     `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	SignatureA *crypto.Signature `protobuf:"bytes,3,opt,name=signature_a,json=signatureA,proto3" json:"signature_a,omitempty"`
+	SignatureB *crypto.Signature `protobuf:"bytes,4,opt,name=signature_b,json=signatureB,proto3" json:"signature_b,omitempty"`
+}
+
+func (m *ChallengeMsg) Reset()         { *m = ChallengeMsg{} }
+func (m *ChallengeMsg) String() string { return proto.CompactTextString(m) }
+func (*ChallengeMsg) ProtoMessage()    {}
+func (*ChallengeMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daf7b5492d84b22a, []int{10}
+}
+func (m *ChallengeMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChallengeMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChallengeMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+}
+func (m *ChallengeMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeMsg.Merge(m, src)
+}
+func (m *ChallengeMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChallengeMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeMsg proto.InternalMessageInfo
+
+func (m *ChallengeMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return n
+	return nil
 }
 
-func (m *Payment) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChallengeMsg) GetProof() *BalanceProof {
+	if m != nil {
+		return m.Proof
 	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *ChallengeMsg) GetSignatureA() *crypto.Signature {
+	if m != nil {
+		return m.SignatureA
 	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *ChallengeMsg) GetSignatureB() *crypto.Signature {
+	if m != nil {
+		return m.SignatureB
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+// SettleMsg pays out the balances of a closing bidirectional channel and
+// deletes it. It can be executed only after the dispute period is over. It is
+// scheduled for execution when the close is started.
+type SettleMsg struct {
+	Metadata  *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ChannelID []byte          `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *SettleMsg) Reset()         { *m = SettleMsg{} }
+func (m *SettleMsg) String() string { return proto.CompactTextString(m) }
+func (*SettleMsg) ProtoMessage()    {}
+func (*SettleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daf7b5492d84b22a, []int{11}
+}
+func (m *SettleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+}
+func (m *SettleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleMsg.Merge(m, src)
+}
+func (m *SettleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleMsg proto.InternalMessageInfo
+
+func (m *SettleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return n
+	return nil
 }
 
-func (m *TransferMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SettleMsg) GetChannelID() []byte {
+	if m != nil {
+		return m.ChannelID
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PaymentChannel)(nil), "paychan.PaymentChannel")
+	proto.RegisterType((*CreateMsg)(nil), "paychan.CreateMsg")
+	proto.RegisterType((*Payment)(nil), "paychan.Payment")
+	proto.RegisterType((*TransferMsg)(nil), "paychan.TransferMsg")
+	proto.RegisterType((*CloseMsg)(nil), "paychan.CloseMsg")
+	proto.RegisterType((*BidirectionalChannel)(nil), "paychan.BidirectionalChannel")
+	proto.RegisterType((*CreateBidirectionalMsg)(nil), "paychan.CreateBidirectionalMsg")
+	proto.RegisterType((*BalanceProof)(nil), "paychan.BalanceProof")
+	proto.RegisterType((*CooperativeCloseMsg)(nil), "paychan.CooperativeCloseMsg")
+	proto.RegisterType((*StartCloseMsg)(nil), "paychan.StartCloseMsg")
+	proto.RegisterType((*ChallengeMsg)(nil), "paychan.ChallengeMsg")
+	proto.RegisterType((*SettleMsg)(nil), "paychan.SettleMsg")
+}
+
+func init() { proto.RegisterFile("x/paychan/codec.proto", fileDescriptor_daf7b5492d84b22a) }
+
+var fileDescriptor_daf7b5492d84b22a = []byte{
+	// 913 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0xa3, 0x1f, 0x8a, 0x23, 0xc9, 0x49, 0x37, 0x4e, 0xb1, 0xf0, 0x41, 0x52, 0xd5, 0xa6,
+	0x55, 0x9b, 0x94, 0x02, 0x5c, 0xa0, 0xbd, 0xf4, 0x4f, 0x94, 0x51, 0x40, 0x28, 0x02, 0x08, 0xb4,
+	0x7b, 0x16, 0x96, 0xe4, 0x5a, 0x5a, 0x98, 0xda, 0x25, 0xc8, 0x95, 0x1b, 0xbd, 0x45, 0x6f, 0x7d,
+	0xa5, 0x1e, 0x7d, 0x6b, 0x4f, 0x42, 0x20, 0x9f, 0xf3, 0x02, 0xbe, 0xb4, 0x20, 0xb9, 0x62, 0xe8,
+	0x1a, 0x72, 0x42, 0x03, 0x2e, 0xd0, 0xc0, 0xb7, 0xd5, 0xce, 0x37, 0x9c, 0x9d, 0x6f, 0xe6, 0x9b,
+	0x81, 0xe0, 0xc9, 0xcb, 0x7e, 0x40, 0x96, 0xee, 0x8c, 0xf0, 0xbe, 0x2b, 0x3c, 0xea, 0x9a, 0x41,
+	0x28, 0xa4, 0x40, 0xba, 0xba, 0xdc, 0xaf, 0xe7, 0x6e, 0xf7, 0x1f, 0xb9, 0x82, 0x5d, 0xc1, 0xed,
+	0x3f, 0x76, 0xc3, 0x65, 0x20, 0x45, 0x7f, 0x2e, 0x3c, 0xea, 0x47, 0xea, 0x72, 0x6f, 0x2a, 0xa6,
+	0x22, 0x39, 0xf6, 0xe3, 0x53, 0x7a, 0xdb, 0x7d, 0x55, 0x82, 0xdd, 0x31, 0x59, 0xce, 0x29, 0x97,
+	0xc3, 0x19, 0xe1, 0x9c, 0xfa, 0xe8, 0x19, 0xd4, 0xe6, 0x54, 0x12, 0x8f, 0x48, 0x82, 0xb5, 0x8e,
+	0xd6, 0xab, 0x1f, 0x3c, 0x34, 0x7f, 0xa5, 0xe4, 0x8c, 0x9a, 0x2f, 0xd4, 0xb5, 0x9d, 0x01, 0xd0,
+	0xb7, 0x50, 0x8d, 0xc4, 0x22, 0x74, 0x29, 0x7e, 0xd0, 0xd1, 0x7a, 0x0d, 0xeb, 0x93, 0xcb, 0x55,
+	0xbb, 0x33, 0x65, 0x72, 0xb6, 0x70, 0x4c, 0x57, 0xcc, 0xfb, 0x4c, 0x9c, 0x7d, 0x29, 0x38, 0xed,
+	0xa7, 0x1f, 0x18, 0x78, 0x5e, 0x48, 0xa3, 0xc8, 0x56, 0x3e, 0xe8, 0x6b, 0x68, 0xa6, 0xa7, 0x49,
+	0xb0, 0x70, 0x4e, 0xe9, 0x12, 0x97, 0x92, 0x78, 0x1f, 0x98, 0x69, 0x02, 0xe6, 0x78, 0xe1, 0xf8,
+	0xcc, 0xfd, 0x99, 0x2e, 0xed, 0x46, 0x8a, 0x1b, 0x27, 0x30, 0xf4, 0x13, 0xd4, 0x3d, 0x1a, 0x49,
+	0xc6, 0x89, 0x64, 0x82, 0xe3, 0x72, 0x81, 0xd0, 0x79, 0x47, 0xd4, 0x81, 0x8a, 0x14, 0x92, 0xf8,
+	0xb8, 0x92, 0xc4, 0x05, 0x33, 0xa6, 0xd2, 0x1c, 0x0a, 0xc6, 0xed, 0xd4, 0x80, 0x7e, 0x00, 0x5d,
+	0xb2, 0x39, 0x15, 0x0b, 0x89, 0xab, 0x1d, 0xad, 0x57, 0xb2, 0x9e, 0x5e, 0xae, 0xda, 0x1f, 0x6d,
+	0x8d, 0xf2, 0x0b, 0x67, 0x2f, 0x8f, 0xd9, 0x9c, 0xda, 0x1b, 0x2f, 0x84, 0xa0, 0x3c, 0xa7, 0x73,
+	0x81, 0xf5, 0x8e, 0xd6, 0x33, 0xec, 0xe4, 0x8c, 0x9e, 0x43, 0x5d, 0x86, 0x84, 0x47, 0x27, 0x34,
+	0x0c, 0xa9, 0x87, 0x6b, 0xd7, 0x82, 0xe7, 0xcd, 0xe8, 0x7b, 0xd0, 0x49, 0xfa, 0x78, 0x6c, 0x14,
+	0x48, 0x74, 0xe3, 0xd4, 0x7d, 0xfd, 0x00, 0x8c, 0x61, 0x48, 0x89, 0xa4, 0x2f, 0xa2, 0xe9, 0x7d,
+	0x75, 0xef, 0xba, 0xba, 0xdd, 0xdf, 0x35, 0xd0, 0x95, 0xa4, 0xd0, 0xa7, 0x50, 0x73, 0x67, 0x84,
+	0xf1, 0x09, 0xf3, 0x12, 0xb6, 0x0d, 0xab, 0xbe, 0x5e, 0xb5, 0xf5, 0x61, 0x7c, 0x37, 0x3a, 0xb4,
+	0xf5, 0xc4, 0x38, 0xf2, 0xd0, 0x73, 0x00, 0x37, 0x95, 0x5f, 0x8c, 0x4c, 0xc9, 0x6e, 0xae, 0x57,
+	0x6d, 0x43, 0x89, 0x72, 0x74, 0x68, 0x1b, 0x0a, 0x30, 0xf2, 0x50, 0x17, 0xaa, 0x64, 0x2e, 0x16,
+	0x5c, 0xe2, 0xd2, 0xb5, 0xcc, 0x94, 0x25, 0x7b, 0x59, 0xf9, 0xea, 0xcb, 0xea, 0xc7, 0xaa, 0xb3,
+	0x0a, 0xf7, 0xc2, 0x17, 0xa0, 0x07, 0x69, 0x56, 0xc9, 0xfb, 0xea, 0x07, 0x8f, 0x4c, 0x35, 0x8e,
+	0x4c, 0x95, 0xad, 0xbd, 0x01, 0xa0, 0x3e, 0x18, 0x11, 0x9b, 0x72, 0x22, 0x17, 0x21, 0xfd, 0x77,
+	0xd5, 0x8f, 0x36, 0x06, 0xfb, 0x0d, 0xa6, 0xbb, 0x84, 0xda, 0xd0, 0x17, 0x51, 0xf1, 0x0e, 0x2d,
+	0x46, 0xdc, 0x86, 0x94, 0x52, 0x8e, 0x94, 0xcb, 0x0a, 0xec, 0x59, 0xcc, 0x63, 0x21, 0x75, 0xe3,
+	0xae, 0x21, 0xfe, 0xad, 0xe6, 0xe0, 0x77, 0x31, 0x3b, 0xa1, 0x5c, 0x4e, 0x48, 0x31, 0xa9, 0x24,
+	0x4e, 0x03, 0xf4, 0x0d, 0xec, 0x2a, 0xf7, 0xb7, 0x6b, 0x25, 0x75, 0x51, 0x5a, 0xc9, 0xe2, 0x3a,
+	0xb8, 0x5c, 0x38, 0xae, 0xf5, 0x26, 0xae, 0xb3, 0x89, 0x5b, 0xb9, 0x39, 0xae, 0xa5, 0xe2, 0x7e,
+	0x06, 0x86, 0x47, 0x03, 0x11, 0x31, 0x39, 0x21, 0xb8, 0x7a, 0xad, 0x0b, 0x6b, 0xca, 0x38, 0xc8,
+	0x03, 0x1d, 0xac, 0x6f, 0x05, 0x5a, 0x68, 0x0c, 0xbb, 0x1e, 0x8b, 0x82, 0x85, 0xa4, 0x93, 0x80,
+	0x86, 0x4c, 0xa4, 0x73, 0xb1, 0x64, 0x7d, 0x7e, 0xb9, 0x6a, 0x3f, 0xbd, 0x51, 0x92, 0x87, 0x8b,
+	0x30, 0x11, 0xbc, 0xdd, 0x54, 0x1f, 0x18, 0x27, 0xfe, 0x59, 0xb5, 0x8d, 0xdc, 0xe8, 0xcd, 0x0d,
+	0x53, 0xb8, 0xc5, 0x30, 0x45, 0x7b, 0x50, 0xe1, 0x82, 0xbb, 0x14, 0xd7, 0x3b, 0x5a, 0xaf, 0x6c,
+	0xa7, 0x3f, 0xe2, 0x24, 0x1d, 0xe2, 0x13, 0xee, 0xd2, 0x09, 0xc1, 0x8d, 0xeb, 0x49, 0x2a, 0xe3,
+	0x20, 0x0f, 0x74, 0x70, 0x73, 0x2b, 0xd0, 0x42, 0x3f, 0x42, 0xcd, 0x8d, 0x05, 0x31, 0x21, 0x12,
+	0xef, 0x16, 0x1a, 0x4d, 0x89, 0xdb, 0x40, 0xa2, 0x8f, 0x41, 0x97, 0x24, 0x3a, 0x8d, 0x65, 0xf1,
+	0x30, 0xc9, 0x14, 0xd6, 0xab, 0x76, 0xf5, 0x98, 0x44, 0xa7, 0xa3, 0x43, 0xbb, 0x1a, 0x9b, 0x46,
+	0x5e, 0xf7, 0x75, 0x09, 0x3e, 0x4c, 0x77, 0xc3, 0x15, 0x09, 0x14, 0x96, 0xe1, 0x7d, 0xfb, 0xff,
+	0x2f, 0xda, 0xbf, 0xbb, 0xd6, 0xa0, 0x61, 0xa5, 0x3d, 0x36, 0x0e, 0x85, 0x38, 0xb9, 0xa3, 0x05,
+	0x95, 0xa9, 0xa4, 0xb4, 0x55, 0x25, 0xe5, 0x77, 0x55, 0x49, 0xe5, 0x06, 0x95, 0xec, 0x41, 0xe5,
+	0x84, 0x71, 0xe2, 0x27, 0x25, 0xa8, 0xd9, 0xe9, 0x8f, 0xee, 0x9f, 0x1a, 0x3c, 0x1e, 0x0a, 0x11,
+	0xd0, 0x98, 0x97, 0x33, 0x7a, 0xbb, 0xc5, 0xf2, 0x0c, 0x2a, 0x41, 0xcc, 0x90, 0x5a, 0x76, 0x4f,
+	0xb2, 0x65, 0x97, 0xa7, 0xcf, 0x4e, 0x31, 0xe8, 0x00, 0xea, 0xd9, 0x2e, 0x9b, 0x90, 0xed, 0x1b,
+	0x0f, 0x32, 0xd4, 0xe0, 0xaa, 0x8f, 0x83, 0xcb, 0x6f, 0xf7, 0xb1, 0xba, 0x7f, 0x6b, 0xd0, 0x3c,
+	0x92, 0x24, 0x94, 0xff, 0xc5, 0xb2, 0xcc, 0x18, 0x28, 0x15, 0x67, 0xa0, 0x7c, 0x0b, 0x06, 0x2a,
+	0xef, 0xc2, 0xc0, 0xb9, 0x06, 0x8d, 0xe1, 0x8c, 0xf8, 0x3e, 0xe5, 0xd3, 0xf7, 0xa4, 0xa8, 0x27,
+	0x60, 0x1c, 0x51, 0x29, 0xfd, 0x3b, 0xae, 0xa7, 0x85, 0xff, 0x58, 0xb7, 0xb4, 0xf3, 0x75, 0x4b,
+	0x7b, 0xb5, 0x6e, 0x69, 0xbf, 0x5d, 0xb4, 0x76, 0xce, 0x2f, 0x5a, 0x3b, 0x7f, 0x5d, 0xb4, 0x76,
+	0x9c, 0x6a, 0xf2, 0x5f, 0xf0, 0xab, 0x7f, 0x06, 0x00, 0xe4, 0x79, 0x79, 0x46, 0x77, 0x0e, 0x00,
+	0x00,
+}
+
+func (m *PaymentChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentChannel) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
 	}
-	if m.Payment != nil {
-		l = m.Payment.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
 	}
-	if m.Signature != nil {
-		l = m.Signature.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if m.SourcePubkey != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SourcePubkey.Size()))
+		n2, err := m.SourcePubkey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
 	}
-	return n
-}
-
-func (m *CloseMsg) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if m.Total != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Total.Size()))
+		n3, err := m.Total.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
 	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if m.Timeout != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Timeout))
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
 	}
-	return n
-}
-
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+	if m.Transferred != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Transferred.Size()))
+		n4, err := m.Transferred.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n4
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
+func (m *CreateMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if m.SourcePubkey != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SourcePubkey.Size()))
+		n6, err := m.SourcePubkey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
+	}
+	if m.Total != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Total.Size()))
+		n7, err := m.Total.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Timeout))
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	return i, nil
+}
+
+func (m *Payment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Payment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChainID)))
+		i += copy(dAtA[i:], m.ChainID)
+	}
+	if len(m.ChannelID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChannelID)))
+		i += copy(dAtA[i:], m.ChannelID)
+	}
+	if m.Amount != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+		n8, err := m.Amount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	return i, nil
+}
+
+func (m *TransferMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Payment != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Payment.Size()))
+		n10, err := m.Payment.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.Signature != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Signature.Size()))
+		n11, err := m.Signature.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+
+func (m *CloseMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CloseMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n12, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if len(m.ChannelID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChannelID)))
+		i += copy(dAtA[i:], m.ChannelID)
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	return i, nil
+}
+
+func (m *BidirectionalChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidirectionalChannel) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n13, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if len(m.PartyA) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PartyA)))
+		i += copy(dAtA[i:], m.PartyA)
+	}
+	if m.PartyAPubkey != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PartyAPubkey.Size()))
+		n14, err := m.PartyAPubkey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if len(m.PartyB) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PartyB)))
+		i += copy(dAtA[i:], m.PartyB)
+	}
+	if m.PartyBPubkey != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PartyBPubkey.Size()))
+		n15, err := m.PartyBPubkey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.DepositA != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DepositA.Size()))
+		n16, err := m.DepositA.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.DepositB != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DepositB.Size()))
+		n17, err := m.DepositB.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.DisputePeriod != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DisputePeriod))
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.Nonce != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Nonce))
+	}
+	if m.BalanceA != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BalanceA.Size()))
+		n18, err := m.BalanceA.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.BalanceB != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BalanceB.Size()))
+		n19, err := m.BalanceB.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if m.CloseAt != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CloseAt))
+	}
+	if len(m.TaskID) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TaskID)))
+		i += copy(dAtA[i:], m.TaskID)
+	}
+	return i, nil
+}
+
+func (m *CreateBidirectionalMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateBidirectionalMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n20, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if len(m.PartyA) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PartyA)))
+		i += copy(dAtA[i:], m.PartyA)
+	}
+	if m.PartyAPubkey != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PartyAPubkey.Size()))
+		n21, err := m.PartyAPubkey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if len(m.PartyB) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PartyB)))
+		i += copy(dAtA[i:], m.PartyB)
+	}
+	if m.PartyBPubkey != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PartyBPubkey.Size()))
+		n22, err := m.PartyBPubkey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if m.DepositA != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DepositA.Size()))
+		n23, err := m.DepositA.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if m.DepositB != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DepositB.Size()))
+		n24, err := m.DepositB.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.DisputePeriod != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DisputePeriod))
+	}
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
+	}
+	return i, nil
+}
+
+func (m *BalanceProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceProof) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChainID)))
+		i += copy(dAtA[i:], m.ChainID)
+	}
+	if len(m.ChannelID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChannelID)))
+		i += copy(dAtA[i:], m.ChannelID)
+	}
+	if m.Nonce != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Nonce))
+	}
+	if m.BalanceA != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BalanceA.Size()))
+		n25, err := m.BalanceA.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if m.BalanceB != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BalanceB.Size()))
+		n26, err := m.BalanceB.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if m.Final {
+		dAtA[i] = 0x30
+		i++
+		if m.Final {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *CooperativeCloseMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CooperativeCloseMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n27, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if m.Proof != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Proof.Size()))
+		n28, err := m.Proof.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.SignatureA != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SignatureA.Size()))
+		n29, err := m.SignatureA.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if m.SignatureB != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SignatureB.Size()))
+		n30, err := m.SignatureB.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	return i, nil
+}
+
+func (m *StartCloseMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartCloseMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n31, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	if len(m.ChannelID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChannelID)))
+		i += copy(dAtA[i:], m.ChannelID)
+	}
+	if m.Proof != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Proof.Size()))
+		n32, err := m.Proof.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	if m.SignatureA != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SignatureA.Size()))
+		n33, err := m.SignatureA.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if m.SignatureB != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SignatureB.Size()))
+		n34, err := m.SignatureB.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	return i, nil
+}
+
+func (m *ChallengeMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChallengeMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n35, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	if m.Proof != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Proof.Size()))
+		n36, err := m.Proof.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if m.SignatureA != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SignatureA.Size()))
+		n37, err := m.SignatureA.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	if m.SignatureB != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SignatureB.Size()))
+		n38, err := m.SignatureB.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	return i, nil
+}
+
+func (m *SettleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n39, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if len(m.ChannelID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChannelID)))
+		i += copy(dAtA[i:], m.ChannelID)
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *PaymentChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.SourcePubkey != nil {
+		l = m.SourcePubkey.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Total != nil {
+		l = m.Total.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovCodec(uint64(m.Timeout))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Transferred != nil {
+		l = m.Transferred.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.SourcePubkey != nil {
+		l = m.SourcePubkey.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Total != nil {
+		l = m.Total.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovCodec(uint64(m.Timeout))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Payment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Payment != nil {
+		l = m.Payment.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Signature != nil {
+		l = m.Signature.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CloseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *BidirectionalChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PartyA)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.PartyAPubkey != nil {
+		l = m.PartyAPubkey.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PartyB)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.PartyBPubkey != nil {
+		l = m.PartyBPubkey.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DepositA != nil {
+		l = m.DepositA.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DepositB != nil {
+		l = m.DepositB.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DisputePeriod != 0 {
+		n += 1 + sovCodec(uint64(m.DisputePeriod))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovCodec(uint64(m.Nonce))
+	}
+	if m.BalanceA != nil {
+		l = m.BalanceA.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.BalanceB != nil {
+		l = m.BalanceB.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.CloseAt != 0 {
+		n += 1 + sovCodec(uint64(m.CloseAt))
+	}
+	l = len(m.TaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateBidirectionalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PartyA)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.PartyAPubkey != nil {
+		l = m.PartyAPubkey.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PartyB)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.PartyBPubkey != nil {
+		l = m.PartyBPubkey.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DepositA != nil {
+		l = m.DepositA.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DepositB != nil {
+		l = m.DepositB.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DisputePeriod != 0 {
+		n += 1 + sovCodec(uint64(m.DisputePeriod))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *BalanceProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovCodec(uint64(m.Nonce))
+	}
+	if m.BalanceA != nil {
+		l = m.BalanceA.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.BalanceB != nil {
+		l = m.BalanceB.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Final {
+		n += 2
+	}
+	return n
+}
+
+func (m *CooperativeCloseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.SignatureA != nil {
+		l = m.SignatureA.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.SignatureB != nil {
+		l = m.SignatureB.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *StartCloseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.SignatureA != nil {
+		l = m.SignatureA.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.SignatureB != nil {
+		l = m.SignatureB.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ChallengeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.SignatureA != nil {
+		l = m.SignatureA.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.SignatureB != nil {
+		l = m.SignatureB.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *SettleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SourcePubkey == nil {
+				m.SourcePubkey = &crypto.PublicKey{}
+			}
+			if err := m.SourcePubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Total == nil {
+				m.Total = &coin.Coin{}
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferred", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transferred == nil {
+				m.Transferred = &coin.Coin{}
+			}
+			if err := m.Transferred.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SourcePubkey == nil {
+				m.SourcePubkey = &crypto.PublicKey{}
+			}
+			if err := m.SourcePubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Total == nil {
+				m.Total = &coin.Coin{}
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Payment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Payment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Payment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = append(m.ChannelID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChannelID == nil {
+				m.ChannelID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &coin.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payment == nil {
+				m.Payment = &Payment{}
+			}
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signature == nil {
+				m.Signature = &crypto.Signature{}
+			}
+			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = append(m.ChannelID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChannelID == nil {
+				m.ChannelID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidirectionalChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidirectionalChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidirectionalChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyA = append(m.PartyA[:0], dAtA[iNdEx:postIndex]...)
+			if m.PartyA == nil {
+				m.PartyA = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyAPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartyAPubkey == nil {
+				m.PartyAPubkey = &crypto.PublicKey{}
+			}
+			if err := m.PartyAPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyB = append(m.PartyB[:0], dAtA[iNdEx:postIndex]...)
+			if m.PartyB == nil {
+				m.PartyB = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyBPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartyBPubkey == nil {
+				m.PartyBPubkey = &crypto.PublicKey{}
+			}
+			if err := m.PartyBPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositA == nil {
+				m.DepositA = &coin.Coin{}
+			}
+			if err := m.DepositA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositB == nil {
+				m.DepositB = &coin.Coin{}
+			}
+			if err := m.DepositB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriod", wireType)
+			}
+			m.DisputePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriod |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BalanceA == nil {
+				m.BalanceA = &coin.Coin{}
+			}
+			if err := m.BalanceA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BalanceB == nil {
+				m.BalanceB = &coin.Coin{}
+			}
+			if err := m.BalanceB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseAt", wireType)
+			}
+			m.CloseAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskID = append(m.TaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskID == nil {
+				m.TaskID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateBidirectionalMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -978,28 +4078,98 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowCodec
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateBidirectionalMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateBidirectionalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PartyA = append(m.PartyA[:0], dAtA[iNdEx:postIndex]...)
+			if m.PartyA == nil {
+				m.PartyA = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PaymentChannel: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PaymentChannel: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyAPubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1026,16 +4196,16 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &weave.Metadata{}
+			if m.PartyAPubkey == nil {
+				m.PartyAPubkey = &crypto.PublicKey{}
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PartyAPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyB", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1062,14 +4232,14 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
-			if m.Source == nil {
-				m.Source = []byte{}
+			m.PartyB = append(m.PartyB[:0], dAtA[iNdEx:postIndex]...)
+			if m.PartyB == nil {
+				m.PartyB = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyBPubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1096,18 +4266,18 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SourcePubkey == nil {
-				m.SourcePubkey = &crypto.PublicKey{}
+			if m.PartyBPubkey == nil {
+				m.PartyBPubkey = &crypto.PublicKey{}
 			}
-			if err := m.SourcePubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PartyBPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositA", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1117,29 +4287,31 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
-			if m.Destination == nil {
-				m.Destination = []byte{}
+			if m.DepositA == nil {
+				m.DepositA = &coin.Coin{}
+			}
+			if err := m.DepositA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositB", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1166,18 +4338,18 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Total == nil {
-				m.Total = &coin.Coin{}
+			if m.DepositB == nil {
+				m.DepositB = &coin.Coin{}
 			}
-			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DepositB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriod", wireType)
 			}
-			m.Timeout = 0
+			m.DisputePeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1187,12 +4359,12 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				m.DisputePeriod |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
@@ -1224,9 +4396,147 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transferred", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = append(m.ChannelID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChannelID == nil {
+				m.ChannelID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceA", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1253,18 +4563,18 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transferred == nil {
-				m.Transferred = &coin.Coin{}
+			if m.BalanceA == nil {
+				m.BalanceA = &coin.Coin{}
 			}
-			if err := m.Transferred.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BalanceA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceB", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1274,26 +4584,48 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			if m.BalanceB == nil {
+				m.BalanceB = &coin.Coin{}
+			}
+			if err := m.BalanceB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Final", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Final = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1318,7 +4650,7 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateMsg) Unmarshal(dAtA []byte) error {
+func (m *CooperativeCloseMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1341,10 +4673,10 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: CooperativeCloseMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CooperativeCloseMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1385,9 +4717,9 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1397,29 +4729,31 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
-			if m.Source == nil {
-				m.Source = []byte{}
+			if m.Proof == nil {
+				m.Proof = &BalanceProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureA", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1446,50 +4780,16 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SourcePubkey == nil {
-				m.SourcePubkey = &crypto.PublicKey{}
+			if m.SignatureA == nil {
+				m.SignatureA = &crypto.Signature{}
 			}
-			if err := m.SourcePubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SignatureA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
-			if m.Destination == nil {
-				m.Destination = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureB", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1516,64 +4816,13 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Total == nil {
-				m.Total = &coin.Coin{}
+			if m.SignatureB == nil {
+				m.SignatureB = &crypto.Signature{}
 			}
-			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SignatureB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			m.Timeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1598,7 +4847,7 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Payment) Unmarshal(dAtA []byte) error {
+func (m *StartCloseMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1621,17 +4870,17 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Payment: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartCloseMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Payment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartCloseMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1641,23 +4890,27 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1695,7 +4948,7 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1722,18 +4975,18 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Amount == nil {
-				m.Amount = &coin.Coin{}
+			if m.Proof == nil {
+				m.Proof = &BalanceProof{}
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureA", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1743,23 +4996,63 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			if m.SignatureA == nil {
+				m.SignatureA = &crypto.Signature{}
+			}
+			if err := m.SignatureA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignatureB == nil {
+				m.SignatureB = &crypto.Signature{}
+			}
+			if err := m.SignatureB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1785,7 +5078,7 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferMsg) Unmarshal(dAtA []byte) error {
+func (m *ChallengeMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1808,10 +5101,10 @@ func (m *TransferMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChallengeMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChallengeMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1852,7 +5145,7 @@ func (m *TransferMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1879,16 +5172,16 @@ func (m *TransferMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Payment == nil {
-				m.Payment = &Payment{}
+			if m.Proof == nil {
+				m.Proof = &BalanceProof{}
 			}
-			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureA", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1915,10 +5208,46 @@ func (m *TransferMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signature == nil {
-				m.Signature = &crypto.Signature{}
+			if m.SignatureA == nil {
+				m.SignatureA = &crypto.Signature{}
 			}
-			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SignatureA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignatureB == nil {
+				m.SignatureB = &crypto.Signature{}
+			}
+			if err := m.SignatureB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1946,7 +5275,7 @@ func (m *TransferMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseMsg) Unmarshal(dAtA []byte) error {
+func (m *SettleMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1969,10 +5298,10 @@ func (m *CloseMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: SettleMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SettleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2045,38 +5374,6 @@ func (m *CloseMsg) Unmarshal(dAtA []byte) error {
 				m.ChannelID = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
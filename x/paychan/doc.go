@@ -14,5 +14,17 @@ are made off the chain and therefore are very fast and cheap to execute.
 Payment channel can be closed only by the destination when claiming received
 funds or by the payment channel owner after the deadline was reached.
 
+Bidirectional channel allows two parties to send payments to each other. Both
+parties deposit funds when the channel is created. Payments are made by
+exchanging balance proofs signed by both parties. Each balance proof declares
+how the deposits are split and must use a nonce greater than the previous one.
+
+Bidirectional channel can be closed cooperatively using a final balance proof,
+which pays out the balances immediately. Either party can also start an
+unilateral close with the latest balance proof it holds. This starts a dispute
+period during which the other party can challenge the close with a balance
+proof of a higher nonce. Once the dispute period is over, the channel is
+settled by the cron using the balances of the latest balance proof.
+
 */
 package paychan
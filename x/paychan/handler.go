@@ -3,6 +3,7 @@ package paychan
 import (
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
@@ -15,13 +16,18 @@ const (
 	transferPaymentChannelCost int64 = 5
 )
 
-// RegisterQuery registers payment channel bucket under /paychans.
+// RegisterQuery registers payment channel bucket under /paychans and
+// bidirectional channel bucket under /bichannels.
 func RegisterQuery(qr weave.QueryRouter) {
 	NewPaymentChannelBucket().Register("paychans", qr)
+	NewBidirectionalChannelBucket().Register("bichannels", qr)
 }
 
 // RegisterRouters registers payment channel message handelers in given registry.
-func RegisterRoutes(r weave.Registry, auth x.Authenticator, cash cash.Controller) {
+//
+// Scheduler is used to settle a bidirectional channel once the dispute period
+// is over.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, cash cash.Controller, scheduler weave.Scheduler) {
 	r = migration.SchemaMigratingRegistry("paychan", r)
 
 	bucket := NewPaymentChannelBucket()
@@ -31,6 +37,26 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, cash cash.Controller
 		&transferPaymentChannelHandler{auth: auth, bucket: bucket, cash: cash})
 	r.Handle(&CloseMsg{},
 		&closePaymentChannelHandler{auth: auth, bucket: bucket, cash: cash})
+
+	bibucket := NewBidirectionalChannelBucket()
+	r.Handle(&CreateBidirectionalMsg{},
+		&createBidirectionalHandler{auth: auth, bucket: bibucket, cash: cash})
+	r.Handle(&CooperativeCloseMsg{},
+		&cooperativeCloseHandler{bucket: bibucket, cash: cash, scheduler: scheduler})
+	r.Handle(&StartCloseMsg{},
+		&startCloseHandler{auth: auth, bucket: bibucket, scheduler: scheduler})
+	r.Handle(&ChallengeMsg{},
+		&challengeHandler{auth: auth, bucket: bibucket})
+	r.Handle(&SettleMsg{},
+		&settleHandler{bucket: bibucket, cash: cash, scheduler: scheduler})
+}
+
+// RegisterCronRoutes registers the handler of a bidirectional channel
+// settlement that is scheduled when a close is started.
+func RegisterCronRoutes(r weave.Registry, cash cash.Controller, scheduler weave.Scheduler) {
+	r = migration.SchemaMigratingRegistry("paychan", r)
+	r.Handle(&SettleMsg{},
+		&settleHandler{bucket: NewBidirectionalChannelBucket(), cash: cash, scheduler: scheduler})
 }
 
 type createPaymentChannelHandler struct {
@@ -266,3 +292,361 @@ func (h *closePaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStore
 	}
 	return &weave.DeliverResult{}, nil
 }
+
+type createBidirectionalHandler struct {
+	auth   x.Authenticator
+	bucket orm.ModelBucket
+	cash   cash.Controller
+}
+
+var _ weave.Handler = (*createBidirectionalHandler)(nil)
+
+func (h *createBidirectionalHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: createPaymentChannelCost}, nil
+}
+
+func (h *createBidirectionalHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*CreateBidirectionalMsg, error) {
+	var msg CreateBidirectionalMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	// Each party that allocates funds must agree to it.
+	if msg.DepositA.IsPositive() && !h.auth.HasAddress(ctx, msg.PartyA) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "party A signature required")
+	}
+	if msg.DepositB.IsPositive() && !h.auth.HasAddress(ctx, msg.PartyB) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "party B signature required")
+	}
+	return &msg, nil
+}
+
+func (h *createBidirectionalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Explicitly acquire the ID as we need it before saving to compute
+	// the entity address.
+	key, err := bidirectionalChannelSeq.NextVal(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot acquire sequence ID")
+	}
+
+	ch := &BidirectionalChannel{
+		Metadata:      &weave.Metadata{},
+		PartyA:        msg.PartyA,
+		PartyAPubkey:  msg.PartyAPubkey,
+		PartyB:        msg.PartyB,
+		PartyBPubkey:  msg.PartyBPubkey,
+		DepositA:      msg.DepositA,
+		DepositB:      msg.DepositB,
+		DisputePeriod: msg.DisputePeriod,
+		Memo:          msg.Memo,
+		Address:       bidirectionalChannelAccount(key),
+	}
+	if _, err := h.bucket.Put(db, key, ch); err != nil {
+		return nil, errors.Wrap(err, "cannot create a bidirectional channel")
+	}
+
+	if err := moveNonZero(h.cash, db, msg.PartyA, ch.Address, *msg.DepositA); err != nil {
+		return nil, errors.Wrap(err, "cannot move party A deposit")
+	}
+	if err := moveNonZero(h.cash, db, msg.PartyB, ch.Address, *msg.DepositB); err != nil {
+		return nil, errors.Wrap(err, "cannot move party B deposit")
+	}
+	return &weave.DeliverResult{Data: key}, nil
+}
+
+// bidirectionalChannelAccount returns an account address for a bidirectional
+// channel with given ID. Deposits of both parties are held on this account
+// until the channel is settled.
+func bidirectionalChannelAccount(channelID []byte) weave.Address {
+	return bidirectionalChannelCondition(channelID).Address()
+}
+
+// bidirectionalChannelCondition returns the condition of a bidirectional
+// channel with given ID. It authorizes the scheduled settlement.
+func bidirectionalChannelCondition(channelID []byte) weave.Condition {
+	return weave.NewCondition("paychan", "bichan", channelID)
+}
+
+// moveNonZero moves given amount unless it is zero. Zero deposits and
+// balances are valid for a bidirectional channel but cannot be transferred.
+func moveNonZero(ctrl cash.Controller, db weave.KVStore, src, dst weave.Address, amount coin.Coin) error {
+	if amount.IsZero() {
+		return nil
+	}
+	return ctrl.MoveCoins(db, src, dst, amount)
+}
+
+// verifyProof returns an error if given balance proof is not signed by both
+// parties of the channel or does not split the channel deposit.
+func verifyProof(ctx weave.Context, ch *BidirectionalChannel, p *BalanceProof, sigA, sigB *crypto.Signature) error {
+	if weave.GetChainID(ctx) != p.ChainID {
+		return errors.Wrap(errors.ErrMsg, "invalid chain ID")
+	}
+	raw, err := p.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "cannot serialize balance proof")
+	}
+	if !ch.PartyAPubkey.Verify(raw, sigA) {
+		return errors.Wrap(errors.ErrMsg, "invalid party A signature")
+	}
+	if !ch.PartyBPubkey.Verify(raw, sigB) {
+		return errors.Wrap(errors.ErrMsg, "invalid party B signature")
+	}
+	if err := ch.validateBalances(p.BalanceA, p.BalanceB); err != nil {
+		return errors.Wrap(err, "balance proof")
+	}
+	return nil
+}
+
+// isParty returns true if the transaction is signed by any of the channel
+// parties.
+func isParty(ctx weave.Context, auth x.Authenticator, ch *BidirectionalChannel) bool {
+	return auth.HasAddress(ctx, ch.PartyA) || auth.HasAddress(ctx, ch.PartyB)
+}
+
+// settle pays out the balances of the channel and deletes it together with
+// its scheduled settlement task. It is safe to call it from within the
+// scheduled task.
+func settle(db weave.KVStore, bucket orm.ModelBucket, ctrl cash.Controller, scheduler weave.Scheduler, key []byte, ch *BidirectionalChannel, balanceA, balanceB coin.Coin) error {
+	if err := moveNonZero(ctrl, db, ch.Address, ch.PartyA, balanceA); err != nil {
+		return errors.Wrap(err, "cannot pay party A")
+	}
+	if err := moveNonZero(ctrl, db, ch.Address, ch.PartyB, balanceB); err != nil {
+		return errors.Wrap(err, "cannot pay party B")
+	}
+	if len(ch.TaskID) != 0 {
+		if err := scheduler.Delete(db, ch.TaskID); err != nil && !errors.ErrNotFound.Is(err) {
+			return errors.Wrap(err, "cannot delete settle task")
+		}
+	}
+	if err := bucket.Delete(db, key); err != nil {
+		return errors.Wrap(err, "cannot delete channel")
+	}
+	return nil
+}
+
+type cooperativeCloseHandler struct {
+	bucket    orm.ModelBucket
+	cash      cash.Controller
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = (*cooperativeCloseHandler)(nil)
+
+func (h *cooperativeCloseHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *cooperativeCloseHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*CooperativeCloseMsg, *BidirectionalChannel, error) {
+	var msg CooperativeCloseMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var ch BidirectionalChannel
+	if err := h.bucket.One(db, msg.Proof.ChannelID, &ch); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load channel")
+	}
+	// Both parties signed the final balance proof so anyone is allowed to
+	// submit it.
+	if err := verifyProof(ctx, &ch, msg.Proof, msg.SignatureA, msg.SignatureB); err != nil {
+		return nil, nil, err
+	}
+	return &msg, &ch, nil
+}
+
+func (h *cooperativeCloseHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, ch, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := settle(db, h.bucket, h.cash, h.scheduler, msg.Proof.ChannelID, ch, *msg.Proof.BalanceA, *msg.Proof.BalanceB); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+type startCloseHandler struct {
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = (*startCloseHandler)(nil)
+
+func (h *startCloseHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *startCloseHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*StartCloseMsg, *BidirectionalChannel, error) {
+	var msg StartCloseMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var ch BidirectionalChannel
+	if err := h.bucket.One(db, msg.ChannelID, &ch); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load channel")
+	}
+	if ch.IsClosing() {
+		return nil, nil, errors.Wrap(errors.ErrState, "close already started")
+	}
+	if !isParty(ctx, h.auth, &ch) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "only a party can close the channel")
+	}
+	if msg.Proof != nil {
+		if err := verifyProof(ctx, &ch, msg.Proof, msg.SignatureA, msg.SignatureB); err != nil {
+			return nil, nil, err
+		}
+	}
+	return &msg, &ch, nil
+}
+
+func (h *startCloseHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, ch, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+
+	// Without a balance proof each party gets back its deposit.
+	if msg.Proof != nil {
+		ch.Nonce = msg.Proof.Nonce
+		ch.BalanceA = msg.Proof.BalanceA
+		ch.BalanceB = msg.Proof.BalanceB
+	} else {
+		ch.BalanceA = ch.DepositA.Clone()
+		ch.BalanceB = ch.DepositB.Clone()
+	}
+	ch.CloseAt = weave.AsUnixTime(now).Add(ch.DisputePeriod.Duration())
+
+	settleMsg := &SettleMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		ChannelID: msg.ChannelID,
+	}
+	auth := []weave.Condition{bidirectionalChannelCondition(msg.ChannelID)}
+	ch.TaskID, err = h.scheduler.Schedule(db, ch.CloseAt.Time(), auth, settleMsg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule settlement")
+	}
+
+	if _, err := h.bucket.Put(db, msg.ChannelID, ch); err != nil {
+		return nil, errors.Wrap(err, "cannot save channel")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+type challengeHandler struct {
+	auth   x.Authenticator
+	bucket orm.ModelBucket
+}
+
+var _ weave.Handler = (*challengeHandler)(nil)
+
+func (h *challengeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *challengeHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ChallengeMsg, *BidirectionalChannel, error) {
+	var msg ChallengeMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var ch BidirectionalChannel
+	if err := h.bucket.One(db, msg.Proof.ChannelID, &ch); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load channel")
+	}
+	if !ch.IsClosing() {
+		return nil, nil, errors.Wrap(errors.ErrState, "close not started")
+	}
+	if weave.IsExpired(ctx, ch.CloseAt) {
+		return nil, nil, errors.Wrap(errors.ErrExpired, "dispute period is over")
+	}
+	if !isParty(ctx, h.auth, &ch) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "only a party can challenge the close")
+	}
+	if err := verifyProof(ctx, &ch, msg.Proof, msg.SignatureA, msg.SignatureB); err != nil {
+		return nil, nil, err
+	}
+	if msg.Proof.Nonce <= ch.Nonce {
+		return nil, nil, errors.Wrapf(errors.ErrState, "nonce must be greater than %d", ch.Nonce)
+	}
+	return &msg, &ch, nil
+}
+
+func (h *challengeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, ch, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	ch.Nonce = msg.Proof.Nonce
+	ch.BalanceA = msg.Proof.BalanceA
+	ch.BalanceB = msg.Proof.BalanceB
+	if _, err := h.bucket.Put(db, msg.Proof.ChannelID, ch); err != nil {
+		return nil, errors.Wrap(err, "cannot save channel")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+type settleHandler struct {
+	bucket    orm.ModelBucket
+	cash      cash.Controller
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = (*settleHandler)(nil)
+
+func (h *settleHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *settleHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*SettleMsg, *BidirectionalChannel, error) {
+	var msg SettleMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var ch BidirectionalChannel
+	if err := h.bucket.One(db, msg.ChannelID, &ch); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load channel")
+	}
+	// Once the dispute period is over the result is final, so anyone is
+	// allowed to settle the channel.
+	if !ch.IsClosing() {
+		return nil, nil, errors.Wrap(errors.ErrState, "close not started")
+	}
+	if !weave.IsExpired(ctx, ch.CloseAt) {
+		return nil, nil, errors.Wrap(errors.ErrState, "dispute period is not over")
+	}
+	return &msg, &ch, nil
+}
+
+func (h *settleHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, ch, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := settle(db, h.bucket, h.cash, h.scheduler, msg.ChannelID, ch, *ch.BalanceA, *ch.BalanceB); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
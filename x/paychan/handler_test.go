@@ -31,7 +31,7 @@ func TestPaymentChannelHandlers(t *testing.T) {
 	auth := &weavetest.CtxAuth{Key: "auth"}
 
 	rt := app.NewRouter()
-	RegisterRoutes(rt, auth, bankCtrl)
+	RegisterRoutes(rt, auth, bankCtrl, &weavetest.Cron{})
 
 	qr := weave.NewQueryRouter()
 	cash.RegisterQuery(qr)
@@ -630,3 +630,294 @@ func setSignature(key crypto.Signer, msg *TransferMsg) *TransferMsg {
 	msg.Signature = sig
 	return msg
 }
+
+func TestBidirectionalChannelHandlers(t *testing.T) {
+	partyA := weavetest.NewKey()
+	partyB := weavetest.NewKey()
+	other := weavetest.NewCondition()
+	channelID := weavetest.SequenceID(1)
+	afterDispute := now.Add(2 * time.Hour)
+
+	create := action{
+		conditions: []weave.Condition{partyA.PublicKey().Condition(), partyB.PublicKey().Condition()},
+		msg: &CreateBidirectionalMsg{
+			Metadata:      &weave.Metadata{Schema: 1},
+			PartyA:        partyA.PublicKey().Address(),
+			PartyAPubkey:  partyA.PublicKey(),
+			PartyB:        partyB.PublicKey().Address(),
+			PartyBPubkey:  partyB.PublicKey(),
+			DepositA:      dogeCoin(10, 0),
+			DepositB:      dogeCoin(5, 0),
+			DisputePeriod: weave.AsUnixDuration(time.Hour),
+		},
+	}
+	proof := func(nonce uint64, a, b int64, final bool) *BalanceProof {
+		return &BalanceProof{
+			ChainID:   "testchain-123",
+			ChannelID: channelID,
+			Nonce:     nonce,
+			BalanceA:  dogeCoin(a, 0),
+			BalanceB:  dogeCoin(b, 0),
+			Final:     final,
+		}
+	}
+	startClose := func(signer crypto.Signer, p *BalanceProof) action {
+		msg := &StartCloseMsg{
+			Metadata:  &weave.Metadata{Schema: 1},
+			ChannelID: channelID,
+		}
+		if p != nil {
+			msg.Proof = p
+			msg.SignatureA, msg.SignatureB = signProof(p, partyA, partyB)
+		}
+		return action{
+			conditions: []weave.Condition{signer.PublicKey().Condition()},
+			msg:        msg,
+		}
+	}
+	challenge := func(signer crypto.Signer, p *BalanceProof) action {
+		msg := &ChallengeMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			Proof:    p,
+		}
+		msg.SignatureA, msg.SignatureB = signProof(p, partyA, partyB)
+		return action{
+			conditions: []weave.Condition{signer.PublicKey().Condition()},
+			msg:        msg,
+		}
+	}
+	settle := action{
+		msg: &SettleMsg{
+			Metadata:  &weave.Metadata{Schema: 1},
+			ChannelID: channelID,
+		},
+		blockTime: afterDispute,
+	}
+	cooperativeClose := func(p *BalanceProof) action {
+		msg := &CooperativeCloseMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			Proof:    p,
+		}
+		msg.SignatureA, msg.SignatureB = signProof(p, partyA, partyB)
+		return action{msg: msg}
+	}
+
+	cases := map[string]struct {
+		actions      []action
+		wantBalanceA *coin.Coin
+		wantBalanceB *coin.Coin
+		// Number of tasks left in the scheduler.
+		wantTasks int
+	}{
+		"creating a channel allocates deposits of both parties": {
+			actions:      []action{create},
+			wantBalanceA: dogeCoin(90, 0),
+			wantBalanceB: dogeCoin(95, 0),
+		},
+		"creating a channel requires signatures of all funding parties": {
+			actions: []action{
+				{
+					conditions:     []weave.Condition{partyA.PublicKey().Condition()},
+					msg:            create.msg,
+					wantCheckErr:   errors.ErrUnauthorized,
+					wantDeliverErr: errors.ErrUnauthorized,
+				},
+			},
+			wantBalanceA: dogeCoin(100, 0),
+			wantBalanceB: dogeCoin(100, 0),
+		},
+		"cooperative close pays out final balances": {
+			actions: []action{
+				create,
+				cooperativeClose(proof(7, 4, 11, true)),
+			},
+			wantBalanceA: dogeCoin(94, 0),
+			wantBalanceB: dogeCoin(106, 0),
+		},
+		"cooperative close requires a final balance proof": {
+			actions: []action{
+				create,
+				withErr(cooperativeClose(proof(7, 4, 11, false)), errors.ErrMsg),
+			},
+			wantBalanceA: dogeCoin(90, 0),
+			wantBalanceB: dogeCoin(95, 0),
+		},
+		"cooperative close cancels a started close": {
+			actions: []action{
+				create,
+				startClose(partyA, proof(1, 12, 3, false)),
+				cooperativeClose(proof(2, 8, 7, true)),
+			},
+			wantBalanceA: dogeCoin(98, 0),
+			wantBalanceB: dogeCoin(102, 0),
+		},
+		"balances must sum up to the total deposit": {
+			actions: []action{
+				create,
+				withErr(cooperativeClose(proof(7, 4, 10, true)), errors.ErrAmount),
+			},
+			wantBalanceA: dogeCoin(90, 0),
+			wantBalanceB: dogeCoin(95, 0),
+		},
+		"balance proof must be signed by both parties": {
+			actions: []action{
+				create,
+				func() action {
+					a := cooperativeClose(proof(7, 4, 11, true))
+					msg := a.msg.(*CooperativeCloseMsg)
+					msg.SignatureA, msg.SignatureB = signProof(msg.Proof, partyA, partyA)
+					return withErr(a, errors.ErrMsg)
+				}(),
+			},
+			wantBalanceA: dogeCoin(90, 0),
+			wantBalanceB: dogeCoin(95, 0),
+		},
+		"unilateral close without a proof returns deposits after the dispute period": {
+			actions: []action{
+				create,
+				startClose(partyB, nil),
+				withErr(withTime(settle, now), errors.ErrState),
+				settle,
+			},
+			wantBalanceA: dogeCoin(100, 0),
+			wantBalanceB: dogeCoin(100, 0),
+		},
+		"unilateral close schedules the settlement": {
+			actions: []action{
+				create,
+				startClose(partyA, proof(1, 12, 3, false)),
+				withErr(startClose(partyB, nil), errors.ErrState),
+			},
+			wantBalanceA: dogeCoin(90, 0),
+			wantBalanceB: dogeCoin(95, 0),
+			wantTasks:    1,
+		},
+		"only a party can start the close": {
+			actions: []action{
+				create,
+				{
+					conditions:     []weave.Condition{other},
+					msg:            startClose(partyA, nil).msg,
+					wantCheckErr:   errors.ErrUnauthorized,
+					wantDeliverErr: errors.ErrUnauthorized,
+				},
+			},
+			wantBalanceA: dogeCoin(90, 0),
+			wantBalanceB: dogeCoin(95, 0),
+		},
+		"challenge with a higher nonce replaces balances": {
+			actions: []action{
+				create,
+				startClose(partyA, proof(1, 12, 3, false)),
+				challenge(partyB, proof(3, 6, 9, false)),
+				withErr(challenge(partyA, proof(2, 12, 3, false)), errors.ErrState),
+				settle,
+			},
+			wantBalanceA: dogeCoin(96, 0),
+			wantBalanceB: dogeCoin(104, 0),
+		},
+		"challenge requires a started close": {
+			actions: []action{
+				create,
+				withErr(challenge(partyB, proof(3, 6, 9, false)), errors.ErrState),
+			},
+			wantBalanceA: dogeCoin(90, 0),
+			wantBalanceB: dogeCoin(95, 0),
+		},
+		"challenge after the dispute period is rejected": {
+			actions: []action{
+				create,
+				startClose(partyA, proof(1, 12, 3, false)),
+				withErr(withTime(challenge(partyB, proof(3, 6, 9, false)), afterDispute), errors.ErrExpired),
+				settle,
+			},
+			wantBalanceA: dogeCoin(102, 0),
+			wantBalanceB: dogeCoin(98, 0),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "paychan", "cash")
+
+			ctrl := cash.NewController(cash.NewBucket())
+			for _, addr := range []weave.Address{partyA.PublicKey().Address(), partyB.PublicKey().Address()} {
+				if err := ctrl.CoinMint(db, addr, *dogeCoin(100, 0)); err != nil {
+					t.Fatalf("cannot mint: %s", err)
+				}
+			}
+
+			cron := &weavetest.Cron{}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, &weavetest.CtxAuth{Key: "auth"}, ctrl, cron)
+
+			for i, a := range tc.actions {
+				cache := db.CacheWrap()
+				if _, err := rt.Check(a.ctx(), cache, a.tx()); !a.wantCheckErr.Is(err) {
+					t.Logf("want: %+v", a.wantCheckErr)
+					t.Logf(" got: %+v", err)
+					t.Fatalf("action %d check (%T)", i, a.msg)
+				}
+				cache.Discard()
+
+				if _, err := rt.Deliver(a.ctx(), db, a.tx()); !a.wantDeliverErr.Is(err) {
+					t.Logf("want: %+v", a.wantDeliverErr)
+					t.Logf(" got: %+v", err)
+					t.Fatalf("action %d delivery (%T)", i, a.msg)
+				}
+			}
+
+			assertBalance(t, ctrl, db, partyA.PublicKey().Address(), tc.wantBalanceA)
+			assertBalance(t, ctrl, db, partyB.PublicKey().Address(), tc.wantBalanceB)
+
+			ctx := weave.WithBlockTime(context.Background(), afterDispute)
+			if got := len(cron.Tick(ctx, db).Tags); got != tc.wantTasks {
+				t.Fatalf("want %d scheduled tasks, got %d", tc.wantTasks, got)
+			}
+		})
+	}
+}
+
+// withErr returns a copy of given action that is expected to fail with given
+// error.
+func withErr(a action, err *errors.Error) action {
+	a.wantCheckErr = err
+	a.wantDeliverErr = err
+	return a
+}
+
+// withTime returns a copy of given action executed at given block time.
+func withTime(a action, blockTime time.Time) action {
+	a.blockTime = blockTime
+	return a
+}
+
+// signProof returns signatures of given balance proof created with given
+// keys.
+func signProof(p *BalanceProof, keyA, keyB crypto.Signer) (*crypto.Signature, *crypto.Signature) {
+	raw, err := p.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	sigA, err := keyA.Sign(raw)
+	if err != nil {
+		panic(err)
+	}
+	sigB, err := keyB.Sign(raw)
+	if err != nil {
+		panic(err)
+	}
+	return sigA, sigB
+}
+
+func assertBalance(t testing.TB, ctrl cash.Controller, db weave.KVStore, addr weave.Address, want *coin.Coin) {
+	t.Helper()
+	got, err := ctrl.Balance(db, addr)
+	if err != nil {
+		t.Fatalf("cannot get %s balance: %s", addr, err)
+	}
+	if !got.Equals(coin.Coins{want}) {
+		t.Fatalf("want %s balance %v, got %v", addr, want, got)
+	}
+}
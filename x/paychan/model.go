@@ -1,6 +1,7 @@
 package paychan
 
 import (
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
@@ -8,6 +9,7 @@ import (
 
 func init() {
 	migration.MustRegister(1, &PaymentChannel{}, migration.NoModification)
+	migration.MustRegister(1, &BidirectionalChannel{}, migration.NoModification)
 }
 
 var _ orm.CloneableData = (*PaymentChannel)(nil)
@@ -79,3 +81,134 @@ func newPaymentChannelObjectBucket() orm.Bucket {
 	obj := orm.NewSimpleObj(nil, &PaymentChannel{})
 	return orm.NewBucket("paychan", obj)
 }
+
+var _ orm.CloneableData = (*BidirectionalChannel)(nil)
+
+// Validate ensures the bidirectional channel is valid.
+func (c *BidirectionalChannel) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", c.Metadata.Validate())
+	errs = errors.AppendField(errs, "PartyA", c.PartyA.Validate())
+	if c.PartyAPubkey == nil {
+		errs = errors.Append(errs,
+			errors.Field("PartyAPubkey", errors.ErrModel, "missing party A public key"))
+	}
+	errs = errors.AppendField(errs, "PartyB", c.PartyB.Validate())
+	if c.PartyBPubkey == nil {
+		errs = errors.Append(errs,
+			errors.Field("PartyBPubkey", errors.ErrModel, "missing party B public key"))
+	}
+	errs = errors.AppendField(errs, "Deposit", validateDeposits(c.DepositA, c.DepositB))
+	if c.DisputePeriod <= 0 {
+		errs = errors.Append(errs,
+			errors.Field("DisputePeriod", errors.ErrModel, "must be greater than zero"))
+	}
+	if len(c.Memo) > 128 {
+		errs = errors.Append(errs,
+			errors.Field("Memo", errors.ErrModel, "memo too long"))
+	}
+	errs = errors.AppendField(errs, "Address", c.Address.Validate())
+
+	if c.CloseAt != 0 {
+		if err := c.CloseAt.Validate(); err != nil {
+			errs = errors.AppendField(errs, "CloseAt", err)
+		}
+		if err := c.validateBalances(c.BalanceA, c.BalanceB); err != nil {
+			errs = errors.AppendField(errs, "Balance", err)
+		}
+	} else if c.BalanceA != nil || c.BalanceB != nil || c.Nonce != 0 {
+		errs = errors.Append(errs,
+			errors.Field("CloseAt", errors.ErrModel, "balances set before the close"))
+	}
+	return errs
+}
+
+// validateDeposits returns an error if given deposits cannot be used to fund
+// a bidirectional channel. Both deposits must be of the same currency and at
+// least one of them must be positive.
+func validateDeposits(a, b *coin.Coin) error {
+	if a == nil || b == nil {
+		return errors.Wrap(errors.ErrEmpty, "deposit is required")
+	}
+	if err := a.Validate(); err != nil {
+		return errors.Wrap(err, "deposit A")
+	}
+	if err := b.Validate(); err != nil {
+		return errors.Wrap(err, "deposit B")
+	}
+	if !a.SameType(*b) {
+		return errors.Wrap(errors.ErrCurrency, "deposits use different ticker")
+	}
+	if !a.IsNonNegative() || !b.IsNonNegative() {
+		return errors.Wrap(errors.ErrAmount, "negative deposit")
+	}
+	if !a.IsPositive() && !b.IsPositive() {
+		return errors.Wrap(errors.ErrAmount, "no deposit")
+	}
+	return nil
+}
+
+// Total returns the total amount deposited on the channel.
+func (c *BidirectionalChannel) Total() (coin.Coin, error) {
+	return c.DepositA.Add(*c.DepositB)
+}
+
+// validateBalances returns an error if given balances do not split the total
+// deposit of the channel.
+func (c *BidirectionalChannel) validateBalances(a, b *coin.Coin) error {
+	if a == nil || b == nil {
+		return errors.Wrap(errors.ErrEmpty, "balance is required")
+	}
+	if !a.IsNonNegative() || !b.IsNonNegative() {
+		return errors.Wrap(errors.ErrAmount, "negative balance")
+	}
+	total, err := c.Total()
+	if err != nil {
+		return errors.Wrap(err, "total")
+	}
+	sum, err := a.Add(*b)
+	if err != nil {
+		return errors.Wrap(err, "balances sum")
+	}
+	if !sum.Equals(total) {
+		return errors.Wrapf(errors.ErrAmount, "balances must sum up to %s", total)
+	}
+	return nil
+}
+
+// IsClosing returns true if the unilateral close of the channel was started.
+func (c *BidirectionalChannel) IsClosing() bool {
+	return c.CloseAt != 0
+}
+
+// Copy returns a deep copy of this BidirectionalChannel.
+func (c BidirectionalChannel) Copy() orm.CloneableData {
+	return &BidirectionalChannel{
+		Metadata:      c.Metadata.Copy(),
+		PartyA:        c.PartyA.Clone(),
+		PartyAPubkey:  c.PartyAPubkey,
+		PartyB:        c.PartyB.Clone(),
+		PartyBPubkey:  c.PartyBPubkey,
+		DepositA:      c.DepositA.Clone(),
+		DepositB:      c.DepositB.Clone(),
+		DisputePeriod: c.DisputePeriod,
+		Memo:          c.Memo,
+		Address:       c.Address.Clone(),
+		Nonce:         c.Nonce,
+		BalanceA:      c.BalanceA.Clone(),
+		BalanceB:      c.BalanceB.Clone(),
+		CloseAt:       c.CloseAt,
+		TaskID:        append([]byte(nil), c.TaskID...),
+	}
+}
+
+// NewBidirectionalChannelBucket returns a bucket for storing
+// BidirectionalChannel state.
+func NewBidirectionalChannelBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("bichannel", &BidirectionalChannel{},
+		orm.WithIDSequence(bidirectionalChannelSeq))
+	return migration.NewModelBucket("paychan", b)
+}
+
+var bidirectionalChannelSeq = orm.NewSequence("bichannel", "id")
@@ -1,7 +1,10 @@
 package paychan
 
 import (
+	"bytes"
+
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 )
@@ -10,6 +13,11 @@ func init() {
 	migration.MustRegister(1, &CreateMsg{}, migration.NoModification)
 	migration.MustRegister(1, &TransferMsg{}, migration.NoModification)
 	migration.MustRegister(1, &CloseMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CreateBidirectionalMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CooperativeCloseMsg{}, migration.NoModification)
+	migration.MustRegister(1, &StartCloseMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ChallengeMsg{}, migration.NoModification)
+	migration.MustRegister(1, &SettleMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*CreateMsg)(nil)
@@ -101,6 +109,161 @@ func (CloseMsg) Path() string {
 	return "paychan/close"
 }
 
+var _ weave.Msg = (*CreateBidirectionalMsg)(nil)
+
+func (m *CreateBidirectionalMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PartyA", m.PartyA.Validate())
+	if m.PartyAPubkey == nil {
+		errs = errors.Append(errs,
+			errors.Field("PartyAPubkey", errors.ErrMsg, "missing party A public key"))
+	} else if !m.PartyAPubkey.Address().Equals(m.PartyA) {
+		errs = errors.Append(errs,
+			errors.Field("PartyAPubkey", errors.ErrMsg, "public key does not match party A address"))
+	}
+	errs = errors.AppendField(errs, "PartyB", m.PartyB.Validate())
+	if m.PartyBPubkey == nil {
+		errs = errors.Append(errs,
+			errors.Field("PartyBPubkey", errors.ErrMsg, "missing party B public key"))
+	} else if !m.PartyBPubkey.Address().Equals(m.PartyB) {
+		errs = errors.Append(errs,
+			errors.Field("PartyBPubkey", errors.ErrMsg, "public key does not match party B address"))
+	}
+	if m.PartyA.Equals(m.PartyB) {
+		errs = errors.Append(errs,
+			errors.Field("PartyB", errors.ErrMsg, "parties must be different"))
+	}
+	errs = errors.AppendField(errs, "Deposit", validateDeposits(m.DepositA, m.DepositB))
+	if m.DisputePeriod <= 0 {
+		errs = errors.Append(errs,
+			errors.Field("DisputePeriod", errors.ErrMsg, "must be greater than zero"))
+	}
+	if len(m.Memo) > 128 {
+		errs = errors.Append(errs,
+			errors.Field("Memo", errors.ErrMsg, "memo too long"))
+	}
+	return errs
+}
+
+func (CreateBidirectionalMsg) Path() string {
+	return "paychan/create_bidirectional"
+}
+
+// validateSignedProof returns an error if given balance proof or any of the
+// signatures is missing or malformed.
+func validateSignedProof(p *BalanceProof, sigA, sigB *crypto.Signature) error {
+	var errs error
+	if sigA == nil {
+		errs = errors.Append(errs,
+			errors.Field("SignatureA", errors.ErrMsg, "missing signature"))
+	}
+	if sigB == nil {
+		errs = errors.Append(errs,
+			errors.Field("SignatureB", errors.ErrMsg, "missing signature"))
+	}
+	if p == nil {
+		return errors.Append(errs,
+			errors.Field("Proof", errors.ErrMsg, "missing balance proof"))
+	}
+	if p.ChainID == "" {
+		errs = errors.Append(errs,
+			errors.Field("Proof.ChainID", errors.ErrMsg, "missing chain ID"))
+	}
+	if p.ChannelID == nil {
+		errs = errors.Append(errs,
+			errors.Field("Proof.ChannelID", errors.ErrMsg, "missing channel ID"))
+	}
+	if p.Nonce == 0 {
+		errs = errors.Append(errs,
+			errors.Field("Proof.Nonce", errors.ErrMsg, "must be greater than zero"))
+	}
+	if p.BalanceA == nil || !p.BalanceA.IsNonNegative() {
+		errs = errors.Append(errs,
+			errors.Field("Proof.BalanceA", errors.ErrMsg, "invalid balance value"))
+	}
+	if p.BalanceB == nil || !p.BalanceB.IsNonNegative() {
+		errs = errors.Append(errs,
+			errors.Field("Proof.BalanceB", errors.ErrMsg, "invalid balance value"))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*CooperativeCloseMsg)(nil)
+
+func (m *CooperativeCloseMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.Append(errs, validateSignedProof(m.Proof, m.SignatureA, m.SignatureB))
+	if m.Proof != nil && !m.Proof.Final {
+		errs = errors.Append(errs,
+			errors.Field("Proof.Final", errors.ErrMsg, "balance proof must be final"))
+	}
+	return errs
+}
+
+func (CooperativeCloseMsg) Path() string {
+	return "paychan/cooperative_close"
+}
+
+var _ weave.Msg = (*StartCloseMsg)(nil)
+
+func (m *StartCloseMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if m.ChannelID == nil {
+		errs = errors.Append(errs,
+			errors.Field("ChannelID", errors.ErrMsg, "missing channel ID"))
+	}
+	// Balance proof is optional. When not provided, deposits are returned.
+	if m.Proof != nil || m.SignatureA != nil || m.SignatureB != nil {
+		errs = errors.Append(errs, validateSignedProof(m.Proof, m.SignatureA, m.SignatureB))
+		if m.Proof != nil && !bytes.Equal(m.Proof.ChannelID, m.ChannelID) {
+			errs = errors.Append(errs,
+				errors.Field("Proof.ChannelID", errors.ErrMsg, "balance proof of a different channel"))
+		}
+	}
+	return errs
+}
+
+func (StartCloseMsg) Path() string {
+	return "paychan/start_close"
+}
+
+var _ weave.Msg = (*ChallengeMsg)(nil)
+
+func (m *ChallengeMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.Append(errs, validateSignedProof(m.Proof, m.SignatureA, m.SignatureB))
+	return errs
+}
+
+func (ChallengeMsg) Path() string {
+	return "paychan/challenge"
+}
+
+var _ weave.Msg = (*SettleMsg)(nil)
+
+func (m *SettleMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if m.ChannelID == nil {
+		errs = errors.Append(errs,
+			errors.Field("ChannelID", errors.ErrMsg, "missing channel ID"))
+	}
+	return errs
+}
+
+func (SettleMsg) Path() string {
+	return "paychan/settle"
+}
+
 // inThePast represents time value for Monday, January 1, 2018 2:00:00 AM GMT+01:00
 //
 // Assumption of this extension is that year 2018 is always in the past and it
@@ -3,7 +3,9 @@ package paychan
 import (
 	"testing"
 
+	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/weavetest/assert"
 )
@@ -22,3 +24,63 @@ func TestCreateMsgValidate(t *testing.T) {
 	assert.FieldError(t, err, "Total", nil)
 	assert.FieldError(t, err, "Memo", nil)
 }
+
+func TestCreateBidirectionalMsgValidate(t *testing.T) {
+	msg := &CreateBidirectionalMsg{
+		DepositA: coin.NewCoinp(1, 0, "IOV"),
+		DepositB: coin.NewCoinp(0, 0, "ETH"),
+	}
+	err := msg.Validate()
+
+	assert.FieldError(t, err, "Metadata", errors.ErrMetadata)
+	assert.FieldError(t, err, "PartyA", errors.ErrEmpty)
+	assert.FieldError(t, err, "PartyAPubkey", errors.ErrMsg)
+	assert.FieldError(t, err, "PartyBPubkey", errors.ErrMsg)
+	assert.FieldError(t, err, "Deposit", errors.ErrCurrency)
+	assert.FieldError(t, err, "DisputePeriod", errors.ErrMsg)
+	assert.FieldError(t, err, "Memo", nil)
+}
+
+func TestCreateBidirectionalMsgPubkeyMismatch(t *testing.T) {
+	partyA := crypto.GenPrivKeyEd25519().PublicKey()
+	partyB := crypto.GenPrivKeyEd25519().PublicKey()
+	msg := &CreateBidirectionalMsg{
+		Metadata:      &weave.Metadata{Schema: 1},
+		PartyA:        partyA.Address(),
+		PartyAPubkey:  partyB,
+		PartyB:        partyB.Address(),
+		PartyBPubkey:  partyA,
+		DepositA:      coin.NewCoinp(1, 0, "IOV"),
+		DepositB:      coin.NewCoinp(0, 0, "IOV"),
+		DisputePeriod: 100,
+	}
+	err := msg.Validate()
+
+	assert.FieldError(t, err, "PartyA", nil)
+	assert.FieldError(t, err, "PartyAPubkey", errors.ErrMsg)
+	assert.FieldError(t, err, "PartyB", nil)
+	assert.FieldError(t, err, "PartyBPubkey", errors.ErrMsg)
+	assert.FieldError(t, err, "Deposit", nil)
+	assert.FieldError(t, err, "DisputePeriod", nil)
+
+	msg.PartyAPubkey, msg.PartyBPubkey = partyA, partyB
+	assert.Nil(t, msg.Validate())
+}
+
+func TestCooperativeCloseMsgValidate(t *testing.T) {
+	msg := &CooperativeCloseMsg{
+		Proof: &BalanceProof{
+			BalanceA: coin.NewCoinp(1, 0, "IOV"),
+			BalanceB: coin.NewCoinp(-1, 0, "IOV"),
+		},
+	}
+	err := msg.Validate()
+
+	assert.FieldError(t, err, "Metadata", errors.ErrMetadata)
+	assert.FieldError(t, err, "SignatureA", errors.ErrMsg)
+	assert.FieldError(t, err, "Proof.ChainID", errors.ErrMsg)
+	assert.FieldError(t, err, "Proof.Nonce", errors.ErrMsg)
+	assert.FieldError(t, err, "Proof.BalanceA", nil)
+	assert.FieldError(t, err, "Proof.BalanceB", errors.ErrMsg)
+	assert.FieldError(t, err, "Proof.Final", errors.ErrMsg)
+}
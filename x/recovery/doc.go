@@ -0,0 +1,17 @@
+/*
+Package recovery implements social recovery of accounts.
+
+An account that lost its private key would lose all its funds. This extension
+allows an account to nominate guardians and a threshold of guardian approvals
+that is required to recover the account.
+
+Any guardian can initiate the recovery by proposing a new public key. Once
+enough guardians approved the recovery, the key rotation is scheduled to
+happen after a configured delay. During that time the account owner can
+cancel the recovery, which protects from malicious guardians.
+
+When the recovery is executed, the new public key becomes the controller of
+the account in x/sigs. From that moment only the new key can sign on behalf of
+the account, while the account address and all its funds remain the same.
+*/
+package recovery
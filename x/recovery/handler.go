@@ -0,0 +1,364 @@
+package recovery
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/sigs"
+)
+
+const (
+	configureCost int64 = 100
+	initiateCost  int64 = 100
+	approveCost   int64 = 0
+	cancelCost    int64 = 0
+)
+
+// RegisterRoutes will instantiate and register all handlers in this package.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, scheduler weave.Scheduler) {
+	r = migration.SchemaMigratingRegistry(packageName, r)
+	configs := NewConfigBucket()
+	recoveries := NewRecoveryBucket()
+	r.Handle(&ConfigureMsg{}, ConfigureHandler{auth, configs, recoveries, scheduler})
+	r.Handle(&InitiateMsg{}, InitiateHandler{auth, configs, recoveries, scheduler})
+	r.Handle(&ApproveMsg{}, ApproveHandler{auth, configs, recoveries, scheduler})
+	r.Handle(&CancelMsg{}, CancelHandler{auth, recoveries, scheduler})
+}
+
+// RegisterCronRoutes registers handlers for messages that are executed by the
+// cron only.
+func RegisterCronRoutes(r weave.Registry) {
+	r = migration.SchemaMigratingRegistry(packageName, r)
+	r.Handle(&ExecuteMsg{}, ExecuteHandler{NewRecoveryBucket()})
+}
+
+// RegisterQuery will register buckets as "/recoveryconfigs" and
+// "/recoveries".
+func RegisterQuery(qr weave.QueryRouter) {
+	NewConfigBucket().Register("recoveryconfigs", qr)
+	NewRecoveryBucket().Register("recoveries", qr)
+}
+
+// ConfigureHandler sets the guardians of the main signer account.
+type ConfigureHandler struct {
+	auth       x.Authenticator
+	configs    orm.ModelBucket
+	recoveries orm.ModelBucket
+	scheduler  weave.Scheduler
+}
+
+var _ weave.Handler = ConfigureHandler{}
+
+func (h ConfigureHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: configureCost}, nil
+}
+
+func (h ConfigureHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, account, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	// Approvals of an ongoing recovery were given for the old
+	// configuration and cannot be trusted anymore.
+	if err := cancelRecovery(db, h.recoveries, h.scheduler, account); err != nil {
+		return nil, err
+	}
+	config := &Config{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Guardians: msg.Guardians,
+		Threshold: msg.Threshold,
+		Delay:     msg.Delay,
+	}
+	if _, err := h.configs.Put(db, account, config); err != nil {
+		return nil, errors.Wrap(err, "cannot store config")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h ConfigureHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ConfigureMsg, weave.Address, error) {
+	var msg ConfigureMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	signer := x.MainSigner(ctx, h.auth)
+	if signer == nil {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "no signer")
+	}
+	account := signer.Address()
+	// Only accounts that are controlled by a public key can have their
+	// key rotated.
+	switch obj, err := sigs.NewBucket().Get(db, account); {
+	case err != nil:
+		return nil, nil, errors.Wrap(err, "cannot load account")
+	case obj == nil:
+		return nil, nil, errors.Wrap(errors.ErrInput, "main signer must be a signature account")
+	}
+	for i, g := range msg.Guardians {
+		if g.Equals(account) {
+			return nil, nil, errors.Wrapf(errors.ErrInput, "guardian %d: account cannot be its own guardian", i)
+		}
+	}
+	return &msg, account, nil
+}
+
+// InitiateHandler starts the recovery of an account.
+type InitiateHandler struct {
+	auth       x.Authenticator
+	configs    orm.ModelBucket
+	recoveries orm.ModelBucket
+	scheduler  weave.Scheduler
+}
+
+var _ weave.Handler = InitiateHandler{}
+
+func (h InitiateHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: initiateCost}, nil
+}
+
+func (h InitiateHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, config, approvals, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	recovery := &Recovery{
+		Metadata:  &weave.Metadata{Schema: 1},
+		NewKey:    msg.NewKey,
+		Approvals: approvals,
+	}
+	if err := scheduleIfApproved(ctx, db, h.scheduler, msg.Account, config, recovery); err != nil {
+		return nil, err
+	}
+	if _, err := h.recoveries.Put(db, msg.Account, recovery); err != nil {
+		return nil, errors.Wrap(err, "cannot store recovery")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h InitiateHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*InitiateMsg, *Config, []weave.Address, error) {
+	var msg InitiateMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+	var config Config
+	if err := h.configs.One(db, msg.Account, &config); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load config")
+	}
+	switch err := h.recoveries.Has(db, msg.Account); {
+	case err == nil:
+		return nil, nil, nil, errors.Wrap(errors.ErrDuplicate, "recovery already in progress")
+	case !errors.ErrNotFound.Is(err):
+		return nil, nil, nil, errors.Wrap(err, "cannot load recovery")
+	}
+	approvals := signingGuardians(ctx, h.auth, &config, nil)
+	if len(approvals) == 0 {
+		return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "must be signed by a guardian")
+	}
+	return &msg, &config, approvals, nil
+}
+
+// ApproveHandler approves an ongoing recovery of an account.
+type ApproveHandler struct {
+	auth       x.Authenticator
+	configs    orm.ModelBucket
+	recoveries orm.ModelBucket
+	scheduler  weave.Scheduler
+}
+
+var _ weave.Handler = ApproveHandler{}
+
+func (h ApproveHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: approveCost}, nil
+}
+
+func (h ApproveHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, config, recovery, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := scheduleIfApproved(ctx, db, h.scheduler, msg.Account, config, recovery); err != nil {
+		return nil, err
+	}
+	if _, err := h.recoveries.Put(db, msg.Account, recovery); err != nil {
+		return nil, errors.Wrap(err, "cannot store recovery")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// validate returns the recovery with approvals of all signing guardians
+// added.
+func (h ApproveHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ApproveMsg, *Config, *Recovery, error) {
+	var msg ApproveMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+	var config Config
+	if err := h.configs.One(db, msg.Account, &config); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load config")
+	}
+	var recovery Recovery
+	if err := h.recoveries.One(db, msg.Account, &recovery); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load recovery")
+	}
+	approvals := signingGuardians(ctx, h.auth, &config, &recovery)
+	if len(approvals) == 0 {
+		return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "must be signed by a guardian that did not approve yet")
+	}
+	recovery.Approvals = append(recovery.Approvals, approvals...)
+	return &msg, &config, &recovery, nil
+}
+
+// CancelHandler cancels an ongoing recovery of an account.
+type CancelHandler struct {
+	auth       x.Authenticator
+	recoveries orm.ModelBucket
+	scheduler  weave.Scheduler
+}
+
+var _ weave.Handler = CancelHandler{}
+
+func (h CancelHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: cancelCost}, nil
+}
+
+func (h CancelHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := cancelRecovery(db, h.recoveries, h.scheduler, msg.Account); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h CancelHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*CancelMsg, error) {
+	var msg CancelMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, msg.Account) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "only the account can cancel its recovery")
+	}
+	if err := h.recoveries.Has(db, msg.Account); err != nil {
+		return nil, errors.Wrap(err, "cannot load recovery")
+	}
+	return &msg, nil
+}
+
+// ExecuteHandler rotates the key of an account once the recovery delay
+// passed.
+type ExecuteHandler struct {
+	recoveries orm.ModelBucket
+}
+
+var _ weave.Handler = ExecuteHandler{}
+
+func (h ExecuteHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	return nil, errors.Wrap(errors.ErrHuman, "execute handler is to be executed by cron only")
+}
+
+func (h ExecuteHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	var msg ExecuteMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	var recovery Recovery
+	if err := h.recoveries.One(db, msg.Account, &recovery); err != nil {
+		return nil, errors.Wrap(err, "cannot load recovery")
+	}
+	if recovery.ExecuteAt == 0 {
+		return nil, errors.Wrap(errors.ErrState, "recovery not approved")
+	}
+	if weave.InTheFuture(ctx, recovery.ExecuteAt.Time()) {
+		return nil, errors.Wrap(errors.ErrState, "execution before the recovery delay passed")
+	}
+	if err := sigs.RotateKey(db, msg.Account, recovery.NewKey); err != nil {
+		return nil, errors.Wrap(err, "cannot rotate key")
+	}
+	if err := h.recoveries.Delete(db, msg.Account); err != nil {
+		return nil, errors.Wrap(err, "cannot delete recovery")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// signingGuardians returns all guardians that signed the transaction and did
+// not approve the recovery yet.
+func signingGuardians(ctx weave.Context, auth x.Authenticator, config *Config, recovery *Recovery) []weave.Address {
+	var approvals []weave.Address
+	for _, g := range config.Guardians {
+		if recovery != nil && recovery.HasApproved(g) {
+			continue
+		}
+		if auth.HasAddress(ctx, g) {
+			approvals = append(approvals, g)
+		}
+	}
+	return approvals
+}
+
+// scheduleIfApproved schedules the key rotation if the recovery reached the
+// threshold of approvals and is not scheduled yet.
+func scheduleIfApproved(
+	ctx weave.Context,
+	db weave.KVStore,
+	scheduler weave.Scheduler,
+	account weave.Address,
+	config *Config,
+	recovery *Recovery,
+) error {
+	if recovery.ExecuteAt != 0 || len(recovery.Approvals) < int(config.Threshold) {
+		return nil
+	}
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return errors.Wrap(err, "block time")
+	}
+	runAt := blockTime.Add(config.Delay.Duration())
+	executeMsg := &ExecuteMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Account:  account,
+	}
+	// Execute message requires no authentication.
+	taskID, err := scheduler.Schedule(db, runAt, nil, executeMsg)
+	if err != nil {
+		return errors.Wrap(err, "cannot schedule recovery task")
+	}
+	recovery.ExecuteAt = weave.AsUnixTime(runAt)
+	recovery.TaskID = taskID
+	return nil
+}
+
+// cancelRecovery deletes the recovery of given account together with its
+// scheduled task. It is a no-op if there is no recovery.
+func cancelRecovery(db weave.KVStore, recoveries orm.ModelBucket, scheduler weave.Scheduler, account weave.Address) error {
+	var recovery Recovery
+	switch err := recoveries.One(db, account, &recovery); {
+	case errors.ErrNotFound.Is(err):
+		return nil
+	case err != nil:
+		return errors.Wrap(err, "cannot load recovery")
+	}
+	if len(recovery.TaskID) != 0 {
+		if err := scheduler.Delete(db, recovery.TaskID); err != nil && !errors.ErrNotFound.Is(err) {
+			return errors.Wrap(err, "cannot delete recovery task")
+		}
+	}
+	if err := recoveries.Delete(db, account); err != nil {
+		return errors.Wrap(err, "cannot delete recovery")
+	}
+	return nil
+}
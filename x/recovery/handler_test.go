@@ -0,0 +1,190 @@
+package recovery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/sigs"
+)
+
+func TestRecoverAccount(t *testing.T) {
+	ownerKey := weavetest.NewKey()
+	owner := ownerKey.PublicKey().Condition()
+	account := owner.Address()
+	newKey := weavetest.NewKey().PublicKey()
+
+	alice := weavetest.NewCondition()
+	bobby := weavetest.NewCondition()
+	charlie := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName, "sigs")
+
+	// Account must be known to x/sigs in order to be configured.
+	bucket := sigs.NewBucket()
+	obj, err := bucket.GetOrCreate(db, ownerKey.PublicKey())
+	assert.Nil(t, err)
+	assert.Nil(t, bucket.Save(db, obj))
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	cron := &weavetest.Cron{}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, cron)
+	RegisterCronRoutes(rt)
+
+	now := time.Now().UTC()
+	ctx := weave.WithBlockTime(context.Background(), now)
+
+	configure := &weavetest.Tx{Msg: &ConfigureMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Guardians: []weave.Address{alice.Address(), bobby.Address(), charlie.Address()},
+		Threshold: 2,
+		Delay:     weave.AsUnixDuration(time.Hour),
+	}}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, alice), db, configure); !errors.ErrInput.Is(err) {
+		t.Fatalf("want unknown account error, got %+v", err)
+	}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, owner), db, configure); err != nil {
+		t.Fatalf("cannot configure: %s", err)
+	}
+
+	initiate := &weavetest.Tx{Msg: &InitiateMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Account:  account,
+		NewKey:   newKey,
+	}}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, owner), db, initiate); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, alice), db, initiate); err != nil {
+		t.Fatalf("cannot initiate: %s", err)
+	}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, bobby), db, initiate); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %+v", err)
+	}
+
+	var recovery Recovery
+	assert.Nil(t, NewRecoveryBucket().One(db, account, &recovery))
+	assert.Equal(t, []weave.Address{alice.Address()}, recovery.Approvals)
+	assert.Equal(t, weave.UnixTime(0), recovery.ExecuteAt)
+
+	approve := &weavetest.Tx{Msg: &ApproveMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Account:  account,
+	}}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, alice), db, approve); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want already approved error, got %+v", err)
+	}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, bobby), db, approve); err != nil {
+		t.Fatalf("cannot approve: %s", err)
+	}
+	assert.Nil(t, NewRecoveryBucket().One(db, account, &recovery))
+	assert.Equal(t, weave.AsUnixTime(now.Add(time.Hour)), recovery.ExecuteAt)
+
+	execute := &weavetest.Tx{Msg: &ExecuteMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Account:  account,
+	}}
+	if _, err := rt.Check(ctx, db, execute); !errors.ErrHuman.Is(err) {
+		t.Fatalf("want cron only error, got %+v", err)
+	}
+	if _, err := rt.Deliver(ctx, db, execute); !errors.ErrState.Is(err) {
+		t.Fatalf("want delay error, got %+v", err)
+	}
+
+	later := weave.WithBlockTime(context.Background(), now.Add(time.Hour+time.Second))
+	if _, err := rt.Deliver(later, db, execute); err != nil {
+		t.Fatalf("cannot execute: %s", err)
+	}
+	if err := NewRecoveryBucket().Has(db, account); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want recovery to be deleted, got %+v", err)
+	}
+	obj, err = bucket.Get(db, account)
+	assert.Nil(t, err)
+	assert.Equal(t, newKey, sigs.AsUser(obj).Controller)
+}
+
+func TestCancelRecovery(t *testing.T) {
+	ownerKey := weavetest.NewKey()
+	owner := ownerKey.PublicKey().Condition()
+	account := owner.Address()
+	alice := weavetest.NewCondition()
+	bobby := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName, "sigs")
+
+	bucket := sigs.NewBucket()
+	obj, err := bucket.GetOrCreate(db, ownerKey.PublicKey())
+	assert.Nil(t, err)
+	assert.Nil(t, bucket.Save(db, obj))
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, &weavetest.Cron{})
+	RegisterCronRoutes(rt)
+
+	now := time.Now().UTC()
+	ctx := weave.WithBlockTime(context.Background(), now)
+
+	configure := &weavetest.Tx{Msg: &ConfigureMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Guardians: []weave.Address{alice.Address(), bobby.Address()},
+		Threshold: 1,
+		Delay:     weave.AsUnixDuration(time.Hour),
+	}}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, owner), db, configure); err != nil {
+		t.Fatalf("cannot configure: %s", err)
+	}
+	initiate := &weavetest.Tx{Msg: &InitiateMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Account:  account,
+		NewKey:   weavetest.NewKey().PublicKey(),
+	}}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, alice), db, initiate); err != nil {
+		t.Fatalf("cannot initiate: %s", err)
+	}
+
+	cancel := &weavetest.Tx{Msg: &CancelMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Account:  account,
+	}}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, alice), db, cancel); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, owner), db, cancel); err != nil {
+		t.Fatalf("cannot cancel: %s", err)
+	}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, owner), db, cancel); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+
+	// A cancelled recovery is never executed.
+	execute := &weavetest.Tx{Msg: &ExecuteMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Account:  account,
+	}}
+	later := weave.WithBlockTime(context.Background(), now.Add(2*time.Hour))
+	if _, err := rt.Deliver(later, db, execute); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+
+	// Reconfiguring the account cancels an ongoing recovery as well.
+	if _, err := rt.Deliver(auth.SetConditions(ctx, bobby), db, initiate); err != nil {
+		t.Fatalf("cannot initiate: %s", err)
+	}
+	if _, err := rt.Deliver(auth.SetConditions(ctx, owner), db, configure); err != nil {
+		t.Fatalf("cannot configure: %s", err)
+	}
+	if err := NewRecoveryBucket().Has(db, account); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want recovery to be deleted, got %+v", err)
+	}
+}
@@ -0,0 +1,118 @@
+package recovery
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+)
+
+func init() {
+	migration.MustRegister(1, &Config{}, migration.NoModification)
+	migration.MustRegister(1, &Recovery{}, migration.NoModification)
+}
+
+var _ orm.CloneableData = (*Config)(nil)
+
+// Validate ensures the config is valid.
+func (c *Config) Validate() error {
+	if err := c.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	return validateGuardians(errors.ErrModel, c.Guardians, c.Threshold, c.Delay)
+}
+
+// Copy makes a new config.
+func (c *Config) Copy() orm.CloneableData {
+	return &Config{
+		Metadata:  c.Metadata.Copy(),
+		Guardians: copyAddresses(c.Guardians),
+		Threshold: c.Threshold,
+		Delay:     c.Delay,
+	}
+}
+
+// IsGuardian returns true if given address is one of the guardians.
+func (c *Config) IsGuardian(addr weave.Address) bool {
+	for _, g := range c.Guardians {
+		if g.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+var _ orm.CloneableData = (*Recovery)(nil)
+
+// Validate ensures the recovery is valid.
+func (r *Recovery) Validate() error {
+	if err := r.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if r.NewKey == nil || r.NewKey.Condition() == nil {
+		return errors.Wrap(errors.ErrModel, "new key is required")
+	}
+	if len(r.Approvals) == 0 {
+		return errors.Wrap(errors.ErrModel, "no approvals")
+	}
+	for i, a := range r.Approvals {
+		if err := a.Validate(); err != nil {
+			return errors.Wrapf(err, "approval %d", i)
+		}
+	}
+	if r.ExecuteAt != 0 {
+		if err := r.ExecuteAt.Validate(); err != nil {
+			return errors.Wrap(err, "execute at")
+		}
+		if len(r.TaskID) == 0 {
+			return errors.Wrap(errors.ErrModel, "scheduled recovery requires a task ID")
+		}
+	}
+	return nil
+}
+
+// Copy makes a new recovery.
+func (r *Recovery) Copy() orm.CloneableData {
+	return &Recovery{
+		Metadata:  r.Metadata.Copy(),
+		NewKey:    r.NewKey,
+		Approvals: copyAddresses(r.Approvals),
+		ExecuteAt: r.ExecuteAt,
+		TaskID:    append([]byte(nil), r.TaskID...),
+	}
+}
+
+// HasApproved returns true if given guardian approved the recovery.
+func (r *Recovery) HasApproved(guardian weave.Address) bool {
+	for _, a := range r.Approvals {
+		if a.Equals(guardian) {
+			return true
+		}
+	}
+	return false
+}
+
+func copyAddresses(addrs []weave.Address) []weave.Address {
+	if addrs == nil {
+		return nil
+	}
+	cp := make([]weave.Address, len(addrs))
+	for i, a := range addrs {
+		cp[i] = a.Clone()
+	}
+	return cp
+}
+
+// NewConfigBucket returns a bucket for storing recovery configurations.
+// Configuration is stored under the address of the account.
+func NewConfigBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("rcvconfig", &Config{})
+	return migration.NewModelBucket(packageName, b)
+}
+
+// NewRecoveryBucket returns a bucket for storing ongoing recoveries.
+// Recovery is stored under the address of the account.
+func NewRecoveryBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("recovery", &Recovery{})
+	return migration.NewModelBucket(packageName, b)
+}
@@ -0,0 +1,143 @@
+package recovery
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &ConfigureMsg{}, migration.NoModification)
+	migration.MustRegister(1, &InitiateMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ApproveMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CancelMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ExecuteMsg{}, migration.NoModification)
+}
+
+const (
+	packageName = "recovery"
+
+	// To avoid burning CPU, this is the maximum number of guardians of a
+	// single account.
+	maxGuardians = 20
+)
+
+var _ weave.Msg = (*ConfigureMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ConfigureMsg) Path() string {
+	return "recovery/configure"
+}
+
+// Validate ensures the message is valid.
+func (m *ConfigureMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	return validateGuardians(errors.ErrMsg, m.Guardians, m.Threshold, m.Delay)
+}
+
+var _ weave.Msg = (*InitiateMsg)(nil)
+
+// Path returns the routing path for this message.
+func (InitiateMsg) Path() string {
+	return "recovery/initiate"
+}
+
+// Validate ensures the message is valid.
+func (m *InitiateMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if err := m.Account.Validate(); err != nil {
+		return errors.Wrap(err, "account")
+	}
+	if m.NewKey == nil || m.NewKey.Condition() == nil {
+		return errors.Wrap(errors.ErrEmpty, "new key")
+	}
+	return nil
+}
+
+var _ weave.Msg = (*ApproveMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ApproveMsg) Path() string {
+	return "recovery/approve"
+}
+
+// Validate ensures the message is valid.
+func (m *ApproveMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if err := m.Account.Validate(); err != nil {
+		return errors.Wrap(err, "account")
+	}
+	return nil
+}
+
+var _ weave.Msg = (*CancelMsg)(nil)
+
+// Path returns the routing path for this message.
+func (CancelMsg) Path() string {
+	return "recovery/cancel"
+}
+
+// Validate ensures the message is valid.
+func (m *CancelMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if err := m.Account.Validate(); err != nil {
+		return errors.Wrap(err, "account")
+	}
+	return nil
+}
+
+var _ weave.Msg = (*ExecuteMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ExecuteMsg) Path() string {
+	return "recovery/execute"
+}
+
+// Validate ensures the message is valid.
+func (m *ExecuteMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if err := m.Account.Validate(); err != nil {
+		return errors.Wrap(err, "account")
+	}
+	return nil
+}
+
+// validateGuardians returns an error if given guardians, threshold and delay
+// do not describe a valid recovery configuration. This check is done on model
+// and messages so instead of copying the code it is extracted into this
+// function.
+func validateGuardians(baseErr error, guardians []weave.Address, threshold uint32, delay weave.UnixDuration) error {
+	switch n := len(guardians); {
+	case n == 0:
+		return errors.Wrap(baseErr, "no guardians")
+	case n > maxGuardians:
+		return errors.Wrapf(baseErr, "too many guardians, max %d", maxGuardians)
+	}
+	seen := make(map[string]struct{}, len(guardians))
+	for i, g := range guardians {
+		if err := g.Validate(); err != nil {
+			return errors.Wrapf(err, "guardian %d", i)
+		}
+		if _, ok := seen[g.String()]; ok {
+			return errors.Wrapf(baseErr, "guardian %d is not unique", i)
+		}
+		seen[g.String()] = struct{}{}
+	}
+	if threshold == 0 || int(threshold) > len(guardians) {
+		return errors.Wrapf(baseErr, "threshold must be between 1 and %d", len(guardians))
+	}
+	if delay <= 0 {
+		return errors.Wrap(baseErr, "delay must be greater than zero")
+	}
+	return nil
+}
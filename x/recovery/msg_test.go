@@ -0,0 +1,101 @@
+package recovery
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestValidateConfigureMsg(t *testing.T) {
+	alice := weavetest.NewCondition().Address()
+	bobby := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid message": {
+			Msg: &ConfigureMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Guardians: []weave.Address{alice, bobby},
+				Threshold: 2,
+				Delay:     100,
+			},
+			WantErr: nil,
+		},
+		"missing metadata": {
+			Msg: &ConfigureMsg{
+				Guardians: []weave.Address{alice},
+				Threshold: 1,
+				Delay:     100,
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"no guardians": {
+			Msg: &ConfigureMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Threshold: 1,
+				Delay:     100,
+			},
+			WantErr: errors.ErrMsg,
+		},
+		"duplicated guardian": {
+			Msg: &ConfigureMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Guardians: []weave.Address{alice, alice},
+				Threshold: 1,
+				Delay:     100,
+			},
+			WantErr: errors.ErrMsg,
+		},
+		"threshold too high": {
+			Msg: &ConfigureMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Guardians: []weave.Address{alice, bobby},
+				Threshold: 3,
+				Delay:     100,
+			},
+			WantErr: errors.ErrMsg,
+		},
+		"zero threshold": {
+			Msg: &ConfigureMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Guardians: []weave.Address{alice},
+				Delay:     100,
+			},
+			WantErr: errors.ErrMsg,
+		},
+		"missing delay": {
+			Msg: &ConfigureMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Guardians: []weave.Address{alice},
+				Threshold: 1,
+			},
+			WantErr: errors.ErrMsg,
+		},
+		"initiate without new key": {
+			Msg: &InitiateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Account:  alice,
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"approve with invalid account": {
+			Msg: &ApproveMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Account:  []byte("xyz"),
+			},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
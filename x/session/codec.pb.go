@@ -0,0 +1,1316 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: x/session/codec.proto
+
+package session
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_iov_one_weave "github.com/iov-one/weave"
+	weave "github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	io "io"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// SessionKey is an additional key registered by an account. A transaction
+// signed with the session key is authorized by the account, but only for the
+// allowed message paths and only up to the spend cap.
+type SessionKey struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Owner is the condition of the account that registered the session key.
+	Owner github_com_iov_one_weave.Condition `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Condition" json:"owner,omitempty"`
+	// Key is the address of the session key signer.
+	Key github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=key,proto3,casttype=github.com/iov-one/weave.Address" json:"key,omitempty"`
+	// Allowed paths is a list of message paths, for example
+	// "username/change_token_targets", that the session key can authorize.
+	AllowedPaths []string `protobuf:"bytes,4,rep,name=allowed_paths,json=allowedPaths,proto3" json:"allowed_paths,omitempty"`
+	// Spend cap is the maximum amount that can be spent from the owner account
+	// using the session key, including fees. An empty spend cap does not allow
+	// any spending.
+	SpendCap []*coin.Coin `protobuf:"bytes,5,rep,name=spend_cap,json=spendCap,proto3" json:"spend_cap,omitempty"`
+	// Spent is the amount spent from the owner account using the session key.
+	Spent []*coin.Coin `protobuf:"bytes,6,rep,name=spent,proto3" json:"spent,omitempty"`
+	// Expires at is an optional time after which the session key can no longer
+	// be used.
+	ExpiresAt github_com_iov_one_weave.UnixTime `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"expires_at,omitempty"`
+}
+
+func (m *SessionKey) Reset()         { *m = SessionKey{} }
+func (m *SessionKey) String() string { return proto.CompactTextString(m) }
+func (*SessionKey) ProtoMessage()    {}
+func (*SessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2622db793e506623, []int{0}
+}
+func (m *SessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKey.Merge(m, src)
+}
+func (m *SessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKey proto.InternalMessageInfo
+
+func (m *SessionKey) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SessionKey) GetOwner() github_com_iov_one_weave.Condition {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *SessionKey) GetKey() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SessionKey) GetAllowedPaths() []string {
+	if m != nil {
+		return m.AllowedPaths
+	}
+	return nil
+}
+
+func (m *SessionKey) GetSpendCap() []*coin.Coin {
+	if m != nil {
+		return m.SpendCap
+	}
+	return nil
+}
+
+func (m *SessionKey) GetSpent() []*coin.Coin {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *SessionKey) GetExpiresAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// RegisterKeyMsg registers a session key for the main signer of the
+// transaction.
+type RegisterKeyMsg struct {
+	Metadata     *weave.Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Key          github_com_iov_one_weave.Address  `protobuf:"bytes,2,opt,name=key,proto3,casttype=github.com/iov-one/weave.Address" json:"key,omitempty"`
+	AllowedPaths []string                          `protobuf:"bytes,3,rep,name=allowed_paths,json=allowedPaths,proto3" json:"allowed_paths,omitempty"`
+	SpendCap     []*coin.Coin                      `protobuf:"bytes,4,rep,name=spend_cap,json=spendCap,proto3" json:"spend_cap,omitempty"`
+	ExpiresAt    github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"expires_at,omitempty"`
+}
+
+func (m *RegisterKeyMsg) Reset()         { *m = RegisterKeyMsg{} }
+func (m *RegisterKeyMsg) String() string { return proto.CompactTextString(m) }
+func (*RegisterKeyMsg) ProtoMessage()    {}
+func (*RegisterKeyMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2622db793e506623, []int{1}
+}
+func (m *RegisterKeyMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterKeyMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterKeyMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterKeyMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterKeyMsg.Merge(m, src)
+}
+func (m *RegisterKeyMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterKeyMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterKeyMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterKeyMsg proto.InternalMessageInfo
+
+func (m *RegisterKeyMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RegisterKeyMsg) GetKey() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *RegisterKeyMsg) GetAllowedPaths() []string {
+	if m != nil {
+		return m.AllowedPaths
+	}
+	return nil
+}
+
+func (m *RegisterKeyMsg) GetSpendCap() []*coin.Coin {
+	if m != nil {
+		return m.SpendCap
+	}
+	return nil
+}
+
+func (m *RegisterKeyMsg) GetExpiresAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// RevokeKeyMsg deletes a session key. Only the owner can revoke a session key.
+type RevokeKeyMsg struct {
+	Metadata     *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	SessionKeyID []byte          `protobuf:"bytes,2,opt,name=session_key_id,json=sessionKeyId,proto3" json:"session_key_id,omitempty"`
+}
+
+func (m *RevokeKeyMsg) Reset()         { *m = RevokeKeyMsg{} }
+func (m *RevokeKeyMsg) String() string { return proto.CompactTextString(m) }
+func (*RevokeKeyMsg) ProtoMessage()    {}
+func (*RevokeKeyMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2622db793e506623, []int{2}
+}
+func (m *RevokeKeyMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeKeyMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeKeyMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokeKeyMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeKeyMsg.Merge(m, src)
+}
+func (m *RevokeKeyMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeKeyMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeKeyMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeKeyMsg proto.InternalMessageInfo
+
+func (m *RevokeKeyMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RevokeKeyMsg) GetSessionKeyID() []byte {
+	if m != nil {
+		return m.SessionKeyID
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SessionKey)(nil), "session.SessionKey")
+	proto.RegisterType((*RegisterKeyMsg)(nil), "session.RegisterKeyMsg")
+	proto.RegisterType((*RevokeKeyMsg)(nil), "session.RevokeKeyMsg")
+}
+
+func init() { proto.RegisterFile("x/session/codec.proto", fileDescriptor_2622db793e506623) }
+
+var fileDescriptor_2622db793e506623 = []byte{
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0xd9, 0xa6, 0x6d, 0x26, 0x6b, 0x2d, 0x83, 0xc2, 0x90, 0xc3, 0x66, 0x8d, 0xbf,
+	0x16, 0xc4, 0x5d, 0xa8, 0xe0, 0xc9, 0x4b, 0x93, 0x5e, 0xa4, 0x14, 0x64, 0xd4, 0xf3, 0x32, 0xdd,
+	0x79, 0x6c, 0x87, 0x34, 0xf3, 0x96, 0x9d, 0x31, 0x3f, 0xfe, 0x04, 0x6f, 0xfe, 0x59, 0x1e, 0x7b,
+	0xf4, 0x14, 0x24, 0xc1, 0x7f, 0x22, 0x27, 0xd9, 0xec, 0x62, 0x2d, 0x52, 0xc5, 0xf4, 0xf6, 0xe6,
+	0xfb, 0xde, 0x67, 0x18, 0x3e, 0xbc, 0x21, 0x0f, 0x67, 0xb1, 0x01, 0x63, 0x14, 0xea, 0x38, 0x45,
+	0x09, 0x69, 0x94, 0x17, 0x68, 0x91, 0xee, 0xd5, 0x61, 0xb7, 0xf3, 0x5b, 0xda, 0x3d, 0x4c, 0x51,
+	0xdd, 0x98, 0xeb, 0x3e, 0xc8, 0x30, 0xc3, 0x4d, 0x19, 0x97, 0x55, 0x95, 0xf6, 0x7f, 0x34, 0x09,
+	0x79, 0x5f, 0x5d, 0x70, 0x0a, 0x73, 0xfa, 0x82, 0xec, 0x8f, 0xc1, 0x0a, 0x29, 0xac, 0x60, 0x4e,
+	0xe0, 0x84, 0x9d, 0xa3, 0xfb, 0xd1, 0x14, 0xc4, 0x04, 0xa2, 0xb3, 0x3a, 0xe6, 0xbf, 0x06, 0xe8,
+	0x1b, 0xd2, 0xc2, 0xa9, 0x86, 0x82, 0x35, 0x03, 0x27, 0xf4, 0x06, 0xcf, 0xd6, 0x8b, 0x5e, 0x3f,
+	0x53, 0xf6, 0xe2, 0xd3, 0x79, 0x94, 0xe2, 0x38, 0x56, 0x38, 0x79, 0x89, 0x1a, 0xe2, 0x8a, 0x1f,
+	0xa2, 0x96, 0xca, 0x2a, 0xd4, 0xbc, 0x82, 0xe8, 0x6b, 0xe2, 0x8e, 0x60, 0xce, 0xdc, 0x0d, 0xfb,
+	0x64, 0xbd, 0xe8, 0x05, 0xb7, 0xb2, 0xc7, 0x52, 0x16, 0x60, 0x0c, 0x2f, 0x01, 0xfa, 0x98, 0xdc,
+	0x13, 0x97, 0x97, 0x38, 0x05, 0x99, 0xe4, 0xc2, 0x5e, 0x18, 0xb6, 0x13, 0xb8, 0x61, 0x9b, 0x7b,
+	0x75, 0xf8, 0xae, 0xcc, 0xe8, 0x73, 0xd2, 0x36, 0x39, 0x68, 0x99, 0xa4, 0x22, 0x67, 0xad, 0xc0,
+	0x0d, 0x3b, 0x47, 0x24, 0x2a, 0x95, 0x44, 0x43, 0x54, 0x9a, 0xef, 0x6f, 0x9a, 0x43, 0x91, 0xd3,
+	0x80, 0xb4, 0xca, 0xda, 0xb2, 0xdd, 0x3f, 0x86, 0xaa, 0x06, 0x3d, 0x21, 0x04, 0x66, 0xb9, 0x2a,
+	0xc0, 0x24, 0xc2, 0xb2, 0xbd, 0xc0, 0x09, 0xdd, 0xc1, 0xd3, 0xf5, 0xa2, 0xf7, 0xe8, 0xd6, 0xe7,
+	0x7e, 0xd4, 0x6a, 0xf6, 0x41, 0x8d, 0x81, 0xb7, 0x6b, 0xf0, 0xd8, 0xf6, 0x3f, 0x37, 0xc9, 0x01,
+	0x87, 0x4c, 0x19, 0x0b, 0xc5, 0x29, 0xcc, 0xcf, 0x4c, 0xf6, 0x7f, 0xae, 0x6b, 0x5b, 0xcd, 0x3b,
+	0xdb, 0x72, 0xff, 0x65, 0x6b, 0xe7, 0x2f, 0xb6, 0x6e, 0xba, 0x68, 0x6d, 0xe9, 0xc2, 0x10, 0x8f,
+	0xc3, 0x04, 0x47, 0xb0, 0x9d, 0x88, 0x83, 0x7a, 0xe1, 0x93, 0x11, 0xcc, 0x13, 0x25, 0x6b, 0x27,
+	0x87, 0xcb, 0x45, 0xcf, 0xbb, 0xde, 0xe4, 0xb7, 0x27, 0xdc, 0x33, 0xd7, 0x27, 0x39, 0x60, 0x5f,
+	0x97, 0xbe, 0x73, 0xb5, 0xf4, 0x9d, 0xef, 0x4b, 0xdf, 0xf9, 0xb2, 0xf2, 0x1b, 0x57, 0x2b, 0xbf,
+	0xf1, 0x6d, 0xe5, 0x37, 0xce, 0x77, 0x37, 0x3f, 0xe1, 0xd5, 0xcf, 0x01, 0x00, 0x67, 0x2e, 0xf1,
+	0xcf, 0x60, 0x03, 0x00, 0x00,
+}
+
+func (m *SessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.AllowedPaths) > 0 {
+		for _, s := range m.AllowedPaths {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.SpendCap) > 0 {
+		for _, msg := range m.SpendCap {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, msg := range m.Spent {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExpiresAt))
+	}
+	return i, nil
+}
+
+func (m *RegisterKeyMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterKeyMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.AllowedPaths) > 0 {
+		for _, s := range m.AllowedPaths {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.SpendCap) > 0 {
+		for _, msg := range m.SpendCap {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExpiresAt))
+	}
+	return i, nil
+}
+
+func (m *RevokeKeyMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeKeyMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.SessionKeyID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SessionKeyID)))
+		i += copy(dAtA[i:], m.SessionKeyID)
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *SessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.AllowedPaths) > 0 {
+		for _, s := range m.AllowedPaths {
+			l = len(s)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.SpendCap) > 0 {
+		for _, e := range m.SpendCap {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovCodec(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *RegisterKeyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.AllowedPaths) > 0 {
+		for _, s := range m.AllowedPaths {
+			l = len(s)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.SpendCap) > 0 {
+		for _, e := range m.SpendCap {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovCodec(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *RevokeKeyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SessionKeyID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SessionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPaths = append(m.AllowedPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendCap = append(m.SpendCap, &coin.Coin{})
+			if err := m.SpendCap[len(m.SpendCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, &coin.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterKeyMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterKeyMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterKeyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPaths = append(m.AllowedPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendCap = append(m.SpendCap, &coin.Coin{})
+			if err := m.SpendCap[len(m.SpendCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevokeKeyMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokeKeyMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokeKeyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeyID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeyID = append(m.SessionKeyID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionKeyID == nil {
+				m.SessionKeyID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCodec(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCodec
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthCodec
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipCodec(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthCodec
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthCodec = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCodec   = fmt.Errorf("proto: integer overflow")
+)
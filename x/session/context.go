@@ -0,0 +1,44 @@
+package session
+
+import (
+	"context"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/x"
+)
+
+type contextKey int // local to the session module
+
+const (
+	contextKeySession contextKey = iota
+)
+
+// withOwner is a private method, as only this module can authorize an owner
+// of a session key.
+func withOwner(ctx weave.Context, owner weave.Condition) weave.Context {
+	val, _ := ctx.Value(contextKeySession).([]weave.Condition)
+	return context.WithValue(ctx, contextKeySession, append(val, owner))
+}
+
+// Authenticate gets permissions granted by session keys.
+type Authenticate struct {
+}
+
+var _ x.Authenticator = Authenticate{}
+
+// GetConditions returns permissions previously set on this context.
+func (a Authenticate) GetConditions(ctx weave.Context) []weave.Condition {
+	// (val, ok) form to return nil instead of panic if unset
+	val, _ := ctx.Value(contextKeySession).([]weave.Condition)
+	return val
+}
+
+// HasAddress returns true iff this address is in GetConditions.
+func (a Authenticate) HasAddress(ctx weave.Context, addr weave.Address) bool {
+	for _, s := range a.GetConditions(ctx) {
+		if addr.Equals(s.Address()) {
+			return true
+		}
+	}
+	return false
+}
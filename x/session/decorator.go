@@ -0,0 +1,220 @@
+package session
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x"
+)
+
+const (
+	sessionKeyGasCost = 10
+)
+
+// CashController is the subset of cash.Controller that is required to track
+// the spending of a session key.
+type CashController interface {
+	Balance(weave.KVStore, weave.Address) (coin.Coins, error)
+}
+
+// Decorator authorizes owners of session keys that signed the transaction.
+type Decorator struct {
+	auth   x.Authenticator
+	bucket orm.ModelBucket
+	ctrl   CashController
+}
+
+var _ weave.Decorator = Decorator{}
+
+// NewDecorator returns a default session key decorator. Given controller is
+// used to read the balance of session key owners.
+func NewDecorator(auth x.Authenticator, ctrl CashController) Decorator {
+	return Decorator{auth: auth, bucket: NewBucket(), ctrl: ctrl}
+}
+
+// Check authorizes session key owners before calling down the stack.
+func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	newCtx, keys, err := d.authSession(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+	var res *weave.CheckResult
+	err = d.trackSpending(store, keys, func(db weave.KVStore) error {
+		var err error
+		res, err = next.Check(newCtx, db, tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.GasPayment += int64(len(keys)) * sessionKeyGasCost
+	return res, nil
+}
+
+// Deliver authorizes session key owners before calling down the stack.
+func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	newCtx, keys, err := d.authSession(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+	var res *weave.DeliverResult
+	err = d.trackSpending(store, keys, func(db weave.KVStore) error {
+		var err error
+		res, err = next.Deliver(newCtx, db, tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// usedKey is a session key that authorized the transaction.
+type usedKey struct {
+	id  []byte
+	key *SessionKey
+}
+
+func (d Decorator) authSession(ctx weave.Context, db weave.KVStore, tx weave.Tx) (weave.Context, []usedKey, error) {
+	signers := d.auth.GetConditions(ctx)
+	if len(signers) == 0 {
+		return ctx, nil, nil
+	}
+
+	var (
+		msg  weave.Msg
+		used []usedKey
+	)
+	for _, signer := range signers {
+		var keys []*SessionKey
+		ids, err := d.bucket.ByIndex(db, "key", signer.Address(), &keys)
+		if err != nil {
+			return ctx, nil, errors.Wrap(err, "cannot query session keys")
+		}
+		for i, sk := range keys {
+			if sk.ExpiresAt != 0 && weave.IsExpired(ctx, sk.ExpiresAt) {
+				continue
+			}
+			if msg == nil {
+				if msg, err = tx.GetMsg(); err != nil {
+					return ctx, nil, errors.Wrap(err, "cannot get message")
+				}
+			}
+			// The signer might be signing for its own account only,
+			// so a session key that does not allow the message is
+			// ignored instead of failing the transaction.
+			if !sk.Allows(msg.Path()) {
+				continue
+			}
+			used = append(used, usedKey{id: ids[i], key: sk})
+			ctx = withOwner(ctx, sk.Owner)
+		}
+	}
+	return ctx, used, nil
+}
+
+// trackSpending calls next and counts everything that left the account of a
+// session key owner toward the spend cap of that session key. This includes
+// funds moved by any handler and fees paid by the owner.
+//
+// Spending is recorded only if next succeeds. If the spend cap is exceeded,
+// all changes made by next are discarded.
+func (d Decorator) trackSpending(db weave.KVStore, keys []usedKey, next func(weave.KVStore) error) error {
+	if len(keys) == 0 {
+		return next(db)
+	}
+	cstore, ok := db.(weave.CacheableKVStore)
+	if !ok {
+		return errors.Wrap(errors.ErrHuman, "session key spending requires a cacheable store")
+	}
+
+	before := make([]coin.Coins, len(keys))
+	for i, k := range keys {
+		b, err := d.balance(db, k.key.Owner.Address())
+		if err != nil {
+			return err
+		}
+		before[i] = b
+	}
+
+	cache := cstore.CacheWrap()
+	if err := next(cache); err != nil {
+		// Whatever the stack below decided to keep despite the
+		// failure, for example a fee payment, must be preserved.
+		if werr := cache.Write(); werr != nil {
+			return errors.Wrap(werr, "cannot write cache")
+		}
+		return err
+	}
+
+	for i, k := range keys {
+		after, err := d.balance(cache, k.key.Owner.Address())
+		if err != nil {
+			cache.Discard()
+			return err
+		}
+		spent, err := spending(before[i], after)
+		if err != nil {
+			cache.Discard()
+			return err
+		}
+		if len(spent) == 0 {
+			continue
+		}
+		total := coin.Coins(k.key.Spent).Clone()
+		for _, c := range spent {
+			if total, err = total.Add(*c); err != nil {
+				cache.Discard()
+				return errors.Wrap(err, "cannot add spent amount")
+			}
+		}
+		for _, c := range total {
+			if !coin.Coins(k.key.SpendCap).Contains(*c) {
+				cache.Discard()
+				return errors.Wrapf(errors.ErrUnauthorized, "session key spend cap exceeded: %s", c)
+			}
+		}
+		k.key.Spent = total
+		if _, err := d.bucket.Put(cache, k.id, k.key); err != nil {
+			cache.Discard()
+			return errors.Wrap(err, "cannot save session key")
+		}
+	}
+	if err := cache.Write(); err != nil {
+		return errors.Wrap(err, "cannot write cache")
+	}
+	return nil
+}
+
+// balance returns the balance of given account. An account without a wallet
+// has an empty balance.
+func (d Decorator) balance(db weave.KVStore, addr weave.Address) (coin.Coins, error) {
+	b, err := d.ctrl.Balance(db, addr)
+	if err != nil && !errors.ErrNotFound.Is(err) {
+		return nil, errors.Wrap(err, "cannot get owner balance")
+	}
+	return b, nil
+}
+
+// spending returns the amount of each currency by which the balance
+// decreased.
+func spending(before, after coin.Coins) (coin.Coins, error) {
+	var spent coin.Coins
+	for _, b := range before {
+		left := coin.NewCoin(0, 0, b.Ticker)
+		for _, a := range after {
+			if a.Ticker == b.Ticker {
+				left = *a
+			}
+		}
+		diff, err := b.Subtract(left)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot compute spending")
+		}
+		if diff.IsPositive() {
+			spent = append(spent, &diff)
+		}
+	}
+	return spent, nil
+}
@@ -0,0 +1,207 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/utils"
+)
+
+func TestDecorator(t *testing.T) {
+	alice := weavetest.NewCondition()
+	session := weavetest.NewCondition()
+	expired := weavetest.NewCondition()
+	now := time.Now()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName, "cash")
+	bucket := NewBucket()
+	ctrl := cash.NewController(cash.NewBucket())
+	assert.Nil(t, ctrl.CoinMint(db, alice.Address(), coin.NewCoin(100, 0, "IOV")))
+	assert.Nil(t, ctrl.CoinMint(db, alice.Address(), coin.NewCoin(100, 0, "ETH")))
+
+	sessionID, err := bucket.Put(db, nil, &SessionKey{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Owner:        alice,
+		Key:          session.Address(),
+		AllowedPaths: []string{"cash/send", "escrow/create", "username/change_token_targets"},
+		SpendCap:     []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+	})
+	assert.Nil(t, err)
+	_, err = bucket.Put(db, nil, &SessionKey{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Owner:        alice,
+		Key:          expired.Address(),
+		AllowedPaths: []string{"cash/send"},
+		ExpiresAt:    weave.AsUnixTime(now.Add(-time.Hour)),
+	})
+	assert.Nil(t, err)
+
+	sendTx := &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "cash/send"}}
+	escrowTx := &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "escrow/create"}}
+
+	// Steps are executed in order and share the session key state.
+	steps := []struct {
+		name    string
+		tx      weave.Tx
+		signers []weave.Condition
+		// Amounts moved out of the owner account by the handler.
+		moves      []coin.Coin
+		handlerErr error
+		wantOwner  bool
+		wantErr    *errors.Error
+		wantSpent  coin.Coins
+	}{
+		{
+			name:      "not a session key",
+			tx:        sendTx,
+			signers:   []weave.Condition{weavetest.NewCondition()},
+			wantOwner: false,
+		},
+		{
+			name:      "expired session key",
+			tx:        sendTx,
+			signers:   []weave.Condition{expired},
+			wantOwner: false,
+		},
+		{
+			name:      "message path not allowed",
+			tx:        &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "gov/vote"}},
+			signers:   []weave.Condition{session},
+			wantOwner: false,
+		},
+		{
+			name:      "allowed message without spending",
+			tx:        &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "username/change_token_targets"}},
+			signers:   []weave.Condition{session},
+			wantOwner: true,
+		},
+		{
+			name:      "spending below the cap",
+			tx:        sendTx,
+			signers:   []weave.Condition{session},
+			moves:     []coin.Coin{coin.NewCoin(6, 0, "IOV")},
+			wantOwner: true,
+			wantSpent: coin.Coins{coin.NewCoinp(6, 0, "IOV")},
+		},
+		{
+			name:       "failed handler does not count toward the cap",
+			tx:         sendTx,
+			signers:    []weave.Condition{session},
+			moves:      []coin.Coin{coin.NewCoin(1, 0, "IOV")},
+			handlerErr: errors.ErrState,
+			wantErr:    errors.ErrState,
+			wantSpent:  coin.Coins{coin.NewCoinp(6, 0, "IOV")},
+		},
+		{
+			name:      "spending by any message above the cap",
+			tx:        escrowTx,
+			signers:   []weave.Condition{session},
+			moves:     []coin.Coin{coin.NewCoin(3, 0, "IOV"), coin.NewCoin(2, 0, "IOV")},
+			wantErr:   errors.ErrUnauthorized,
+			wantSpent: coin.Coins{coin.NewCoinp(6, 0, "IOV")},
+		},
+		{
+			name:      "spending by any message up to the cap",
+			tx:        escrowTx,
+			signers:   []weave.Condition{session},
+			moves:     []coin.Coin{coin.NewCoin(3, 0, "IOV"), coin.NewCoin(1, 0, "IOV")},
+			wantOwner: true,
+			wantSpent: coin.Coins{coin.NewCoinp(10, 0, "IOV")},
+		},
+		{
+			name:      "spending currency without a cap",
+			tx:        sendTx,
+			signers:   []weave.Condition{session},
+			moves:     []coin.Coin{coin.NewCoin(1, 0, "ETH")},
+			wantErr:   errors.ErrUnauthorized,
+			wantSpent: coin.Coins{coin.NewCoinp(10, 0, "IOV")},
+		},
+	}
+
+	for _, step := range steps {
+		ctx := weave.WithBlockTime(context.Background(), now)
+		auth := &weavetest.CtxAuth{Key: "auth"}
+		ctx = auth.SetConditions(ctx, step.signers...)
+		d := NewDecorator(auth, ctrl)
+
+		hn := sessionCheckHandler{
+			ctrl:  ctrl,
+			owner: alice.Address(),
+			moves: step.moves,
+			err:   step.handlerErr,
+		}
+		// The savepoint rolls back a failed handler, like the fee
+		// decorator does in a real application stack.
+		stack := weavetest.Decorate(weavetest.Decorate(&hn, utils.NewSavepoint().OnDeliver()), d)
+
+		balance, err := ctrl.Balance(db, alice.Address())
+		assert.Nil(t, err)
+
+		cache := db.CacheWrap()
+		if _, err := stack.Deliver(ctx, cache, step.tx); !step.wantErr.Is(err) {
+			t.Fatalf("%s: unexpected error: %+v", step.name, err)
+		}
+		assert.Nil(t, cache.Write())
+
+		if step.wantErr == nil {
+			got := x.ChainAuth(auth, Authenticate{}).HasAddress(hn.ctx, alice.Address())
+			if got != step.wantOwner {
+				t.Fatalf("%s: want owner authorized %v, got %v", step.name, step.wantOwner, got)
+			}
+		} else {
+			// A rejected transaction must not move any funds.
+			after, err := ctrl.Balance(db, alice.Address())
+			assert.Nil(t, err)
+			if !balance.Equals(after) {
+				t.Fatalf("%s: want balance %v, got %v", step.name, balance, after)
+			}
+		}
+
+		var sk SessionKey
+		assert.Nil(t, bucket.One(db, sessionID, &sk))
+		if !coin.Coins(sk.Spent).Equals(step.wantSpent) {
+			t.Fatalf("%s: want %v spent, got %v", step.name, step.wantSpent, sk.Spent)
+		}
+	}
+}
+
+// sessionCheckHandler stores the context of the last call. On delivery it
+// moves given amounts out of the owner account and returns given error.
+type sessionCheckHandler struct {
+	ctx   weave.Context
+	ctrl  cash.Controller
+	owner weave.Address
+	moves []coin.Coin
+	err   error
+}
+
+var _ weave.Handler = (*sessionCheckHandler)(nil)
+
+func (s *sessionCheckHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	s.ctx = ctx
+	return &weave.CheckResult{}, nil
+}
+
+func (s *sessionCheckHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	s.ctx = ctx
+	for _, c := range s.moves {
+		if err := s.ctrl.MoveCoins(store, s.owner, weavetest.NewCondition().Address(), c); err != nil {
+			return nil, err
+		}
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &weave.DeliverResult{}, nil
+}
@@ -0,0 +1,25 @@
+/*
+Package session implements scoped session keys.
+
+A signature is all-or-nothing: a key that signed a transaction authorizes any
+message. This extension allows an account to register additional session keys
+that can act on behalf of the account, but only within a limited scope.
+
+A session key is restricted to a list of message paths (for example
+username/change_token_targets) and to a spend cap. The spend cap does not
+depend on the message type. The balance of the account is compared before and
+after a transaction authorized by the session key is processed and any decrease,
+including fees, is counted toward the spend cap. A transaction that would exceed
+the spend cap is rejected without any change. A failed transaction is not
+counted. Optionally a session key can expire.
+
+The Decorator must be placed after the signature verification and before the
+routing. For every signer of a transaction it finds the session keys
+registered for that signer. If the message path is allowed and the spend cap is
+not exceeded, the owner of the session key is added to the authentication
+conditions.
+
+Session keys can never authorize messages of this package, so a session key
+cannot be used to register or revoke other session keys.
+*/
+package session
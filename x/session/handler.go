@@ -0,0 +1,135 @@
+package session
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x"
+)
+
+const (
+	registerKeyCost int64 = 100
+	revokeKeyCost   int64 = 0
+)
+
+// RegisterRoutes will instantiate and register all handlers in this package.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
+	r = migration.SchemaMigratingRegistry(packageName, r)
+	bucket := NewBucket()
+	r.Handle(&RegisterKeyMsg{}, RegisterKeyHandler{auth, bucket})
+	r.Handle(&RevokeKeyMsg{}, RevokeKeyHandler{auth, bucket})
+}
+
+// RegisterQuery will register this bucket as "/sessionkeys".
+func RegisterQuery(qr weave.QueryRouter) {
+	NewBucket().Register("sessionkeys", qr)
+}
+
+// RegisterKeyHandler registers a session key for the main signer.
+type RegisterKeyHandler struct {
+	auth   x.Authenticator
+	bucket orm.ModelBucket
+}
+
+var _ weave.Handler = RegisterKeyHandler{}
+
+func (h RegisterKeyHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: registerKeyCost}, nil
+}
+
+func (h RegisterKeyHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, owner, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	key, err := sessionKeySeq.NextVal(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot acquire key")
+	}
+	sk := &SessionKey{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Owner:        owner,
+		Key:          msg.Key,
+		AllowedPaths: msg.AllowedPaths,
+		SpendCap:     msg.SpendCap,
+		ExpiresAt:    msg.ExpiresAt,
+	}
+	if _, err := h.bucket.Put(db, key, sk); err != nil {
+		return nil, errors.Wrap(err, "cannot store session key")
+	}
+	return &weave.DeliverResult{Data: key}, nil
+}
+
+func (h RegisterKeyHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*RegisterKeyMsg, weave.Condition, error) {
+	var msg RegisterKeyMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	owner := x.MainSigner(ctx, h.auth)
+	if owner == nil {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "no signer")
+	}
+	if owner.Address().Equals(msg.Key) {
+		return nil, nil, errors.Wrap(errors.ErrInput, "account cannot be its own session key")
+	}
+	if msg.ExpiresAt != 0 && weave.IsExpired(ctx, msg.ExpiresAt) {
+		return nil, nil, errors.Wrap(errors.ErrExpired, "expiration time in the past")
+	}
+	var registered []SessionKey
+	if _, err := h.bucket.ByIndex(db, "key", msg.Key, &registered); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot query session keys")
+	}
+	for _, sk := range registered {
+		if bytes.Equal(sk.Owner, owner) {
+			return nil, nil, errors.Wrap(errors.ErrDuplicate, "session key already registered")
+		}
+	}
+	return &msg, owner, nil
+}
+
+// RevokeKeyHandler deletes a session key.
+type RevokeKeyHandler struct {
+	auth   x.Authenticator
+	bucket orm.ModelBucket
+}
+
+var _ weave.Handler = RevokeKeyHandler{}
+
+func (h RevokeKeyHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: revokeKeyCost}, nil
+}
+
+func (h RevokeKeyHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.bucket.Delete(db, msg.SessionKeyID); err != nil {
+		return nil, errors.Wrap(err, "cannot delete session key")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h RevokeKeyHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*RevokeKeyMsg, error) {
+	var msg RevokeKeyMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	var sk SessionKey
+	if err := h.bucket.One(db, msg.SessionKeyID, &sk); err != nil {
+		return nil, errors.Wrap(err, "cannot load session key")
+	}
+	if !h.auth.HasAddress(ctx, sk.Owner.Address()) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "only the owner can revoke a session key")
+	}
+	return &msg, nil
+}
@@ -0,0 +1,73 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestRegisterAndRevokeKey(t *testing.T) {
+	alice := weavetest.NewCondition()
+	bobby := weavetest.NewCondition()
+	session := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth)
+
+	register := &weavetest.Tx{Msg: &RegisterKeyMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Key:          session.Address(),
+		AllowedPaths: []string{"username/change_token_targets"},
+	}}
+
+	if _, err := rt.Deliver(context.Background(), db, register); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+
+	aliceCtx := auth.SetConditions(context.Background(), alice)
+	res, err := rt.Deliver(aliceCtx, db, register)
+	if err != nil {
+		t.Fatalf("cannot register session key: %s", err)
+	}
+	id := res.Data
+
+	var sk SessionKey
+	assert.Nil(t, NewBucket().One(db, id, &sk))
+	assert.Equal(t, alice, sk.Owner)
+	assert.Equal(t, session.Address(), sk.Key)
+
+	if _, err := rt.Deliver(aliceCtx, db, register); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %+v", err)
+	}
+
+	// The same key can be registered by another account.
+	bobbyCtx := auth.SetConditions(context.Background(), bobby)
+	if _, err := rt.Deliver(bobbyCtx, db, register); err != nil {
+		t.Fatalf("cannot register session key: %s", err)
+	}
+
+	revoke := &weavetest.Tx{Msg: &RevokeKeyMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		SessionKeyID: id,
+	}}
+	if _, err := rt.Deliver(bobbyCtx, db, revoke); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	if _, err := rt.Deliver(aliceCtx, db, revoke); err != nil {
+		t.Fatalf("cannot revoke session key: %s", err)
+	}
+	if err := NewBucket().One(db, id, &sk); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want session key to be deleted, got %+v", err)
+	}
+}
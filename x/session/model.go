@@ -0,0 +1,106 @@
+package session
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+)
+
+func init() {
+	migration.MustRegister(1, &SessionKey{}, migration.NoModification)
+}
+
+var _ orm.CloneableData = (*SessionKey)(nil)
+
+// Validate ensures the session key is valid.
+func (s *SessionKey) Validate() error {
+	if err := s.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if err := s.Owner.Validate(); err != nil {
+		return errors.Wrap(err, "owner")
+	}
+	if err := s.Key.Validate(); err != nil {
+		return errors.Wrap(err, "key")
+	}
+	if err := validateScope(errors.ErrModel, s.AllowedPaths, s.SpendCap); err != nil {
+		return err
+	}
+	if err := coin.Coins(s.Spent).Validate(); err != nil {
+		return errors.Wrap(err, "spent")
+	}
+	if s.ExpiresAt != 0 {
+		if err := s.ExpiresAt.Validate(); err != nil {
+			return errors.Wrap(err, "expires at")
+		}
+	}
+	return nil
+}
+
+// Copy makes a new session key.
+func (s *SessionKey) Copy() orm.CloneableData {
+	paths := make([]string, len(s.AllowedPaths))
+	copy(paths, s.AllowedPaths)
+	return &SessionKey{
+		Metadata:     s.Metadata.Copy(),
+		Owner:        append(weave.Condition(nil), s.Owner...),
+		Key:          s.Key.Clone(),
+		AllowedPaths: paths,
+		SpendCap:     coin.Coins(s.SpendCap).Clone(),
+		Spent:        coin.Coins(s.Spent).Clone(),
+		ExpiresAt:    s.ExpiresAt,
+	}
+}
+
+// Allows returns true if the session key can authorize a message with given
+// path.
+func (s *SessionKey) Allows(path string) bool {
+	for _, p := range s.AllowedPaths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// NewBucket returns a bucket for storing session keys. Session keys are
+// indexed by the owner address and by the session key address.
+func NewBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("sessionkey", &SessionKey{},
+		orm.WithIDSequence(sessionKeySeq),
+		orm.WithIndex("owner", idxOwner, false),
+		orm.WithIndex("key", idxKey, false),
+	)
+	return migration.NewModelBucket(packageName, b)
+}
+
+var sessionKeySeq = orm.NewSequence("sessionkey", "id")
+
+func toSessionKey(obj orm.Object) (*SessionKey, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "Cannot take index of nil")
+	}
+	sk, ok := obj.Value().(*SessionKey)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "Can only take index of SessionKey")
+	}
+	return sk, nil
+}
+
+func idxOwner(obj orm.Object) ([]byte, error) {
+	sk, err := toSessionKey(obj)
+	if err != nil {
+		return nil, err
+	}
+	return sk.Owner.Address(), nil
+}
+
+func idxKey(obj orm.Object) ([]byte, error) {
+	sk, err := toSessionKey(obj)
+	if err != nil {
+		return nil, err
+	}
+	return sk.Key, nil
+}
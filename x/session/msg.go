@@ -0,0 +1,91 @@
+package session
+
+import (
+	"strings"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &RegisterKeyMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RevokeKeyMsg{}, migration.NoModification)
+}
+
+const (
+	packageName = "session"
+
+	// To avoid burning CPU, this is the maximum number of message paths
+	// a single session key can be allowed to authorize.
+	maxAllowedPaths = 32
+)
+
+var _ weave.Msg = (*RegisterKeyMsg)(nil)
+
+// Path returns the routing path for this message.
+func (RegisterKeyMsg) Path() string {
+	return "session/register_key"
+}
+
+// Validate ensures the message is valid.
+func (m *RegisterKeyMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if err := m.Key.Validate(); err != nil {
+		return errors.Wrap(err, "key")
+	}
+	if err := validateScope(errors.ErrMsg, m.AllowedPaths, m.SpendCap); err != nil {
+		return err
+	}
+	if m.ExpiresAt != 0 {
+		if err := m.ExpiresAt.Validate(); err != nil {
+			return errors.Wrap(err, "expires at")
+		}
+	}
+	return nil
+}
+
+var _ weave.Msg = (*RevokeKeyMsg)(nil)
+
+// Path returns the routing path for this message.
+func (RevokeKeyMsg) Path() string {
+	return "session/revoke_key"
+}
+
+// Validate ensures the message is valid.
+func (m *RevokeKeyMsg) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if len(m.SessionKeyID) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "session key ID")
+	}
+	return nil
+}
+
+// validateScope returns an error if given allowed paths and spend cap do not
+// describe a valid session key scope. This check is done on model and
+// messages so instead of copying the code it is extracted into this function.
+func validateScope(baseErr error, paths []string, spendCap []*coin.Coin) error {
+	switch n := len(paths); {
+	case n == 0:
+		return errors.Wrap(baseErr, "no allowed paths")
+	case n > maxAllowedPaths:
+		return errors.Wrapf(baseErr, "too many allowed paths, max %d", maxAllowedPaths)
+	}
+	for _, p := range paths {
+		if i := strings.Index(p, "/"); i < 1 || i == len(p)-1 {
+			return errors.Wrapf(baseErr, "invalid message path %q", p)
+		}
+		if strings.HasPrefix(p, packageName+"/") {
+			return errors.Wrapf(baseErr, "session key cannot authorize %q", p)
+		}
+	}
+	if err := coin.Coins(spendCap).Validate(); err != nil {
+		return errors.Wrap(err, "spend cap")
+	}
+	return nil
+}
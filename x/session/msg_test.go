@@ -0,0 +1,105 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestRegisterKeyMsg(t *testing.T) {
+	cases := map[string]struct {
+		Mutator func(msg *RegisterKeyMsg)
+		WantErr *errors.Error
+	}{
+		"valid message": {},
+		"valid message without spend cap": {
+			Mutator: func(msg *RegisterKeyMsg) {
+				msg.SpendCap = nil
+			},
+		},
+		"missing metadata": {
+			Mutator: func(msg *RegisterKeyMsg) {
+				msg.Metadata = nil
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"missing key": {
+			Mutator: func(msg *RegisterKeyMsg) {
+				msg.Key = nil
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"no allowed paths": {
+			Mutator: func(msg *RegisterKeyMsg) {
+				msg.AllowedPaths = nil
+			},
+			WantErr: errors.ErrMsg,
+		},
+		"invalid path": {
+			Mutator: func(msg *RegisterKeyMsg) {
+				msg.AllowedPaths = []string{"cash/"}
+			},
+			WantErr: errors.ErrMsg,
+		},
+		"session key management path": {
+			Mutator: func(msg *RegisterKeyMsg) {
+				msg.AllowedPaths = []string{"session/revoke_key"}
+			},
+			WantErr: errors.ErrMsg,
+		},
+		"invalid spend cap": {
+			Mutator: func(msg *RegisterKeyMsg) {
+				msg.SpendCap = []*coin.Coin{coin.NewCoinp(0, 0, "IOV")}
+			},
+			WantErr: errors.ErrState,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			msg := &RegisterKeyMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Key:          weavetest.NewCondition().Address(),
+				AllowedPaths: []string{"cash/send", "username/change_token_targets"},
+				SpendCap:     []*coin.Coin{coin.NewCoinp(10, 0, "IOV")},
+			}
+			if tc.Mutator != nil {
+				tc.Mutator(msg)
+			}
+			if err := msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestRevokeKeyMsg(t *testing.T) {
+	cases := map[string]struct {
+		Msg     *RevokeKeyMsg
+		WantErr *errors.Error
+	}{
+		"valid message": {
+			Msg: &RevokeKeyMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				SessionKeyID: weavetest.SequenceID(1),
+			},
+		},
+		"missing session key ID": {
+			Msg: &RevokeKeyMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
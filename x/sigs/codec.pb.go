@@ -5,7 +5,9 @@ package sigs
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_iov_one_weave "github.com/iov-one/weave"
 	weave "github.com/iov-one/weave"
 	crypto "github.com/iov-one/weave/crypto"
 	io "io"
@@ -32,6 +34,10 @@ type UserData struct {
 	Metadata *weave.Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Pubkey   *crypto.PublicKey `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	Sequence int64             `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Controller is the public key that controls the account after its key was
+	// rotated. When set, only the controller can sign on behalf of the account
+	// and the original public key is no longer accepted.
+	Controller *crypto.PublicKey `protobuf:"bytes,4,opt,name=controller,proto3" json:"controller,omitempty"`
 }
 
 func (m *UserData) Reset()         { *m = UserData{} }
@@ -88,6 +94,13 @@ func (m *UserData) GetSequence() int64 {
 	return 0
 }
 
+func (m *UserData) GetController() *crypto.PublicKey {
+	if m != nil {
+		return m.Controller
+	}
+	return nil
+}
+
 // StdSignature represents the signature, the identity of the signer
 // (the Pubkey), and a sequence number to prevent replay attacks.
 //
@@ -98,6 +111,16 @@ type StdSignature struct {
 	Pubkey   *crypto.PublicKey `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	// Removed Address, Pubkey is more powerful
 	Signature *crypto.Signature `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Lane is the ID of the nonce lane that the sequence belongs to. Each lane
+	// of an account maintains an independent sequence, so that transactions
+	// signed using different lanes do not depend on each other. Default lane
+	// is zero and it is using the UserData sequence.
+	Lane uint32 `protobuf:"varint,5,opt,name=lane,proto3" json:"lane,omitempty"`
+	// Account is the address of the account that the signature is created for.
+	// It must be set only when signing on behalf of an account with a rotated
+	// key. In that case the signature must be created using the account
+	// controller key and the account sequence is used.
+	Account github_com_iov_one_weave.Address `protobuf:"bytes,6,opt,name=account,proto3,casttype=github.com/iov-one/weave.Address" json:"account,omitempty"`
 }
 
 func (m *StdSignature) Reset()         { *m = StdSignature{} }
@@ -154,6 +177,76 @@ func (m *StdSignature) GetSignature() *crypto.Signature {
 	return nil
 }
 
+func (m *StdSignature) GetLane() uint32 {
+	if m != nil {
+		return m.Lane
+	}
+	return 0
+}
+
+func (m *StdSignature) GetAccount() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+// LaneData stores the sequence of a single nonce lane of an account.
+// Key is the address of the account followed by the lane ID encoded as a 4
+// byte big endian number. Lane zero is never stored as LaneData, because its
+// sequence is stored in the UserData.
+type LaneData struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Sequence int64           `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *LaneData) Reset()         { *m = LaneData{} }
+func (m *LaneData) String() string { return proto.CompactTextString(m) }
+func (*LaneData) ProtoMessage()    {}
+func (*LaneData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3400434997a8ae, []int{2}
+}
+func (m *LaneData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaneData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaneData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaneData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaneData.Merge(m, src)
+}
+func (m *LaneData) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaneData) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaneData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaneData proto.InternalMessageInfo
+
+func (m *LaneData) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *LaneData) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 // BumpSequenceMsg increments a sequence counter by given amount for a user
 // that signed the transaction.
 type BumpSequenceMsg struct {
@@ -170,7 +263,7 @@ func (m *BumpSequenceMsg) Reset()         { *m = BumpSequenceMsg{} }
 func (m *BumpSequenceMsg) String() string { return proto.CompactTextString(m) }
 func (*BumpSequenceMsg) ProtoMessage()    {}
 func (*BumpSequenceMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f3400434997a8ae, []int{2}
+	return fileDescriptor_1f3400434997a8ae, []int{3}
 }
 func (m *BumpSequenceMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,34 +306,225 @@ func (m *BumpSequenceMsg) GetIncrement() uint32 {
 	return 0
 }
 
+// AggregatedSignature is a single BLS12-381 signature that replaces the
+// signatures of many signers. All signers sign the same message that is built
+// from the transaction and the sequences of all signers. Signature is
+// verified against the aggregated public key of all signers.
+//
+// To prevent a rogue key attack, each signer public key must be registered by
+// signing at least one transaction with a standalone signature first.
+type AggregatedSignature struct {
+	Signers   []*AggregatedSigner `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers,omitempty"`
+	Signature *crypto.Signature   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *AggregatedSignature) Reset()         { *m = AggregatedSignature{} }
+func (m *AggregatedSignature) String() string { return proto.CompactTextString(m) }
+func (*AggregatedSignature) ProtoMessage()    {}
+func (*AggregatedSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3400434997a8ae, []int{4}
+}
+func (m *AggregatedSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregatedSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedSignature.Merge(m, src)
+}
+func (m *AggregatedSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedSignature proto.InternalMessageInfo
+
+func (m *AggregatedSignature) GetSigners() []*AggregatedSigner {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *AggregatedSignature) GetSignature() *crypto.Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// AggregatedSigner is a single participant of an aggregated signature.
+type AggregatedSigner struct {
+	Sequence int64             `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Pubkey   *crypto.PublicKey `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+}
+
+func (m *AggregatedSigner) Reset()         { *m = AggregatedSigner{} }
+func (m *AggregatedSigner) String() string { return proto.CompactTextString(m) }
+func (*AggregatedSigner) ProtoMessage()    {}
+func (*AggregatedSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3400434997a8ae, []int{5}
+}
+func (m *AggregatedSigner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedSigner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregatedSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedSigner.Merge(m, src)
+}
+func (m *AggregatedSigner) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedSigner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedSigner proto.InternalMessageInfo
+
+func (m *AggregatedSigner) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AggregatedSigner) GetPubkey() *crypto.PublicKey {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+// TxExpiration limits the validity of a transaction to a window of blocks.
+// A transaction with an expiration cannot be replayed after it expires, for
+// example on a hard-forked chain that is sharing the same chain ID.
+//
+// Expiration is part of the transaction and therefore it is signed.
+type TxExpiration struct {
+	// Height is the last block height at which the transaction is valid. Zero
+	// means no height limit.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Time is the time at which the transaction expires. Expiration is
+	// inclusive, so the transaction is no longer valid in a block with the
+	// same time. Zero means no time limit.
+	Time github_com_iov_one_weave.UnixTime `protobuf:"varint,2,opt,name=time,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"time,omitempty"`
+}
+
+func (m *TxExpiration) Reset()         { *m = TxExpiration{} }
+func (m *TxExpiration) String() string { return proto.CompactTextString(m) }
+func (*TxExpiration) ProtoMessage()    {}
+func (*TxExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3400434997a8ae, []int{6}
+}
+func (m *TxExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxExpiration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxExpiration.Merge(m, src)
+}
+func (m *TxExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxExpiration proto.InternalMessageInfo
+
+func (m *TxExpiration) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TxExpiration) GetTime() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*UserData)(nil), "sigs.UserData")
 	proto.RegisterType((*StdSignature)(nil), "sigs.StdSignature")
+	proto.RegisterType((*LaneData)(nil), "sigs.LaneData")
 	proto.RegisterType((*BumpSequenceMsg)(nil), "sigs.BumpSequenceMsg")
+	proto.RegisterType((*AggregatedSignature)(nil), "sigs.AggregatedSignature")
+	proto.RegisterType((*AggregatedSigner)(nil), "sigs.AggregatedSigner")
+	proto.RegisterType((*TxExpiration)(nil), "sigs.TxExpiration")
 }
 
 func init() { proto.RegisterFile("x/sigs/codec.proto", fileDescriptor_1f3400434997a8ae) }
 
 var fileDescriptor_1f3400434997a8ae = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xaa, 0xd0, 0x2f, 0xce,
-	0x4c, 0x2f, 0xd6, 0x4f, 0xce, 0x4f, 0x49, 0x4d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62,
-	0x01, 0x89, 0x48, 0x71, 0x23, 0x09, 0x49, 0x09, 0x27, 0x17, 0x55, 0x16, 0x94, 0xe4, 0xeb, 0xe7,
-	0xe6, 0xa7, 0xa4, 0xe6, 0x14, 0x43, 0x04, 0x95, 0xea, 0xb8, 0x38, 0x42, 0x8b, 0x53, 0x8b, 0x5c,
-	0x12, 0x4b, 0x12, 0x85, 0xb4, 0xb9, 0x38, 0x72, 0x53, 0x4b, 0x12, 0x53, 0x12, 0x4b, 0x12, 0x25,
-	0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xf8, 0xf5, 0xca, 0x53, 0x13, 0xcb, 0x52, 0xf5, 0x7c, 0xa1,
-	0xc2, 0x41, 0x70, 0x05, 0x42, 0x9a, 0x5c, 0x6c, 0x05, 0xa5, 0x49, 0xd9, 0xa9, 0x95, 0x12, 0x4c,
-	0x60, 0xa5, 0x82, 0x7a, 0x10, 0xe3, 0xf5, 0x02, 0x4a, 0x93, 0x72, 0x32, 0x93, 0xbd, 0x53, 0x2b,
-	0x83, 0xa0, 0x0a, 0x84, 0xa4, 0xb8, 0x38, 0x8a, 0x53, 0x0b, 0x4b, 0x53, 0xf3, 0x92, 0x53, 0x25,
-	0x98, 0x15, 0x18, 0x35, 0x98, 0x83, 0xe0, 0x7c, 0xa5, 0x36, 0x46, 0x2e, 0x9e, 0xe0, 0x92, 0x94,
-	0xe0, 0xcc, 0xf4, 0xbc, 0xc4, 0x92, 0xd2, 0xa2, 0x54, 0x14, 0xc5, 0x4c, 0xa8, 0x8a, 0x91, 0xec,
-	0x64, 0x26, 0x64, 0xa7, 0x3e, 0x17, 0x67, 0x31, 0xcc, 0x4c, 0x09, 0x16, 0x54, 0xd5, 0x70, 0xcb,
-	0x82, 0x10, 0x6a, 0x94, 0x62, 0xb8, 0xf8, 0x9d, 0x4a, 0x73, 0x0b, 0x82, 0xa1, 0x76, 0xf9, 0x16,
-	0xa7, 0x93, 0x16, 0x1e, 0x32, 0x5c, 0x9c, 0x99, 0x79, 0xc9, 0x45, 0xa9, 0xb9, 0xa9, 0x79, 0x25,
-	0x60, 0x87, 0xf3, 0x06, 0x21, 0x04, 0x9c, 0x24, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
-	0x8e, 0x21, 0x89, 0x0d, 0x1c, 0x0f, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x12, 0xae,
-	0x63, 0xc5, 0x01, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0x26, 0x21, 0x4d, 0x27, 0xa9, 0x0a, 0x5b, 0x54, 0x59, 0x11, 0x72, 0x8d, 0x05, 0x52,
+	0x10, 0xc2, 0x86, 0x72, 0xe2, 0x82, 0xd4, 0x08, 0x4e, 0x50, 0x09, 0x39, 0xed, 0x01, 0x89, 0xcb,
+	0xc6, 0x1e, 0x39, 0x2b, 0xe2, 0xdd, 0xb0, 0xbb, 0x2e, 0xce, 0xbf, 0xe0, 0xaf, 0xf0, 0x2f, 0x38,
+	0xf6, 0x88, 0x38, 0x54, 0x28, 0xf9, 0x17, 0x3d, 0xa1, 0x38, 0xce, 0xa7, 0x14, 0xa2, 0xde, 0x66,
+	0x9e, 0xde, 0xbe, 0x19, 0xbd, 0x37, 0x0b, 0x34, 0xf3, 0x35, 0x8f, 0xb5, 0x1f, 0xca, 0x08, 0x43,
+	0x6f, 0xa8, 0xa4, 0x91, 0xb4, 0x3a, 0x45, 0x5a, 0x8d, 0x15, 0xa8, 0x75, 0x14, 0xaa, 0xd1, 0xd0,
+	0x48, 0x3f, 0x91, 0x11, 0x0e, 0x74, 0x01, 0x3e, 0x8c, 0x65, 0x2c, 0xf3, 0xd2, 0x9f, 0x56, 0x33,
+	0xd4, 0xfd, 0x49, 0xa0, 0x7e, 0xa9, 0x51, 0xbd, 0x63, 0x86, 0xd1, 0xe7, 0x50, 0x4f, 0xd0, 0xb0,
+	0x88, 0x19, 0x66, 0x11, 0x87, 0xb4, 0x1b, 0xa7, 0x87, 0xde, 0x77, 0x64, 0x57, 0xe8, 0x9d, 0x17,
+	0x70, 0xb0, 0x20, 0xd0, 0x67, 0x50, 0x1b, 0xa6, 0xbd, 0xaf, 0x38, 0xb2, 0xca, 0x39, 0xf5, 0x81,
+	0x37, 0x9b, 0xea, 0x7d, 0x4a, 0x7b, 0x03, 0x1e, 0x7e, 0xc0, 0x51, 0x50, 0x10, 0x68, 0x0b, 0xea,
+	0x1a, 0xbf, 0xa5, 0x28, 0x42, 0xb4, 0x2a, 0x0e, 0x69, 0x57, 0x82, 0x45, 0x4f, 0x5f, 0x01, 0x84,
+	0x52, 0x18, 0x25, 0x07, 0x03, 0x54, 0x56, 0x75, 0x9b, 0xd4, 0x0a, 0xc9, 0xfd, 0x43, 0xa0, 0xd9,
+	0x35, 0x51, 0x97, 0xc7, 0x82, 0x99, 0x54, 0xe1, 0x9a, 0x7e, 0x79, 0x43, 0x7f, 0xb9, 0x66, 0x65,
+	0xd7, 0x9a, 0x3e, 0xec, 0xeb, 0xb9, 0xe6, 0xe6, 0x26, 0x8b, 0x61, 0xc1, 0x92, 0x43, 0x29, 0x54,
+	0x07, 0x4c, 0xa0, 0x75, 0xcf, 0x21, 0xed, 0x83, 0x20, 0xaf, 0xe9, 0x5b, 0xd8, 0x63, 0x61, 0x28,
+	0x53, 0x61, 0xac, 0x9a, 0x43, 0xda, 0xcd, 0xce, 0x93, 0xdb, 0x9b, 0x13, 0x27, 0xe6, 0xa6, 0x9f,
+	0xf6, 0xbc, 0x50, 0x26, 0x3e, 0x97, 0x57, 0x2f, 0xa4, 0x40, 0x7f, 0x66, 0xec, 0x59, 0x14, 0x29,
+	0xd4, 0x3a, 0x98, 0x3f, 0x72, 0xbb, 0x50, 0xff, 0xc8, 0x04, 0xde, 0x3d, 0x8f, 0xff, 0x98, 0xe0,
+	0x7e, 0x81, 0xc3, 0x4e, 0x9a, 0x0c, 0xbb, 0x45, 0x7f, 0xae, 0xe3, 0xbb, 0x69, 0x3f, 0x82, 0x7d,
+	0x2e, 0x42, 0x85, 0x09, 0x0a, 0x93, 0x8b, 0x1f, 0x04, 0x4b, 0xc0, 0xcd, 0xe0, 0xe8, 0x2c, 0x8e,
+	0x15, 0xc6, 0xcc, 0xe0, 0x4a, 0x2a, 0x2f, 0x61, 0x6f, 0x6a, 0x15, 0x2a, 0x6d, 0x11, 0xa7, 0xd2,
+	0x6e, 0x9c, 0x1e, 0x7b, 0xd3, 0x53, 0xf5, 0xd6, 0xb9, 0xa8, 0x82, 0x39, 0x6d, 0x3d, 0x80, 0xf2,
+	0xee, 0x00, 0xdc, 0xcf, 0x70, 0x7f, 0x53, 0x6d, 0xcd, 0x07, 0xb2, 0xf5, 0x18, 0x76, 0xdd, 0xac,
+	0xcb, 0xa0, 0x79, 0x91, 0xbd, 0xcf, 0x86, 0x5c, 0x31, 0xc3, 0xa5, 0xa0, 0xc7, 0x50, 0xeb, 0x23,
+	0x8f, 0xfb, 0xa6, 0x10, 0x2d, 0x3a, 0xfa, 0x06, 0xaa, 0x86, 0x27, 0x85, 0xe5, 0x9d, 0xa7, 0xb7,
+	0x37, 0x27, 0x8f, 0xb7, 0x86, 0x7d, 0x29, 0x78, 0x76, 0xc1, 0x13, 0x0c, 0xf2, 0x27, 0x1d, 0xeb,
+	0xd7, 0xd8, 0x26, 0xd7, 0x63, 0x9b, 0xfc, 0x1d, 0xdb, 0xe4, 0xc7, 0xc4, 0x2e, 0x5d, 0x4f, 0xec,
+	0xd2, 0xef, 0x89, 0x5d, 0xea, 0xd5, 0xf2, 0xcf, 0xf9, 0xfa, 0xdf, 0x00, 0x8f, 0x1e, 0x42, 0xde,
+	0xf0, 0x03, 0x00, 0x00,
 }
 
 func (m *UserData) Marshal() (dAtA []byte, err error) {
@@ -283,6 +567,16 @@ func (m *UserData) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Sequence))
 	}
+	if m.Controller != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Controller.Size()))
+		n3, err := m.Controller.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
 	return i, nil
 }
 
@@ -310,26 +604,37 @@ func (m *StdSignature) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Pubkey.Size()))
-		n3, err := m.Pubkey.MarshalTo(dAtA[i:])
+		n4, err := m.Pubkey.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n4
 	}
 	if m.Signature != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Signature.Size()))
-		n4, err := m.Signature.MarshalTo(dAtA[i:])
+		n5, err := m.Signature.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n5
+	}
+	if m.Lane != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Lane))
+	}
+	if len(m.Account) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Account)))
+		i += copy(dAtA[i:], m.Account)
 	}
 	return i, nil
 }
 
-func (m *BumpSequenceMsg) Marshal() (dAtA []byte, err error) {
+func (m *LaneData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -339,7 +644,7 @@ func (m *BumpSequenceMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BumpSequenceMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *LaneData) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -348,75 +653,236 @@ func (m *BumpSequenceMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
-	if m.Increment != 0 {
+	if m.Sequence != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Increment))
+		i = encodeVarintCodec(dAtA, i, uint64(m.Sequence))
 	}
 	return i, nil
 }
 
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BumpSequenceMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *UserData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BumpSequenceMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if m.Pubkey != nil {
-		l = m.Pubkey.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovCodec(uint64(m.Sequence))
+	if m.Increment != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Increment))
 	}
-	return n
+	return i, nil
 }
 
-func (m *StdSignature) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AggregatedSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregatedSignature) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovCodec(uint64(m.Sequence))
-	}
-	if m.Pubkey != nil {
-		l = m.Pubkey.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, msg := range m.Signers {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.Signature != nil {
-		l = m.Signature.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Signature.Size()))
+		n8, err := m.Signature.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
 	}
-	return n
+	return i, nil
 }
 
-func (m *BumpSequenceMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AggregatedSigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *AggregatedSigner) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Sequence))
+	}
+	if m.Pubkey != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Pubkey.Size()))
+		n9, err := m.Pubkey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *TxExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxExpiration) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Height))
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Time))
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *UserData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovCodec(uint64(m.Sequence))
+	}
+	if m.Controller != nil {
+		l = m.Controller.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *StdSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovCodec(uint64(m.Sequence))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Signature != nil {
+		l = m.Signature.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Lane != 0 {
+		n += 1 + sovCodec(uint64(m.Lane))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *LaneData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovCodec(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *BumpSequenceMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Metadata != nil {
 		l = m.Metadata.Size()
 		n += 1 + l + sovCodec(uint64(l))
@@ -424,23 +890,450 @@ func (m *BumpSequenceMsg) Size() (n int) {
 	if m.Increment != 0 {
 		n += 1 + sovCodec(uint64(m.Increment))
 	}
-	return n
-}
+	return n
+}
+
+func (m *AggregatedSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Signature != nil {
+		l = m.Signature.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregatedSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovCodec(uint64(m.Sequence))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TxExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovCodec(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovCodec(uint64(m.Time))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pubkey == nil {
+				m.Pubkey = &crypto.PublicKey{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Controller == nil {
+				m.Controller = &crypto.PublicKey{}
+			}
+			if err := m.Controller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StdSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pubkey == nil {
+				m.Pubkey = &crypto.PublicKey{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signature == nil {
+				m.Signature = &crypto.Signature{}
+			}
+			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			m.Lane = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lane |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = append(m.Account[:0], dAtA[iNdEx:postIndex]...)
+			if m.Account == nil {
+				m.Account = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *UserData) Unmarshal(dAtA []byte) error {
+func (m *LaneData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -463,10 +1356,10 @@ func (m *UserData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserData: wiretype end group for non-group")
+			return fmt.Errorf("proto: LaneData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LaneData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -506,8 +1399,80 @@ func (m *UserData) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BumpSequenceMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BumpSequenceMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BumpSequenceMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -534,18 +1499,18 @@ func (m *UserData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pubkey == nil {
-				m.Pubkey = &crypto.PublicKey{}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
 			}
-			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
 			}
-			m.Sequence = 0
+			m.Increment = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -555,7 +1520,7 @@ func (m *UserData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= int64(b&0x7F) << shift
+				m.Increment |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -584,7 +1549,7 @@ func (m *UserData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StdSignature) Unmarshal(dAtA []byte) error {
+func (m *AggregatedSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -607,34 +1572,15 @@ func (m *StdSignature) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StdSignature: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregatedSignature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StdSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregatedSignature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -661,14 +1607,12 @@ func (m *StdSignature) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pubkey == nil {
-				m.Pubkey = &crypto.PublicKey{}
-			}
-			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Signers = append(m.Signers, &AggregatedSigner{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
@@ -728,7 +1672,7 @@ func (m *StdSignature) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BumpSequenceMsg) Unmarshal(dAtA []byte) error {
+func (m *AggregatedSigner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -751,15 +1695,34 @@ func (m *BumpSequenceMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BumpSequenceMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregatedSigner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BumpSequenceMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregatedSigner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -786,18 +1749,90 @@ func (m *BumpSequenceMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &weave.Metadata{}
+			if m.Pubkey == nil {
+				m.Pubkey = &crypto.PublicKey{}
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxExpiration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxExpiration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.Increment = 0
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -807,7 +1842,7 @@ func (m *BumpSequenceMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Increment |= uint32(b&0x7F) << shift
+				m.Time |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7,12 +7,25 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 )
 
 // SignCodeV1 is the current way to prefix the bytes we use to build
 // a signature
 var SignCodeV1 = []byte{0, 0xCA, 0xFE, 0}
 
+// LaneSignCodeV1 is the current way to prefix the bytes we use to build
+// a signature using a nonce lane other than the default one
+var LaneSignCodeV1 = []byte{0, 0xCA, 0xFE, 0x1A}
+
+// AccountSignCodeV1 is the current way to prefix the bytes we use to build
+// a signature on behalf of an account with a rotated key
+var AccountSignCodeV1 = []byte{0, 0xCA, 0xFE, 0xAC}
+
+// AggregatedSignCodeV1 is the current way to prefix the bytes we use to build
+// an aggregated signature
+var AggregatedSignCodeV1 = []byte{0, 0xCA, 0xFE, 0xA1}
+
 //----------------- Controller ------------------
 //
 // Place actual business logic here.
@@ -48,9 +61,76 @@ func VerifyTxSignatures(store weave.KVStore, tx SignedTx,
 		signers = append(signers, signer)
 	}
 
+	if atx, ok := tx.(AggregatedSignedTx); ok {
+		if agg := atx.GetAggregatedSignature(); agg != nil {
+			conds, err := VerifyAggregatedSignature(store, agg, bz, chainID)
+			if err != nil {
+				return nil, errors.Wrap(err, "aggregated signature")
+			}
+			signers = append(signers, conds...)
+		}
+	}
+
 	return signers, nil
 }
 
+// VerifyAggregatedSignature checks an aggregated signature against signbytes,
+// check chain and updates state of all signers in the store.
+//
+// Each signer must already be known, which means that it signed at least one
+// transaction with a standalone signature before. This proves the possession
+// of the private key and protects from a rogue key attack.
+func VerifyAggregatedSignature(db weave.KVStore, agg *AggregatedSignature,
+	signBytes []byte, chainID string) ([]weave.Condition, error) {
+
+	if err := agg.Validate(); err != nil {
+		return nil, err
+	}
+
+	bucket := NewBucket()
+	users := make([]orm.Object, 0, len(agg.Signers))
+	pubkeys := make([]*crypto.PublicKey, 0, len(agg.Signers))
+	for i, signer := range agg.Signers {
+		obj, err := bucket.Get(db, signer.Pubkey.Address())
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			return nil, errors.Wrapf(errors.ErrUnauthorized, "signer %d: unknown public key", i)
+		}
+		user := AsUser(obj)
+		if user.Controller != nil {
+			return nil, errors.Wrapf(errors.ErrUnauthorized, "signer %d: account key rotated", i)
+		}
+		if err := user.CheckAndIncrementSequence(signer.Sequence); err != nil {
+			return nil, errors.Wrapf(err, "signer %d", i)
+		}
+		users = append(users, obj)
+		pubkeys = append(pubkeys, user.Pubkey)
+	}
+
+	toSign, err := BuildAggregatedSignBytes(signBytes, chainID, agg.Signers)
+	if err != nil {
+		return nil, err
+	}
+	aggPubkey, err := crypto.AggregateBls12381PublicKeys(pubkeys)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrUnauthorized, err.Error())
+	}
+	if !aggPubkey.Verify(toSign, agg.Signature) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "invalid signature")
+	}
+
+	conds := make([]weave.Condition, 0, len(users))
+	for _, obj := range users {
+		if err := bucket.Save(db, obj); err != nil {
+			return nil, err
+		}
+		conds = append(conds, AsUser(obj).Pubkey.Condition())
+	}
+	return conds, nil
+}
+
 // VerifySignature checks one signature against signbytes,
 // check chain and updates state in the store
 func VerifySignature(db weave.KVStore, sig *StdSignature,
@@ -65,24 +145,56 @@ func VerifySignature(db weave.KVStore, sig *StdSignature,
 	bucket := NewBucket()
 
 	// load account
-	obj, err := bucket.GetOrCreate(db, sig.Pubkey)
-	if err != nil {
-		return nil, err
+	var obj orm.Object
+	if len(sig.Account) == 0 {
+		obj, err = bucket.GetOrCreate(db, sig.Pubkey)
+		if err != nil {
+			return nil, err
+		}
+		if AsUser(obj).Controller != nil {
+			return nil, errors.Wrap(errors.ErrUnauthorized, "account key rotated")
+		}
+	} else {
+		obj, err = bucket.Get(db, sig.Account)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			return nil, errors.Wrap(errors.ErrUnauthorized, "unknown account")
+		}
+		controller := AsUser(obj).Controller
+		if controller == nil || !controller.Address().Equals(sig.Pubkey.Address()) {
+			return nil, errors.Wrap(errors.ErrUnauthorized, "not the account controller")
+		}
 	}
 
-	toSign, err := BuildSignBytes(signBytes, chainID, sig.Sequence)
+	toSign, err := BuildAccountSignBytes(signBytes, chainID, sig.Account, sig.Lane, sig.Sequence)
 	if err != nil {
 		return nil, err
 	}
 
 	user := AsUser(obj)
-	if !user.Pubkey.Verify(toSign, sig.Signature) {
+	if !user.SigningKey().Verify(toSign, sig.Signature) {
 		return nil, errors.Wrap(errors.ErrUnauthorized, "invalid signature")
 	}
 
-	err = user.CheckAndIncrementSequence(sig.Sequence)
-	if err != nil {
-		return nil, err
+	if sig.Lane == 0 {
+		err = user.CheckAndIncrementSequence(sig.Sequence)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		lanes := NewLaneBucket()
+		laneObj, err := lanes.GetOrCreate(db, user.Pubkey.Address(), sig.Lane)
+		if err != nil {
+			return nil, err
+		}
+		if err := AsLane(laneObj).CheckAndIncrementSequence(sig.Sequence); err != nil {
+			return nil, errors.Wrapf(err, "lane %d", sig.Lane)
+		}
+		if err := lanes.Save(db, laneObj); err != nil {
+			return nil, err
+		}
 	}
 	err = bucket.Save(db, obj)
 	if err != nil {
@@ -91,6 +203,33 @@ func VerifySignature(db weave.KVStore, sig *StdSignature,
 	return user.Pubkey.Condition(), nil
 }
 
+// RotateKey sets the controller of an existing account. After the rotation,
+// only the controller key can sign on behalf of the account. Rotating to the
+// original public key of the account restores its initial state.
+//
+// This function does not authorize the rotation. It is meant to be called by
+// extensions that implement their own authorization, like x/recovery.
+func RotateKey(db weave.KVStore, account weave.Address, controller *crypto.PublicKey) error {
+	if controller == nil {
+		return errors.Wrap(errors.ErrEmpty, "controller")
+	}
+	bucket := NewBucket()
+	obj, err := bucket.Get(db, account)
+	if err != nil {
+		return errors.Wrap(err, "cannot load account")
+	}
+	if obj == nil {
+		return errors.Wrap(errors.ErrNotFound, "account")
+	}
+	user := AsUser(obj)
+	if user.Pubkey.Address().Equals(controller.Address()) {
+		user.Controller = nil
+	} else {
+		user.Controller = controller
+	}
+	return bucket.Save(db, obj)
+}
+
 /*
 BuildSignBytes combines all info on the actual tx before signing
 
@@ -130,6 +269,184 @@ func BuildSignBytes(signBytes []byte, chainID string, seq int64) ([]byte, error)
 	return hashed[:], nil
 }
 
+/*
+BuildLaneSignBytes combines all info on the actual tx before signing using
+given nonce lane. Default lane zero is using the BuildSignBytes format, so
+that signatures without a lane are not affected.
+
+Any other lane is using the following format:
+
+version | len(chainID) | chainID      | lane               | nonce             | signBytes
+4bytes  | uint8        | ascii string | uint32 (bigendian) | int64 (bigendian) | serialized transaction
+
+This is then prehashed with sha512 before fed into
+the public key signing/verification step
+*/
+func BuildLaneSignBytes(signBytes []byte, chainID string, lane uint32, seq int64) ([]byte, error) {
+	if lane == 0 {
+		return BuildSignBytes(signBytes, chainID, seq)
+	}
+	if lane > MaxLane {
+		return nil, errors.Wrapf(errors.ErrInput, "lane greater than %d", MaxLane)
+	}
+	if seq < 0 {
+		return nil, errors.Wrap(ErrInvalidSequence, "negative")
+	}
+	if !weave.IsValidChainID(chainID) {
+		return nil, errors.Wrapf(errors.ErrInput, "chain id: %v", chainID)
+	}
+
+	laneID := make([]byte, 4)
+	binary.BigEndian.PutUint32(laneID, lane)
+	nonce := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonce, uint64(seq))
+
+	output := make([]byte, 0, 4+1+len(chainID)+4+8+len(signBytes))
+	output = append(output, LaneSignCodeV1...)
+	output = append(output, uint8(len(chainID)))
+	output = append(output, []byte(chainID)...)
+	output = append(output, laneID...)
+	output = append(output, nonce...)
+	output = append(output, signBytes...)
+
+	hashed := sha512.Sum512(output)
+	return hashed[:], nil
+}
+
+/*
+BuildAccountSignBytes combines all info on the actual tx before signing on
+behalf of an account with a rotated key. When no account is given, the
+BuildLaneSignBytes format is used.
+
+Otherwise the following format is used:
+
+version | len(chainID) | chainID      | len(account) | account | lane               | nonce             | signBytes
+4bytes  | uint8        | ascii string | uint8        | address | uint32 (bigendian) | int64 (bigendian) | serialized transaction
+
+This is then prehashed with sha512 before fed into
+the public key signing/verification step
+*/
+func BuildAccountSignBytes(signBytes []byte, chainID string, account weave.Address, lane uint32, seq int64) ([]byte, error) {
+	if len(account) == 0 {
+		return BuildLaneSignBytes(signBytes, chainID, lane, seq)
+	}
+	if err := account.Validate(); err != nil {
+		return nil, errors.Wrap(err, "account")
+	}
+	if lane > MaxLane {
+		return nil, errors.Wrapf(errors.ErrInput, "lane greater than %d", MaxLane)
+	}
+	if seq < 0 {
+		return nil, errors.Wrap(ErrInvalidSequence, "negative")
+	}
+	if !weave.IsValidChainID(chainID) {
+		return nil, errors.Wrapf(errors.ErrInput, "chain id: %v", chainID)
+	}
+
+	laneID := make([]byte, 4)
+	binary.BigEndian.PutUint32(laneID, lane)
+	nonce := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonce, uint64(seq))
+
+	output := make([]byte, 0, 4+1+len(chainID)+1+len(account)+4+8+len(signBytes))
+	output = append(output, AccountSignCodeV1...)
+	output = append(output, uint8(len(chainID)))
+	output = append(output, []byte(chainID)...)
+	output = append(output, uint8(len(account)))
+	output = append(output, account...)
+	output = append(output, laneID...)
+	output = append(output, nonce...)
+	output = append(output, signBytes...)
+
+	hashed := sha512.Sum512(output)
+	return hashed[:], nil
+}
+
+/*
+BuildAggregatedSignBytes combines all info on the actual tx and all signers
+before signing with an aggregated signature
+
+We use the following format:
+
+version | len(chainID) | chainID      | len(signers) | signers                              | signBytes
+4bytes  | uint8        | ascii string | uint8        | (pubkey | int64 (bigendian)) * len | serialized transaction
+
+This is then prehashed with sha512 before fed into
+the public key signing/verification step
+*/
+func BuildAggregatedSignBytes(signBytes []byte, chainID string, signers []*AggregatedSigner) ([]byte, error) {
+	if !weave.IsValidChainID(chainID) {
+		return nil, errors.Wrapf(errors.ErrInput, "chain id: %v", chainID)
+	}
+	if len(signers) == 0 || len(signers) > maxAggregatedSigners {
+		return nil, errors.Wrapf(errors.ErrInput, "signers: %d", len(signers))
+	}
+
+	output := make([]byte, 0, 4+1+len(chainID)+1+len(signers)*(48+8)+len(signBytes))
+	output = append(output, AggregatedSignCodeV1...)
+	output = append(output, uint8(len(chainID)))
+	output = append(output, []byte(chainID)...)
+	output = append(output, uint8(len(signers)))
+	for _, s := range signers {
+		if s.Sequence < 0 {
+			return nil, errors.Wrap(ErrInvalidSequence, "negative")
+		}
+		nonce := make([]byte, 8)
+		binary.BigEndian.PutUint64(nonce, uint64(s.Sequence))
+		output = append(output, s.Pubkey.GetBls12381()...)
+		output = append(output, nonce...)
+	}
+	output = append(output, signBytes...)
+
+	hashed := sha512.Sum512(output)
+	return hashed[:], nil
+}
+
+// SignAggregatedTx creates an aggregated signature of all given signers for
+// the given tx. Sequence of each signer is provided at the same position in
+// the seqs list. All signers must use BLS12-381 keys.
+//
+// This function is useful when all private keys are available. Otherwise
+// each signer must sign the message returned by BuildAggregatedSignBytes and
+// the signatures must be combined using crypto.AggregateBls12381Signatures.
+func SignAggregatedTx(signers []crypto.Signer, tx SignedTx, chainID string,
+	seqs []int64) (*AggregatedSignature, error) {
+
+	if len(signers) != len(seqs) {
+		return nil, errors.Wrap(errors.ErrInput, "each signer requires a sequence")
+	}
+	agg := &AggregatedSignature{
+		Signers: make([]*AggregatedSigner, len(signers)),
+	}
+	for i, s := range signers {
+		agg.Signers[i] = &AggregatedSigner{
+			Sequence: seqs[i],
+			Pubkey:   s.PublicKey(),
+		}
+	}
+	signBytes, err := tx.GetSignBytes()
+	if err != nil {
+		return nil, err
+	}
+	toSign, err := BuildAggregatedSignBytes(signBytes, chainID, agg.Signers)
+	if err != nil {
+		return nil, err
+	}
+	sigs := make([]*crypto.Signature, len(signers))
+	for i, s := range signers {
+		sig, err := s.Sign(toSign)
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = sig
+	}
+	agg.Signature, err = crypto.AggregateBls12381Signatures(sigs)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	return agg, nil
+}
+
 // BuildSignBytesTx calculates the sign bytes given a tx
 func BuildSignBytesTx(tx SignedTx, chainID string, seq int64) ([]byte, error) {
 	signBytes, err := tx.GetSignBytes()
@@ -162,3 +479,59 @@ func SignTx(signer crypto.Signer, tx SignedTx, chainID string,
 
 	return res, nil
 }
+
+// SignLaneTx creates a signature for the given tx using given nonce lane
+func SignLaneTx(signer crypto.Signer, tx SignedTx, chainID string,
+	lane uint32, seq int64) (*StdSignature, error) {
+
+	signBytes, err := tx.GetSignBytes()
+	if err != nil {
+		return nil, err
+	}
+	toSign, err := BuildLaneSignBytes(signBytes, chainID, lane, seq)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := signer.Sign(toSign)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &StdSignature{
+		Pubkey:    signer.PublicKey(),
+		Signature: sig,
+		Sequence:  seq,
+		Lane:      lane,
+	}
+	return res, nil
+}
+
+// SignAccountTx creates a signature for the given tx on behalf of an account
+// with a rotated key. Signer must be the controller of the account.
+func SignAccountTx(signer crypto.Signer, tx SignedTx, chainID string,
+	account weave.Address, lane uint32, seq int64) (*StdSignature, error) {
+
+	signBytes, err := tx.GetSignBytes()
+	if err != nil {
+		return nil, err
+	}
+	toSign, err := BuildAccountSignBytes(signBytes, chainID, account, lane, seq)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := signer.Sign(toSign)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &StdSignature{
+		Pubkey:    signer.PublicKey(),
+		Signature: sig,
+		Sequence:  seq,
+		Lane:      lane,
+		Account:   account,
+	}
+	return res, nil
+}
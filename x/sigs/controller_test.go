@@ -123,6 +123,178 @@ func TestVerifySignature(t *testing.T) {
 	}
 }
 
+func TestVerifyLaneSignature(t *testing.T) {
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "sigs")
+	priv := crypto.GenPrivKeyEd25519()
+	addr := priv.PublicKey().Address()
+
+	chainID := "lanes-of-love"
+	bz := []byte("parallel")
+	tx := NewStdTx(bz)
+
+	sign := func(lane uint32, seq int64) *StdSignature {
+		t.Helper()
+		sig, err := SignLaneTx(priv, tx, chainID, lane, seq)
+		assert.Nil(t, err)
+		return sig
+	}
+
+	// Signature using the default lane is the same as a signature without
+	// a lane.
+	def, err := SignTx(priv, tx, chainID, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, def, sign(0, 0))
+
+	// Lanes are independent from each other and from the default lane.
+	_, err = VerifySignature(kv, sign(1, 0), bz, chainID)
+	assert.Nil(t, err)
+	_, err = VerifySignature(kv, sign(2, 0), bz, chainID)
+	assert.Nil(t, err)
+	_, err = VerifySignature(kv, sign(1, 1), bz, chainID)
+	assert.Nil(t, err)
+	_, err = VerifySignature(kv, sign(0, 0), bz, chainID)
+	assert.Nil(t, err)
+
+	for lane, want := range map[uint32]int64{0: 1, 1: 2, 2: 1, 3: 0} {
+		got, err := NextLaneNonce(kv, addr, lane)
+		assert.Nil(t, err)
+		if got != want {
+			t.Fatalf("lane %d: want %d nonce, got %d", lane, want, got)
+		}
+	}
+
+	// Replay within a lane is not allowed.
+	if _, err := VerifySignature(kv, sign(1, 1), bz, chainID); !ErrInvalidSequence.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A signature cannot be moved to another lane.
+	moved := sign(2, 1)
+	moved.Lane = 3
+	if _, err := VerifySignature(kv, moved, bz, chainID); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Lane ID is limited.
+	if _, err := SignLaneTx(priv, tx, chainID, MaxLane+1, 0); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	tooBig := sign(1, 2)
+	tooBig.Lane = MaxLane + 1
+	if _, err := VerifySignature(kv, tooBig, bz, chainID); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestVerifyRotatedAccountSignature(t *testing.T) {
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "sigs")
+	lost := crypto.GenPrivKeyEd25519()
+	account := lost.PublicKey().Address()
+	controller := crypto.GenPrivKeyEd25519()
+
+	chainID := "recover-me"
+	bz := []byte("rotate")
+	tx := NewStdTx(bz)
+
+	// Account must exist to be rotated.
+	if err := RotateKey(kv, account, controller.PublicKey()); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sig, err := SignTx(lost, tx, chainID, 0)
+	assert.Nil(t, err)
+	_, err = VerifySignature(kv, sig, bz, chainID)
+	assert.Nil(t, err)
+
+	assert.Nil(t, RotateKey(kv, account, controller.PublicKey()))
+
+	// Original key is no longer accepted.
+	sig, err = SignTx(lost, tx, chainID, 1)
+	assert.Nil(t, err)
+	if _, err := VerifySignature(kv, sig, bz, chainID); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Controller signs on behalf of the account, using the account
+	// sequence, and is authenticated as the account.
+	sig, err = SignAccountTx(controller, tx, chainID, account, 0, 1)
+	assert.Nil(t, err)
+	cond, err := VerifySignature(kv, sig, bz, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, lost.PublicKey().Condition(), cond)
+
+	// Lanes are supported as well.
+	sig, err = SignAccountTx(controller, tx, chainID, account, 3, 0)
+	assert.Nil(t, err)
+	_, err = VerifySignature(kv, sig, bz, chainID)
+	assert.Nil(t, err)
+
+	// A signature cannot be used without the account, because the sign
+	// bytes differ.
+	sig, err = SignAccountTx(controller, tx, chainID, account, 0, 0)
+	assert.Nil(t, err)
+	sig.Account = nil
+	if _, err := VerifySignature(kv, sig, bz, chainID); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Only the controller can sign on behalf of the account.
+	other := crypto.GenPrivKeyEd25519()
+	sig, err = SignAccountTx(other, tx, chainID, account, 0, 2)
+	assert.Nil(t, err)
+	if _, err := VerifySignature(kv, sig, bz, chainID); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Rotating back to the original key restores the account.
+	assert.Nil(t, RotateKey(kv, account, lost.PublicKey()))
+	sig, err = SignTx(lost, tx, chainID, 2)
+	assert.Nil(t, err)
+	_, err = VerifySignature(kv, sig, bz, chainID)
+	assert.Nil(t, err)
+	sig, err = SignAccountTx(controller, tx, chainID, account, 0, 3)
+	assert.Nil(t, err)
+	if _, err := VerifySignature(kv, sig, bz, chainID); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestVerifySecp256k1Signature(t *testing.T) {
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "sigs")
+	priv := crypto.GenPrivKeySecp256k1()
+	perm := priv.PublicKey().Condition()
+
+	chainID := "emo-music-2345"
+	bz := []byte("my special valentine")
+	tx := NewStdTx(bz)
+
+	sig0, err := SignTx(priv, tx, chainID, 0)
+	assert.Nil(t, err)
+	sig1, err := SignTx(priv, tx, chainID, 1)
+	assert.Nil(t, err)
+
+	sign, err := VerifySignature(kv, sig0, bz, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, perm, sign)
+
+	// doesn't match on bad sig
+	copy(sig1.Signature.GetSecp256K1(), []byte{42, 17, 99})
+	if _, err := VerifySignature(kv, sig1, bz, chainID); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// signature of another key type is rejected
+	other, err := SignTx(crypto.GenPrivKeyEd25519(), tx, chainID, 1)
+	assert.Nil(t, err)
+	other.Pubkey = priv.PublicKey()
+	if _, err := VerifySignature(kv, other, bz, chainID); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestVerifyTxSignatures(t *testing.T) {
 	kv := store.MemStore()
 	migration.MustInitPkg(kv, "sigs")
@@ -223,3 +395,122 @@ func (tx StdTx) GetSignBytes() ([]byte, error) {
 	}
 	return bz, nil
 }
+
+type AggregatedTx struct {
+	*StdTx
+	Aggregated *AggregatedSignature
+}
+
+var _ AggregatedSignedTx = (*AggregatedTx)(nil)
+
+func (tx AggregatedTx) GetAggregatedSignature() *AggregatedSignature {
+	return tx.Aggregated
+}
+
+func TestVerifyAggregatedSignature(t *testing.T) {
+	chainID := "hot_summer_days"
+
+	alice := crypto.GenPrivKeyBls12381()
+	bobby := crypto.GenPrivKeyBls12381()
+	charlie := crypto.GenPrivKeyBls12381()
+	unknown := crypto.GenPrivKeyBls12381()
+	registered := []crypto.Signer{alice, bobby, charlie}
+
+	specs := map[string]struct {
+		Signers   []crypto.Signer
+		Seqs      []int64
+		Tamper    func(*testing.T, *AggregatedSignature)
+		WantErr   *errors.Error
+		WantConds []weave.Condition
+	}{
+		"All good": {
+			Signers: []crypto.Signer{alice, bobby, charlie},
+			Seqs:    []int64{1, 1, 1},
+			WantConds: []weave.Condition{
+				alice.PublicKey().Condition(),
+				bobby.PublicKey().Condition(),
+				charlie.PublicKey().Condition(),
+			},
+		},
+		"Single signer": {
+			Signers:   []crypto.Signer{bobby},
+			Seqs:      []int64{1},
+			WantConds: []weave.Condition{bobby.PublicKey().Condition()},
+		},
+		"Unknown public key is rejected": {
+			Signers: []crypto.Signer{alice, unknown},
+			Seqs:    []int64{1, 0},
+			WantErr: errors.ErrUnauthorized,
+		},
+		"Invalid sequence": {
+			Signers: []crypto.Signer{alice, bobby},
+			Seqs:    []int64{1, 0},
+			WantErr: ErrInvalidSequence,
+		},
+		"Duplicated signer": {
+			Signers: []crypto.Signer{alice, alice},
+			Seqs:    []int64{1, 1},
+			WantErr: errors.ErrDuplicate,
+		},
+		"Missing signature of a signer": {
+			Signers: []crypto.Signer{alice, bobby},
+			Seqs:    []int64{1, 1},
+			Tamper: func(t *testing.T, agg *AggregatedSignature) {
+				agg.Signers = append(agg.Signers, &AggregatedSigner{
+					Sequence: 1,
+					Pubkey:   charlie.PublicKey(),
+				})
+			},
+			WantErr: errors.ErrUnauthorized,
+		},
+		"Non BLS signers are not supported": {
+			Signers: []crypto.Signer{alice},
+			Seqs:    []int64{1},
+			Tamper: func(t *testing.T, agg *AggregatedSignature) {
+				agg.Signers[0].Pubkey = crypto.GenPrivKeyEd25519().PublicKey()
+			},
+			WantErr: errors.ErrUnauthorized,
+		},
+	}
+
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "sigs")
+
+			// Register all keys, except the unknown one, using a
+			// standalone signature.
+			for _, key := range registered {
+				tx := NewStdTx([]byte("register"))
+				sig, err := SignTx(key, tx, chainID, 0)
+				assert.Nil(t, err)
+				tx.Signatures = []*StdSignature{sig}
+				if _, err := VerifyTxSignatures(kv, tx, chainID); err != nil {
+					t.Fatalf("cannot register key: %s", err)
+				}
+			}
+
+			tx := &AggregatedTx{StdTx: NewStdTx([]byte("ice cream"))}
+			agg, err := SignAggregatedTx(spec.Signers, tx, chainID, spec.Seqs)
+			assert.Nil(t, err)
+			if spec.Tamper != nil {
+				spec.Tamper(t, agg)
+			}
+			tx.Aggregated = agg
+
+			conds, err := VerifyTxSignatures(kv, tx, chainID)
+			if !spec.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if spec.WantErr != nil {
+				return
+			}
+			assert.Equal(t, spec.WantConds, conds)
+
+			// Replay is not possible.
+			if _, err := VerifyTxSignatures(kv, tx, chainID); !ErrInvalidSequence.Is(err) {
+				t.Fatalf("unexpected replay error: %+v", err)
+			}
+		})
+	}
+}
@@ -14,9 +14,11 @@ const (
 	signatureVerifyCost = 500
 )
 
-// RegisterQuery will register this bucket as "/auth"
+// RegisterQuery will register this bucket as "/auth" and the nonce lanes
+// bucket as "/authlanes"
 func RegisterQuery(qr weave.QueryRouter) {
 	NewBucket().Register("auth", qr)
+	NewLaneBucket().Register("authlanes", qr)
 }
 
 //----------------- Decorator ----------------
@@ -46,8 +48,14 @@ func (d Decorator) AllowMissingSigs() Decorator {
 	return d
 }
 
-// Check verifies signatures before calling down the stack.
+// Check verifies expiration and signatures before calling down the stack.
+//
+// Because the mempool is rechecking its transactions after each block, an
+// expired transaction is dropped from the mempool as well.
 func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	if err := verifyExpiration(ctx, tx); err != nil {
+		return nil, err
+	}
 	stx, ok := tx.(SignedTx)
 	if !ok {
 		return next.Check(ctx, store, tx)
@@ -75,8 +83,11 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 	return res, nil
 }
 
-// Deliver verifies signatures before calling down the stack.
+// Deliver verifies expiration and signatures before calling down the stack.
 func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	if err := verifyExpiration(ctx, tx); err != nil {
+		return nil, err
+	}
 	stx, ok := tx.(SignedTx)
 	if !ok {
 		return next.Deliver(ctx, store, tx)
@@ -94,3 +105,32 @@ func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 	ctx = withSigners(ctx, signers)
 	return next.Deliver(ctx, store, tx)
 }
+
+// verifyExpiration returns an error if given transaction defines an
+// expiration that was already reached.
+func verifyExpiration(ctx weave.Context, tx weave.Tx) error {
+	etx, ok := tx.(ExpiringTx)
+	if !ok {
+		return nil
+	}
+	exp := etx.GetExpiration()
+	if exp == nil {
+		return nil
+	}
+	if err := exp.Validate(); err != nil {
+		return errors.Wrap(err, "invalid expiration")
+	}
+	if exp.Height != 0 {
+		height, ok := weave.GetHeight(ctx)
+		if !ok {
+			return errors.Wrap(errors.ErrHuman, "block height not present in context")
+		}
+		if height > exp.Height {
+			return errors.Wrapf(errors.ErrExpired, "transaction expired at height %d", exp.Height)
+		}
+	}
+	if exp.Time != 0 && weave.IsExpired(ctx, exp.Time) {
+		return errors.Wrapf(errors.ErrExpired, "transaction expired at %s", exp.Time)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package sigs
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
@@ -153,3 +154,91 @@ func TestGasPaymentPerSigner(t *testing.T) {
 		t.Fatalf("want %d gas payment, got %d", want, got)
 	}
 }
+
+func TestDecoratorExpiration(t *testing.T) {
+	const chainID = "expire-me"
+	now := time.Now()
+
+	priv := weavetest.NewKey()
+
+	cases := map[string]struct {
+		Expiration *TxExpiration
+		WantErr    *errors.Error
+	}{
+		"no expiration": {
+			Expiration: nil,
+		},
+		"height in the future": {
+			Expiration: &TxExpiration{Height: 101},
+		},
+		"height is the current height": {
+			Expiration: &TxExpiration{Height: 100},
+		},
+		"height in the past": {
+			Expiration: &TxExpiration{Height: 99},
+			WantErr:    errors.ErrExpired,
+		},
+		"time in the future": {
+			Expiration: &TxExpiration{Time: weave.AsUnixTime(now.Add(time.Minute))},
+		},
+		"time is the current time": {
+			Expiration: &TxExpiration{Time: weave.AsUnixTime(now)},
+			WantErr:    errors.ErrExpired,
+		},
+		"time in the past": {
+			Expiration: &TxExpiration{Time: weave.AsUnixTime(now.Add(-time.Minute))},
+			WantErr:    errors.ErrExpired,
+		},
+		"height in the future, time in the past": {
+			Expiration: &TxExpiration{Height: 200, Time: weave.AsUnixTime(now.Add(-time.Minute))},
+			WantErr:    errors.ErrExpired,
+		},
+		"empty expiration": {
+			Expiration: &TxExpiration{},
+			WantErr:    errors.ErrEmpty,
+		},
+		"negative height": {
+			Expiration: &TxExpiration{Height: -1},
+			WantErr:    errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "sigs")
+
+			ctx := weave.WithChainID(context.Background(), chainID)
+			ctx = weave.WithHeight(ctx, 100)
+			ctx = weave.WithBlockTime(ctx, now)
+
+			tx := &ExpTx{
+				StdTx:      NewStdTx([]byte("expiring")),
+				Expiration: tc.Expiration,
+			}
+			sig, err := SignTx(priv, tx, chainID, 0)
+			assert.Nil(t, err)
+			tx.Signatures = []*StdSignature{sig}
+
+			var h weavetest.Handler
+			d := NewDecorator()
+			if _, err := d.Check(ctx, db.CacheWrap(), tx, &h); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := d.Deliver(ctx, db, tx, &h); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+		})
+	}
+}
+
+type ExpTx struct {
+	*StdTx
+	Expiration *TxExpiration
+}
+
+var _ ExpiringTx = (*ExpTx)(nil)
+
+func (tx ExpTx) GetExpiration() *TxExpiration {
+	return tx.Expiration
+}
@@ -1,6 +1,8 @@
 package sigs
 
 import (
+	"encoding/binary"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
@@ -10,11 +12,18 @@ import (
 
 func init() {
 	migration.MustRegister(1, &UserData{}, migration.NoModification)
+	migration.MustRegister(1, &LaneData{}, migration.NoModification)
 }
 
 // BucketName is where we store the accounts
 const BucketName = "sigs"
 
+// LaneBucketName is where we store the sequences of nonce lanes
+const LaneBucketName = "sigslane"
+
+// MaxLane is the greatest nonce lane ID that can be used by an account.
+const MaxLane = 255
+
 //---- UserData
 // Model stores the persistent state and all domain logic
 // associated with valid state and state transitions.
@@ -33,15 +42,19 @@ func (u *UserData) Validate() error {
 	if seq > 0 && u.Pubkey == nil {
 		return errors.Wrapf(ErrInvalidSequence, "Seq(%d) needs Pubkey", seq)
 	}
+	if u.Controller != nil && u.Pubkey == nil {
+		return errors.Wrap(errors.ErrState, "controller needs Pubkey")
+	}
 	return nil
 }
 
 // Copy makes a new UserData with the same coins
 func (u *UserData) Copy() orm.CloneableData {
 	return &UserData{
-		Metadata: u.Metadata.Copy(),
-		Sequence: u.Sequence,
-		Pubkey:   u.Pubkey,
+		Metadata:   u.Metadata.Copy(),
+		Sequence:   u.Sequence,
+		Pubkey:     u.Pubkey,
+		Controller: u.Controller,
 	}
 }
 
@@ -51,11 +64,22 @@ func (u *UserData) Copy() orm.CloneableData {
 // Before incrementing the sequence, this function is testing for a value
 // overflow.
 func (u *UserData) CheckAndIncrementSequence(expected int64) error {
-	if u.Sequence != expected {
-		return errors.Wrapf(ErrInvalidSequence, "mismatch expected %d, got %d", expected, u.Sequence)
+	next, err := nextSequence(u.Sequence, expected)
+	if err != nil {
+		return err
+	}
+	u.Sequence = next
+	return nil
+}
+
+// nextSequence returns the sequence value following the current one if the
+// current value is the same as given expected value.
+func nextSequence(current, expected int64) (int64, error) {
+	if current != expected {
+		return 0, errors.Wrapf(ErrInvalidSequence, "mismatch expected %d, got %d", expected, current)
 	}
 
-	next := u.Sequence + 1
+	next := current + 1
 
 	// maxSequenceValue is limited by the client. The greatest supported
 	// nonce value at client side is
@@ -64,10 +88,9 @@ func (u *UserData) CheckAndIncrementSequence(expected int64) error {
 	// client code.
 	const maxSequenceValue = (1 << 53) - 1
 	if next <= 0 || next > maxSequenceValue {
-		return errors.Wrap(errors.ErrOverflow, "sequence out of range")
+		return 0, errors.Wrap(errors.ErrOverflow, "sequence out of range")
 	}
-	u.Sequence = next
-	return nil
+	return next, nil
 }
 
 // SetPubkey will try to set the Pubkey or panic on an illegal operation.
@@ -81,6 +104,16 @@ func (u *UserData) SetPubkey(pubkey *crypto.PublicKey) {
 	u.Pubkey = pubkey
 }
 
+// SigningKey returns the public key that is currently allowed to sign on
+// behalf of this account. This is the controller if the account key was
+// rotated and the original public key otherwise.
+func (u *UserData) SigningKey() *crypto.PublicKey {
+	if u.Controller != nil {
+		return u.Controller
+	}
+	return u.Pubkey
+}
+
 //-------------------- Object Wrapper -------
 
 // AsUser will safely type-cast any value from Bucket to a UserData
@@ -126,3 +159,78 @@ func (b Bucket) GetOrCreate(db weave.KVStore, pubkey *crypto.PublicKey) (orm.Obj
 	}
 	return obj, err
 }
+
+//---- LaneData
+
+var _ orm.CloneableData = (*LaneData)(nil)
+
+// Validate ensures the lane sequence is valid
+func (l *LaneData) Validate() error {
+	if err := l.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if l.Sequence < 0 {
+		return errors.Wrapf(ErrInvalidSequence, "Seq(%d)", l.Sequence)
+	}
+	return nil
+}
+
+// Copy makes a new LaneData with the same sequence
+func (l *LaneData) Copy() orm.CloneableData {
+	return &LaneData{
+		Metadata: l.Metadata.Copy(),
+		Sequence: l.Sequence,
+	}
+}
+
+// CheckAndIncrementSequence implements check and increment operation for
+// the lane sequence. It follows the same rules as the UserData sequence.
+func (l *LaneData) CheckAndIncrementSequence(expected int64) error {
+	next, err := nextSequence(l.Sequence, expected)
+	if err != nil {
+		return err
+	}
+	l.Sequence = next
+	return nil
+}
+
+// LaneKey returns the key of the nonce lane of given address.
+func LaneKey(addr weave.Address, lane uint32) []byte {
+	key := make([]byte, len(addr)+4)
+	copy(key, addr)
+	binary.BigEndian.PutUint32(key[len(addr):], lane)
+	return key
+}
+
+// AsLane will safely type-cast any value from LaneBucket to a LaneData
+func AsLane(obj orm.Object) *LaneData {
+	if obj == nil || obj.Value() == nil {
+		return nil
+	}
+	return obj.Value().(*LaneData)
+}
+
+// LaneBucket extends orm.Bucket with GetOrCreate
+type LaneBucket struct {
+	orm.Bucket
+}
+
+// NewLaneBucket creates the bucket for the nonce lane sequences
+func NewLaneBucket() LaneBucket {
+	return LaneBucket{
+		Bucket: migration.NewBucket("sigs", LaneBucketName, orm.NewSimpleObj(nil, &LaneData{})),
+	}
+}
+
+// GetOrCreate initializes a LaneData if none exist for given address and
+// lane.
+func (b LaneBucket) GetOrCreate(db weave.KVStore, addr weave.Address, lane uint32) (orm.Object, error) {
+	key := LaneKey(addr, lane)
+	obj, err := b.Get(db, key)
+	if err == nil && obj == nil {
+		obj = orm.NewSimpleObj(key, &LaneData{
+			Metadata: &weave.Metadata{Schema: 1},
+		})
+	}
+	return obj, err
+}
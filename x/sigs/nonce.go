@@ -22,3 +22,22 @@ func NextNonce(db weave.ReadOnlyKVStore, signer weave.Address) (int64, error) {
 	// If not yet present, nonce counting starts with zero.
 	return 0, nil
 }
+
+// NextLaneNonce returns the next numeric nonce value of given nonce lane that
+// should be used during a transaction signing. Lane zero is the default lane
+// and it is using the same nonce as returned by NextNonce.
+func NextLaneNonce(db weave.ReadOnlyKVStore, signer weave.Address, lane uint32) (int64, error) {
+	if lane == 0 {
+		return NextNonce(db, signer)
+	}
+	obj, err := NewLaneBucket().Get(db, LaneKey(signer, lane))
+	if err != nil {
+		return 0, errors.Wrap(err, "bucket get")
+	}
+	if l := AsLane(obj); l != nil {
+		return l.Sequence, nil
+	}
+
+	// If not yet present, nonce counting starts with zero.
+	return 0, nil
+}
@@ -18,6 +18,73 @@ type SignedTx interface {
 	GetSignatures() []*StdSignature
 }
 
+// AggregatedSignedTx represents a transaction that can contain an aggregated
+// signature of many signers in addition to the standalone signatures.
+type AggregatedSignedTx interface {
+	SignedTx
+
+	// GetAggregatedSignature returns the aggregated signature of many
+	// signers or nil if not present.
+	GetAggregatedSignature() *AggregatedSignature
+}
+
+// ExpiringTx represents a transaction that is valid only until the
+// expiration height or time is reached. Expired transactions are rejected by
+// the Decorator.
+type ExpiringTx interface {
+	// GetExpiration returns the expiration of the transaction or nil if the
+	// transaction does not expire.
+	GetExpiration() *TxExpiration
+}
+
+// Validate ensures the TxExpiration meets basic standards
+func (e *TxExpiration) Validate() error {
+	if e.Height < 0 {
+		return errors.Wrap(errors.ErrInput, "negative height")
+	}
+	if e.Time != 0 {
+		if err := e.Time.Validate(); err != nil {
+			return errors.Wrap(err, "time")
+		}
+	}
+	if e.Height == 0 && e.Time == 0 {
+		return errors.Wrap(errors.ErrEmpty, "neither height nor time is set")
+	}
+	return nil
+}
+
+// maxAggregatedSigners is the maximum number of signers of a single
+// aggregated signature.
+const maxAggregatedSigners = 100
+
+// Validate ensures the AggregatedSignature meets basic standards
+func (s *AggregatedSignature) Validate() error {
+	if len(s.Signers) == 0 {
+		return errors.Wrap(errors.ErrUnauthorized, "missing signers")
+	}
+	if len(s.Signers) > maxAggregatedSigners {
+		return errors.Wrapf(errors.ErrInput, "more than %d signers", maxAggregatedSigners)
+	}
+	if s.Signature.GetBls12381() == nil {
+		return errors.Wrap(errors.ErrUnauthorized, "missing bls12381 signature")
+	}
+	seen := make(map[string]struct{}, len(s.Signers))
+	for i, signer := range s.Signers {
+		if signer.GetSequence() < 0 {
+			return errors.Wrapf(ErrInvalidSequence, "signer %d: negative", i)
+		}
+		if signer.Pubkey.GetBls12381() == nil {
+			return errors.Wrapf(errors.ErrUnauthorized, "signer %d: missing bls12381 public key", i)
+		}
+		key := string(signer.Pubkey.GetBls12381())
+		if _, ok := seen[key]; ok {
+			return errors.Wrapf(errors.ErrDuplicate, "signer %d", i)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
 // Validate ensures the StdSignature meets basic standards
 func (s *StdSignature) Validate() error {
 	seq := s.GetSequence()
@@ -30,6 +97,14 @@ func (s *StdSignature) Validate() error {
 	if s.Signature == nil {
 		return errors.Wrap(errors.ErrUnauthorized, "missing signature")
 	}
+	if s.Lane > MaxLane {
+		return errors.Wrapf(errors.ErrInput, "lane greater than %d", MaxLane)
+	}
+	if len(s.Account) != 0 {
+		if err := s.Account.Validate(); err != nil {
+			return errors.Wrap(err, "account")
+		}
+	}
 
 	return nil
 }